@@ -47,6 +47,8 @@ CREATE TABLE gardener_config
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
     eu_access boolean NOT NULL,
+    shoot_and_seed_same_region boolean NOT NULL DEFAULT false,
+    resource_version integer NOT NULL DEFAULT 1,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -212,3 +214,140 @@ CREATE TABLE dns_providers
     type varchar(256) NOT NULL,
     foreign key (dns_config_id) REFERENCES dns_config (id) ON DELETE CASCADE
 );
+
+-- API audit events
+
+CREATE TABLE api_audit_event
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    timestamp timestamp without time zone NOT NULL,
+    caller varchar(256) NOT NULL,
+    tenant varchar(256) NOT NULL,
+    sub_account_id varchar(256),
+    mutation varchar(256) NOT NULL,
+    runtime_id varchar(256),
+    input jsonb,
+    operation_id varchar(256),
+    outcome varchar(256) NOT NULL,
+    err_message text
+);
+
+CREATE INDEX api_audit_event_runtime_id_timestamp_idx ON api_audit_event (runtime_id, timestamp);
+
+CREATE RULE api_audit_event_no_update AS ON UPDATE TO api_audit_event DO INSTEAD NOTHING;
+CREATE RULE api_audit_event_no_delete AS ON DELETE TO api_audit_event DO INSTEAD NOTHING;
+
+-- Idempotency keys
+
+CREATE TABLE idempotency_key
+(
+    tenant varchar(256) NOT NULL,
+    key varchar(256) NOT NULL,
+    mutation varchar(256) NOT NULL,
+    request_hash varchar(64) NOT NULL,
+    operation_id uuid NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (tenant, key),
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+
+-- Tenant quota overrides
+
+CREATE TABLE tenant_quota
+(
+    tenant varchar(256) PRIMARY KEY,
+    runtimes integer,
+    total_max_nodes integer,
+    concurrent_operations integer
+);
+
+CREATE INDEX cluster_tenant_idx ON cluster (tenant);
+
+-- Retention of deleted clusters
+
+ALTER TABLE cluster ADD COLUMN deleted_at timestamp without time zone;
+
+CREATE TABLE operation_archive
+(
+    id uuid PRIMARY KEY,
+    type operation_type NOT NULL,
+    state operation_state NOT NULL,
+    message text,
+    start_timestamp timestamp without time zone NOT NULL,
+    end_timestamp timestamp without time zone,
+    cluster_id uuid NOT NULL,
+    stage varchar(256) NOT NULL,
+    last_transition timestamp without time zone,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL,
+    archived_at timestamp without time zone NOT NULL
+);
+
+CREATE INDEX operation_archive_cluster_id_idx ON operation_archive (cluster_id);
+
+-- Gardener config revisions
+
+CREATE TABLE gardener_config_revision
+(
+    cluster_id uuid NOT NULL,
+    revision integer NOT NULL,
+    operation_id uuid,
+    creation_timestamp timestamp without time zone NOT NULL,
+    config jsonb NOT NULL,
+    PRIMARY KEY (cluster_id, revision),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+-- Runtime drift
+
+CREATE TABLE runtime_drift
+(
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    stored_value text,
+    actual_value text,
+    detected_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (cluster_id, field),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+
+-- Runtime metadata
+
+ALTER TABLE cluster ADD COLUMN labels jsonb;
+ALTER TABLE cluster ADD COLUMN description text;
+
+CREATE INDEX cluster_labels_idx ON cluster USING GIN (labels);
+
+-- Seed placement
+
+ALTER TABLE operation ADD COLUMN seed_placement text;
+ALTER TABLE operation_archive ADD COLUMN seed_placement text;
+
+-- Gardener extensions
+
+ALTER TABLE gardener_config ADD COLUMN extensions jsonb;
+
+-- Networking plugin
+
+ALTER TABLE gardener_config ADD COLUMN networking jsonb;
+
+-- Kube-apiserver settings
+
+ALTER TABLE gardener_config ADD COLUMN kube_api_server jsonb;
+
+-- User agent of API audit events
+
+ALTER TABLE api_audit_event ADD COLUMN user_agent varchar(256);
+
+-- Client controlled values of API audit events are not limited in length
+
+ALTER TABLE api_audit_event
+    ALTER COLUMN caller TYPE text,
+    ALTER COLUMN tenant TYPE text,
+    ALTER COLUMN sub_account_id TYPE text,
+    ALTER COLUMN mutation TYPE text,
+    ALTER COLUMN runtime_id TYPE text,
+    ALTER COLUMN operation_id TYPE text,
+    ALTER COLUMN outcome TYPE text,
+    ALTER COLUMN user_agent TYPE text;
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/backfill"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	connStringFormat          = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s sslrootcert=%s"
+	databaseConnectionRetries = 20
+)
+
+type config struct {
+	Database struct {
+		User        string `envconfig:"default=postgres"`
+		Password    string `envconfig:"default=password"`
+		Host        string `envconfig:"default=localhost"`
+		Port        string `envconfig:"default=5432"`
+		Name        string `envconfig:"default=provisioner"`
+		SSLMode     string `envconfig:"default=disable"`
+		SSLRootCert string `envconfig:"optional"`
+	}
+
+	Gardener struct {
+		Project        string `envconfig:"default=gardenerProject"`
+		KubeconfigPath string `envconfig:"default=./dev/kubeconfig.yaml"`
+	}
+}
+
+// Stores pods and services CIDRs of Runtimes provisioned before the CIDRs were persisted, reading them from the shoots
+func main() {
+	log.SetFormatter(&log.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Failed to load backfill config")
+
+	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
+
+	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
+	exitOnError(err, "Failed to initialize database connection")
+	defer connection.Close()
+
+	rawKubeconfig, err := os.ReadFile(cfg.Gardener.KubeconfigPath)
+	exitOnError(err, "Failed to read Gardener kubeconfig")
+
+	gardenerClusterConfig, err := gardener.Config(rawKubeconfig)
+	exitOnError(err, "Failed to create Gardener cluster config")
+
+	gardenerClientSet, err := gardener.NewClient(gardenerClusterConfig)
+	exitOnError(err, "Failed to create Gardener cluster clientset")
+
+	shootClient := gardenerClientSet.Shoots(fmt.Sprintf("garden-%s", cfg.Gardener.Project))
+
+	result, err := backfill.NewNetworkingBackfill(backfill.NewNetworkingStore(connection), shootClient).Run(context.Background())
+	exitOnError(err, "Failed to backfill networking CIDRs")
+
+	log.Infof("Backfilled networking CIDRs of %d Runtimes, skipped %d, failed %d", result.Updated, result.Skipped, result.Failed)
+	if result.Failed > 0 {
+		log.Fatal("Failed to backfill networking CIDRs of some Runtimes, run the backfill again to retry")
+	}
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
@@ -9,8 +10,15 @@ import (
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/drift"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/quota"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	ctrl "sigs.k8s.io/controller-runtime"
 
@@ -37,10 +45,15 @@ func newProvisioningService(
 	defaultEnableKubernetesVersionAutoUpdate,
 	defaultEnableMachineImageVersionAutoUpdate bool,
 	defaultEnableIMDSv2 bool,
-	dynamicKubeconfigProvider DynamicKubeconfigProvider) provisioning.Service {
+	dynamicKubeconfigProvider DynamicKubeconfigProvider,
+	quotaChecker quota.Checker,
+	regionPolicies regionpolicy.Provider,
+	seedPlacer seedplacement.Placer,
+	cloudProfiles cloudprofile.Provider,
+	extensionsConfig extensions.Config) provisioning.Service {
 
 	uuidGenerator := uuid.NewUUIDGenerator()
-	inputConverter := provisioning.NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := provisioning.NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensionsConfig)
 	graphQLConverter := provisioning.NewGraphQLConverter()
 
 	return provisioning.NewProvisioningService(
@@ -53,10 +66,27 @@ func newProvisioningService(
 		provisioningQueue,
 		deprovisioningQueue,
 		shootUpgradeQueue,
-		dynamicKubeconfigProvider)
+		dynamicKubeconfigProvider,
+		quotaChecker,
+		regionPolicies,
+		seedPlacer,
+		cloudProfiles)
 }
 
-func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string) (*gardener.ShootController, error) {
+func newKeyProvider(cfg config) (dbsession.KeyProvider, error) {
+	return dbsession.NewKeyProvider(dbsession.KeyProviderConfig{
+		Type:              cfg.Database.KeyProvider,
+		SecretKey:         cfg.Database.SecretKey,
+		SecretKeys:        cfg.Database.SecretKeys,
+		SecretKeysPath:    cfg.Database.SecretKeysPath,
+		WrappedSecretKeys: cfg.Database.WrappedSecretKeys,
+		KMSEndpoint:       cfg.Database.KMSEndpoint,
+		KMSTokenPath:      cfg.Database.KMSTokenPath,
+		GCMWritesEnabled:  cfg.Database.GCMWritesEnabled,
+	})
+}
+
+func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string, driftDetector *drift.Detector) (*gardener.ShootController, error) {
 
 	syncPeriod := defaultSyncPeriod
 
@@ -65,7 +95,49 @@ func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient
 		return nil, fmt.Errorf("unable to create shoot controller manager: %w", err)
 	}
 
-	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath)
+	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath, driftDetector)
+}
+
+// newDriftDetector returns nil if drift detection is disabled
+func newDriftDetector(cfg config) (*drift.Detector, error) {
+	if !cfg.Drift.Enabled {
+		return nil, nil
+	}
+
+	policies, err := drift.LoadPolicies(cfg.Drift.PolicyConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return drift.NewDetector(policies), nil
+}
+
+// newCloudProfileProvider returns nil if validation against CloudProfiles is disabled
+func newCloudProfileProvider(cfg config, gardenerClusterCfg *restclient.Config) (cloudprofile.Provider, error) {
+	if !cfg.Gardener.CloudProfileValidationEnabled {
+		return nil, nil
+	}
+
+	clientset, err := versioned.NewForConfig(gardenerClusterCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gardener clientset: %s", err.Error())
+	}
+
+	return cloudprofile.NewInformerProvider(context.Background(), clientset, defaultSyncPeriod)
+}
+
+// newSeedPlacer returns nil if seed placement is disabled
+func newSeedPlacer(cfg config, gardenerClusterCfg *restclient.Config) (seedplacement.Placer, error) {
+	if !cfg.Gardener.SeedPlacementEnabled {
+		return nil, nil
+	}
+
+	clientset, err := versioned.NewForConfig(gardenerClusterCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gardener clientset: %s", err.Error())
+	}
+
+	return seedplacement.NewInformerPlacer(context.Background(), clientset, defaultSyncPeriod)
 }
 
 func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
@@ -15,19 +15,27 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/avast/retry-go"
+	dbr "github.com/gocraft/dbr/v2"
 	"github.com/gorilla/mux"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/healthz"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/network"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	provisioningStages "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/inmemory"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/quota"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/retention"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -45,19 +53,29 @@ type config struct {
 	PlaygroundAPIEndpoint string `envconfig:"default=/graphql"`
 
 	Database struct {
-		User        string `envconfig:"default=postgres"`
-		Password    string `envconfig:"default=password"`
-		Host        string `envconfig:"default=localhost"`
-		Port        string `envconfig:"default=5432"`
-		Name        string `envconfig:"default=provisioner"`
-		SSLMode     string `envconfig:"default=disable"`
-		SSLRootCert string `envconfig:"optional"`
-		SecretKey   string `envconfig:"optional"`
+		User              string `envconfig:"default=postgres"`
+		Password          string `envconfig:"default=password"`
+		Host              string `envconfig:"default=localhost"`
+		Port              string `envconfig:"default=5432"`
+		Name              string `envconfig:"default=provisioner"`
+		SSLMode           string `envconfig:"default=disable"`
+		SSLRootCert       string `envconfig:"optional"`
+		SecretKey         string `envconfig:"optional"`
+		SecretKeys        string `envconfig:"optional"`
+		SecretKeysPath    string `envconfig:"optional"`
+		KeyProvider       string `envconfig:"default=static"`
+		WrappedSecretKeys string `envconfig:"optional"`
+		KMSEndpoint       string `envconfig:"optional"`
+		KMSTokenPath      string `envconfig:"optional"`
+		GCMWritesEnabled  bool   `envconfig:"default=false"`
+		ReEncrypt         bool   `envconfig:"default=false"`
+		InMemory          bool   `envconfig:"default=false"`
 	}
 
 	ProvisioningTimeout   queue.ProvisioningTimeouts
 	DeprovisioningTimeout queue.DeprovisioningTimeouts
 	HibernationTimeout    queue.HibernationTimeouts
+	Scheduling            queue.SchedulingConfig
 
 	OperatorRoleBinding provisioningStages.OperatorRoleBinding
 
@@ -67,6 +85,11 @@ type config struct {
 		AuditLogsPolicyConfigMap                   string `envconfig:"optional"`
 		AuditLogsTenantConfigPath                  string `envconfig:"optional"`
 		MaintenanceWindowConfigPath                string `envconfig:"optional"`
+		RegionPolicyConfigPath                     string `envconfig:"optional"`
+		ExtensionsConfigPath                       string `envconfig:"optional"`
+		SeedPlacementEnabled                       bool   `envconfig:"default=false"`
+		CloudProfileValidationEnabled              bool   `envconfig:"default=false"`
+		ReservedSeedRanges                         string `envconfig:"optional"`
 		ClusterCleanupResourceSelector             string `envconfig:"default=https://service-manager."`
 		DefaultEnableKubernetesVersionAutoUpdate   bool   `envconfig:"default=false"`
 		DefaultEnableMachineImageVersionAutoUpdate bool   `envconfig:"default=false"`
@@ -74,6 +97,17 @@ type config struct {
 		EnableDumpShootSpec                        bool   `envconfig:"default=false"`
 	}
 
+	Quota struct {
+		ConfigPath string `envconfig:"optional"`
+	}
+
+	Retention retention.Config
+
+	Drift struct {
+		Enabled          bool   `envconfig:"default=false"`
+		PolicyConfigPath string `envconfig:"optional"`
+	}
+
 	EnqueueInProgressOperations bool `envconfig:"default=true"`
 
 	MetricsAddress string `envconfig:"default=127.0.0.1:9000"`
@@ -84,25 +118,33 @@ type config struct {
 func (c *config) String() string {
 	return fmt.Sprintf("Address: %s, APIEndpoint: %s, "+
 		"DatabaseUser: %s, DatabaseHost: %s, DatabasePort: %s, "+
-		"DatabaseName: %s, DatabaseSSLMode: %s, "+
+		"DatabaseName: %s, DatabaseSSLMode: %s, DatabaseKeyProvider: %s, DatabaseGCMWritesEnabled: %v, DatabaseInMemory: %v, "+
 		"ProvisioningTimeoutClusterCreation: %s "+
 		"ProvisioningTimeoutInstallation: %s, ProvisioningTimeoutUpgrade: %s, "+
 		"DeprovisioningNoInstallTimeoutClusterDeletion: %s, DeprovisioningNoInstallTimeoutWaitingForClusterDeletion: %s "+
 		"ShootUpgradeTimeout: %s, "+
 		"OperatorRoleBindingCreatingForAdmin: %t "+
-		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, DefaultEnableIMDSv2: %v "+
+		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, DefaultEnableIMDSv2: %v, SeedPlacementEnabled: %v, CloudProfileValidationEnabled: %v, ReservedSeedRanges: %s, ExtensionsConfigPath: %s "+
+		"ProvisioningWorkers: %d, DeprovisioningWorkers: %d, ShootUpgradeWorkers: %d, PlanWeights: %s "+
+		"QuotaConfigPath: %s "+
+		"RetentionEnabled: %v, RetentionInterval: %s, RetentionSecretsDays: %d, RetentionOperationsDays: %d, RetentionOperationsPolicy: %s "+
+		"DriftEnabled: %v, DriftPolicyConfigPath: %s "+
 		"EnqueueInProgressOperations: %v "+
 		"EnableDumpShootSpec: %v "+
 		"LogLevel: %s",
 		c.Address, c.APIEndpoint,
 		c.Database.User, c.Database.Host, c.Database.Port,
-		c.Database.Name, c.Database.SSLMode,
+		c.Database.Name, c.Database.SSLMode, c.Database.KeyProvider, c.Database.GCMWritesEnabled, c.Database.InMemory,
 		c.ProvisioningTimeout.ClusterCreation.String(),
 		c.ProvisioningTimeout.Installation.String(), c.ProvisioningTimeout.Upgrade.String(),
 		c.DeprovisioningTimeout.ClusterDeletion.String(), c.DeprovisioningTimeout.WaitingForClusterDeletion.String(),
 		c.ProvisioningTimeout.ShootUpgrade.String(),
 		c.OperatorRoleBinding.CreatingForAdmin,
-		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath, c.Gardener.DefaultEnableIMDSv2,
+		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath, c.Gardener.DefaultEnableIMDSv2, c.Gardener.SeedPlacementEnabled, c.Gardener.CloudProfileValidationEnabled, c.Gardener.ReservedSeedRanges, c.Gardener.ExtensionsConfigPath,
+		c.Scheduling.ProvisioningWorkers, c.Scheduling.DeprovisioningWorkers, c.Scheduling.ShootUpgradeWorkers, c.Scheduling.PlanWeights,
+		c.Quota.ConfigPath,
+		c.Retention.Enabled, c.Retention.Interval.String(), c.Retention.SecretsRetentionDays, c.Retention.OperationsRetentionDays, c.Retention.OperationsPolicy,
+		c.Drift.Enabled, c.Drift.PolicyConfigPath,
 		c.EnqueueInProgressOperations,
 		c.Gardener.EnableDumpShootSpec,
 		c.LogLevel)
@@ -128,15 +170,35 @@ func main() {
 	log.Infof("Starting Provisioner.")
 	log.Infof("Config: %s", cfg.String())
 
-	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
-		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
+	if cfg.Retention.Enabled {
+		err = cfg.Retention.Validate()
+		exitOnError(err, "Invalid retention config")
+	}
+
+	err = cfg.Scheduling.Validate()
+	exitOnError(err, "Invalid scheduling config")
+
+	var connection *dbr.Connection
+	var keyProvider dbsession.KeyProvider
+	var dbsFactory dbsession.Factory
 
-	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
-	exitOnError(err, "Failed to initialize persistence")
+	if cfg.Database.InMemory {
+		log.Warnf("Using in-memory database, data will be lost on restart")
+		dbsFactory = inmemory.NewFactory()
+	} else {
+		connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+			cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
 
-	dbsFactory, err := dbsession.NewFactory(connection, cfg.Database.SecretKey)
+		connection, err = database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
+		exitOnError(err, "Failed to initialize persistence")
 
-	exitOnError(err, "Cannot create database session")
+		keyProvider, err = newKeyProvider(cfg)
+		exitOnError(err, "Failed to create database encryption key provider")
+
+		dbsFactory, err = dbsession.NewFactory(connection, keyProvider)
+
+		exitOnError(err, "Cannot create database session")
+	}
 
 	gardenerNamespace := fmt.Sprintf("garden-%s", cfg.Gardener.Project)
 
@@ -163,18 +225,40 @@ func main() {
 	adminKubeconfigRequest := gardenerClient.SubResource("adminkubeconfig")
 	kubeconfigProvider := gardener.NewKubeconfigProvider(shootClient, adminKubeconfigRequest, secretsInterface)
 
-	provisioningQueue := queue.CreateProvisioningQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, shootClient)
+	planWeights, err := queue.ParsePlanWeights(cfg.Scheduling.PlanWeights)
+	exitOnError(err, "Failed to parse plan weights")
+	classifier := queue.NewPlanClassifier(dbsFactory.NewReadSession(), planWeights)
+
+	provisioningQueue := queue.CreateProvisioningQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider, cfg.Scheduling.ProvisioningWorkers, classifier)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider, cfg.Scheduling.ShootUpgradeWorkers, classifier)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, shootClient, cfg.Scheduling.DeprovisioningWorkers, classifier)
 
-	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath, testDataWriter)
-	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
+	regionPolicies, err := regionpolicy.NewProvider(cfg.Gardener.RegionPolicyConfigPath)
+	exitOnError(err, "Failed to load region policy config")
+
+	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath, regionPolicies, testDataWriter)
+	driftDetector, err := newDriftDetector(cfg)
+	exitOnError(err, "Failed to load drift policy config")
+
+	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath, driftDetector)
 	exitOnError(err, "Failed to create Shoot controller.")
 	go func() {
 		err := shootController.StartShootController()
 		exitOnError(err, "Failed to start Shoot Controller")
 	}()
 
+	defaultQuotaLimits, err := quota.LoadDefaultLimits(cfg.Quota.ConfigPath)
+	exitOnError(err, "Failed to load quota config")
+
+	extensionsConfig, err := extensions.LoadConfig(cfg.Gardener.ExtensionsConfigPath)
+	exitOnError(err, "Failed to load extensions config")
+
+	seedPlacer, err := newSeedPlacer(cfg, gardenerClusterConfig)
+	exitOnError(err, "Failed to create seed placer")
+
+	cloudProfiles, err := newCloudProfileProvider(cfg, gardenerClusterConfig)
+	exitOnError(err, "Failed to start CloudProfile informer")
+
 	provisioningSVC := newProvisioningService(
 		cfg.Gardener.Project,
 		provisioner,
@@ -187,10 +271,19 @@ func main() {
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableIMDSv2,
 		kubeconfigProvider,
+		quota.NewChecker(defaultQuotaLimits, dbsFactory),
+		regionPolicies,
+		seedPlacer,
+		cloudProfiles,
+		extensionsConfig,
 	)
 
 	tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
-	validator := api.NewValidator()
+
+	reservedRanges, err := network.ParseReservedRanges(cfg.Gardener.ReservedSeedRanges)
+	exitOnError(err, "Failed to parse reserved seed ranges")
+
+	validator := api.NewValidator(cloudProfiles, reservedRanges)
 	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater, testDataWriter)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -212,6 +305,7 @@ func main() {
 	log.Infof("Registering endpoint on %s...", cfg.APIEndpoint)
 	router := mux.NewRouter()
 	router.Use(middlewares.ExtractTenant)
+	router.Use(middlewares.ExtractCaller)
 
 	router.HandleFunc("/", playground.Handler("Dataloader", cfg.PlaygroundAPIEndpoint))
 
@@ -219,12 +313,14 @@ func main() {
 	gqlHandler.AddTransport(transport.POST{})
 	gqlHandler.AddTransport(transport.GET{})
 	gqlHandler.Use(extension.Introspection{})
+	gqlHandler.Use(middlewares.NewAuditLogger(dbsFactory, uuid.NewUUIDGenerator()))
 	gqlHandler.SetErrorPresenter(presenter.Do)
 	router.Handle(cfg.APIEndpoint, gqlHandler)
 	router.HandleFunc("/healthz", healthz.NewHTTPHandler(log.StandardLogger()))
 
 	// Metrics
-	err = metrics.Register(dbsFactory.NewReadSession())
+	purgedRows := metrics.NewPurgedRowsCounter()
+	err = metrics.Register(dbsFactory.NewReadSession(), dbsFactory.NewReadSession(), purgedRows)
 	exitOnError(err, "Failed to register metrics collectors")
 
 	// Expose metrics on different port as it cannot be secured with mTLS
@@ -261,6 +357,15 @@ func main() {
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
+	if cfg.Database.ReEncrypt && !cfg.Database.InMemory {
+		go reEncryptSecrets(connection, keyProvider)
+	}
+
+	if cfg.Retention.Enabled && !cfg.Database.InMemory {
+		purger := retention.NewPurger(connection, cfg.Retention, purgedRows)
+		go retention.NewJob(purger, cfg.Retention.Interval).Run(ctx)
+	}
+
 	wg.Wait()
 }
 
@@ -298,6 +403,20 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 	return nil
 }
 
+func reEncryptSecrets(connection *dbr.Connection, keyProvider dbsession.KeyProvider) {
+	result, err := dbsession.ReEncrypt(connection, keyProvider)
+	if errors.Is(err, dbsession.ErrReEncryptionInProgress) {
+		log.Info("Skipping re-encryption of stored secrets, as it is in progress on another replica")
+		return
+	}
+	if err != nil {
+		log.Errorf("Failed to re-encrypt stored secrets: %s", err.Error())
+		return
+	}
+
+	log.Infof("Re-encrypted %d kubeconfigs and %d administrators", result.Kubeconfigs, result.Administrators)
+}
+
 func exitOnError(err error, context string) {
 	if err != nil {
 		wrappedError := errors.Wrap(err, context)
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	connStringFormat          = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s sslrootcert=%s"
+	databaseConnectionRetries = 20
+)
+
+type config struct {
+	Database struct {
+		User              string `envconfig:"default=postgres"`
+		Password          string `envconfig:"default=password"`
+		Host              string `envconfig:"default=localhost"`
+		Port              string `envconfig:"default=5432"`
+		Name              string `envconfig:"default=provisioner"`
+		SSLMode           string `envconfig:"default=disable"`
+		SSLRootCert       string `envconfig:"optional"`
+		SecretKey         string `envconfig:"optional"`
+		SecretKeys        string `envconfig:"optional"`
+		SecretKeysPath    string `envconfig:"optional"`
+		KeyProvider       string `envconfig:"default=static"`
+		WrappedSecretKeys string `envconfig:"optional"`
+		KMSEndpoint       string `envconfig:"optional"`
+		KMSTokenPath      string `envconfig:"optional"`
+		GCMWritesEnabled  bool   `envconfig:"default=false"`
+	}
+}
+
+// Re-encrypts stored kubeconfigs and administrators with the newest key once, with the same key configuration as the Provisioner
+func main() {
+	log.SetFormatter(&log.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Failed to load re-encryption config")
+
+	keyProvider, err := dbsession.NewKeyProvider(dbsession.KeyProviderConfig{
+		Type:              cfg.Database.KeyProvider,
+		SecretKey:         cfg.Database.SecretKey,
+		SecretKeys:        cfg.Database.SecretKeys,
+		SecretKeysPath:    cfg.Database.SecretKeysPath,
+		WrappedSecretKeys: cfg.Database.WrappedSecretKeys,
+		KMSEndpoint:       cfg.Database.KMSEndpoint,
+		KMSTokenPath:      cfg.Database.KMSTokenPath,
+		GCMWritesEnabled:  cfg.Database.GCMWritesEnabled,
+	})
+	exitOnError(err, "Failed to create database encryption key provider")
+
+	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
+
+	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
+	exitOnError(err, "Failed to initialize database connection")
+	defer connection.Close()
+
+	result, err := dbsession.ReEncrypt(connection, keyProvider)
+	exitOnError(err, "Failed to re-encrypt stored secrets")
+
+	log.Infof("Re-encrypted %d kubeconfigs and %d administrators", result.Kubeconfigs, result.Administrators)
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
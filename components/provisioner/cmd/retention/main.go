@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/retention"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	connStringFormat          = "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s sslrootcert=%s"
+	databaseConnectionRetries = 20
+)
+
+type config struct {
+	Database struct {
+		User        string `envconfig:"default=postgres"`
+		Password    string `envconfig:"default=password"`
+		Host        string `envconfig:"default=localhost"`
+		Port        string `envconfig:"default=5432"`
+		Name        string `envconfig:"default=provisioner"`
+		SSLMode     string `envconfig:"default=disable"`
+		SSLRootCert string `envconfig:"optional"`
+	}
+
+	Retention retention.Config
+}
+
+// Purges the data of deleted clusters once, with the same configuration as the retention job of the Provisioner
+func main() {
+	log.SetFormatter(&log.TextFormatter{
+		FullTimestamp: true,
+	})
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Failed to load retention config")
+
+	err = cfg.Retention.Validate()
+	exitOnError(err, "Invalid retention config")
+
+	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
+		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
+
+	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
+	exitOnError(err, "Failed to initialize database connection")
+	defer connection.Close()
+
+	log.Infof("Purging deleted clusters with secrets retention of %d days, operations retention of %d days and %s policy",
+		cfg.Retention.SecretsRetentionDays, cfg.Retention.OperationsRetentionDays, cfg.Retention.OperationsPolicy)
+
+	result, err := retention.NewPurger(connection, cfg.Retention, nil).Purge()
+	exitOnError(err, "Failed to purge deleted clusters")
+
+	log.Infof("Purged %d kubeconfigs, %d administrators, archived %d operations, deleted %d operations and %d clusters",
+		result.Kubeconfigs, result.Administrators, result.ArchivedOperations, result.DeletedOperations, result.DeletedClusters)
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.Fatal(wrappedError)
+	}
+}
@@ -0,0 +1,181 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	mutationObject = "Mutation"
+	redactedValue  = "[REDACTED]"
+	unknownCaller  = "unknown"
+)
+
+// AuditLogger is a gqlgen extension recording every mutation issued against the API in the audit trail.
+type AuditLogger struct {
+	dbSessionFactory dbsession.Factory
+	uuidGenerator    uuid.UUIDGenerator
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = &AuditLogger{}
+
+func NewAuditLogger(factory dbsession.Factory, generator uuid.UUIDGenerator) *AuditLogger {
+	return &AuditLogger{
+		dbSessionFactory: factory,
+		uuidGenerator:    generator,
+	}
+}
+
+func (a *AuditLogger) ExtensionName() string {
+	return "AuditLogger"
+}
+
+func (a *AuditLogger) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a *AuditLogger) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldContext := graphql.GetFieldContext(ctx)
+	if fieldContext == nil || fieldContext.Object != mutationObject {
+		return next(ctx)
+	}
+
+	result, err := next(ctx)
+
+	event := a.newAuditEvent(ctx, fieldContext, result, err)
+	if auditErr := a.recordAuditEvent(event); auditErr != nil {
+		// The mutation is not reported as succeeded without its audit event, the client can repeat it with the same idempotency key or check the Runtime status
+		log.Errorf("Failed to record audit event for %s mutation: %s", event.Mutation, auditErr)
+		return nil, apperrors.Internal("failed to record audit event for %s mutation: %s", event.Mutation, auditErr)
+	}
+
+	return result, err
+}
+
+// recordAuditEvent retries the insert, so that a transient database failure does not fail the executed mutation
+func (a *AuditLogger) recordAuditEvent(event model.AuditEvent) error {
+	return retry.OnError(retry.DefaultBackoff, func(error) bool { return true }, func() error {
+		if dberr := a.dbSessionFactory.NewWriteSession().InsertAuditEvent(event); dberr != nil {
+			return dberr
+		}
+		return nil
+	})
+}
+
+func (a *AuditLogger) newAuditEvent(ctx context.Context, fieldContext *graphql.FieldContext, result interface{}, err error) model.AuditEvent {
+	event := model.AuditEvent{
+		ID:           a.uuidGenerator.New(),
+		Timestamp:    time.Now(),
+		Caller:       unknownCaller,
+		UserAgent:    stringFromContext(ctx, UserAgent),
+		Mutation:     fieldContext.Field.Name,
+		SubAccountID: stringFromContext(ctx, SubAccountID),
+		Outcome:      model.AuditEventSucceeded,
+	}
+
+	if caller := stringFromContext(ctx, Caller); caller != nil {
+		event.Caller = *caller
+	}
+	if tenant := stringFromContext(ctx, Tenant); tenant != nil {
+		event.Tenant = *tenant
+	}
+
+	if id, ok := fieldContext.Args["id"].(string); ok && id != "" {
+		event.RuntimeID = &id
+	}
+
+	input, sanitizeErr := sanitizeInput(fieldContext.Args)
+	if sanitizeErr != nil {
+		log.Warnf("Failed to sanitize input of %s mutation: %s", event.Mutation, sanitizeErr)
+	} else {
+		event.Input = &input
+	}
+
+	switch res := result.(type) {
+	case *gqlschema.OperationStatus:
+		if res != nil {
+			event.OperationID = res.ID
+			if event.RuntimeID == nil {
+				event.RuntimeID = res.RuntimeID
+			}
+		}
+	case string:
+		if res != "" {
+			event.OperationID = &res
+		}
+	}
+
+	if err != nil {
+		message := err.Error()
+		event.Outcome = model.AuditEventFailed
+		event.ErrMessage = &message
+	}
+
+	return event
+}
+
+func stringFromContext(ctx context.Context, key Header) *string {
+	value, ok := ctx.Value(key).(string)
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
+
+// sanitizeInput returns mutation arguments in JSON format with administrators and secret configuration values redacted.
+func sanitizeInput(args map[string]interface{}) (string, error) {
+	raw, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+
+	var input interface{}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return "", err
+	}
+
+	sanitized, err := json.Marshal(redact(input))
+	if err != nil {
+		return "", err
+	}
+
+	return string(sanitized), nil
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if key == "administrators" && field != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redact(field)
+		}
+		if secret, ok := v["secret"].(bool); ok && secret {
+			v["value"] = redactedValue
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+		return v
+	default:
+		return value
+	}
+}
@@ -0,0 +1,228 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	auditEventID = "4d9a2d3e-5c0f-4a3b-9e8f-4f1f6a1c2b3d"
+	operationID  = "ec781980-0533-4098-aab7-96b535569732"
+	runtimeID    = "1100bb59-9c40-4ebb-b846-7477c4dc5bbb"
+	tenant       = "tenant"
+	subAccount   = "sub-account"
+	caller       = "spiffe://cluster.local/ns/kcp-system/sa/kcp-kyma-environment-broker"
+)
+
+func TestAuditLogger_InterceptField(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	ctx = context.WithValue(ctx, middlewares.SubAccountID, subAccount)
+	ctx = context.WithValue(ctx, middlewares.Caller, caller)
+
+	provisionInput := gqlschema.ProvisionRuntimeInput{
+		RuntimeInput: &gqlschema.RuntimeInput{Name: "runtime"},
+		ClusterConfig: &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{Name: "shoot"},
+			Administrators: []string{"admin@example.com"},
+		},
+		KymaConfig: &gqlschema.KymaConfigInput{
+			Configuration: []*gqlschema.ConfigEntryInput{
+				{Key: "password", Value: "s3cr3t", Secret: util.PtrTo(true)},
+				{Key: "domain", Value: "example.com"},
+			},
+		},
+	}
+
+	t.Run("Should record succeeded mutation with sanitized input", func(t *testing.T) {
+		// given
+		factory, writeSession := sessionMocksWithWriteSession()
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(auditEventID)
+
+		var event model.AuditEvent
+		writeSession.On("InsertAuditEvent", mock.MatchedBy(func(e model.AuditEvent) bool {
+			event = e
+			return true
+		})).Return(nil)
+
+		auditLogger := middlewares.NewAuditLogger(factory, uuidGenerator)
+		fieldCtx := withFieldContext(ctx, "Mutation", "provisionRuntime", map[string]interface{}{"config": provisionInput})
+
+		status := &gqlschema.OperationStatus{ID: util.PtrTo(operationID), RuntimeID: util.PtrTo(runtimeID)}
+
+		// when
+		result, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return status, nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, status, result)
+
+		assert.Equal(t, auditEventID, event.ID)
+		assert.Equal(t, caller, event.Caller)
+		assert.Equal(t, tenant, event.Tenant)
+		assert.Equal(t, util.PtrTo(subAccount), event.SubAccountID)
+		assert.Equal(t, "provisionRuntime", event.Mutation)
+		assert.Equal(t, util.PtrTo(runtimeID), event.RuntimeID)
+		assert.Equal(t, util.PtrTo(operationID), event.OperationID)
+		assert.Equal(t, model.AuditEventSucceeded, event.Outcome)
+		assert.Nil(t, event.ErrMessage)
+
+		require.NotNil(t, event.Input)
+		assert.NotContains(t, *event.Input, "admin@example.com")
+		assert.NotContains(t, *event.Input, "s3cr3t")
+		assert.Contains(t, *event.Input, `"administrators":"[REDACTED]"`)
+		assert.Contains(t, *event.Input, `"value":"example.com"`)
+		writeSession.AssertExpectations(t)
+	})
+
+	t.Run("Should record failed mutation", func(t *testing.T) {
+		// given
+		factory, writeSession := sessionMocksWithWriteSession()
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(auditEventID)
+
+		var event model.AuditEvent
+		writeSession.On("InsertAuditEvent", mock.MatchedBy(func(e model.AuditEvent) bool {
+			event = e
+			return true
+		})).Return(nil)
+
+		auditLogger := middlewares.NewAuditLogger(factory, uuidGenerator)
+		fieldCtx := withFieldContext(ctx, "Mutation", "deprovisionRuntime", map[string]interface{}{"id": runtimeID})
+
+		// when
+		_, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return "", errors.New("runtime not found")
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, "deprovisionRuntime", event.Mutation)
+		assert.Equal(t, util.PtrTo(runtimeID), event.RuntimeID)
+		assert.Nil(t, event.OperationID)
+		assert.Equal(t, model.AuditEventFailed, event.Outcome)
+		assert.Equal(t, util.PtrTo("runtime not found"), event.ErrMessage)
+	})
+
+	t.Run("Should record unknown caller with user agent when no client certificate was forwarded", func(t *testing.T) {
+		// given
+		factory, writeSession := sessionMocksWithWriteSession()
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(auditEventID)
+
+		var event model.AuditEvent
+		writeSession.On("InsertAuditEvent", mock.MatchedBy(func(e model.AuditEvent) bool {
+			event = e
+			return true
+		})).Return(nil)
+
+		auditLogger := middlewares.NewAuditLogger(factory, uuidGenerator)
+		userAgentCtx := context.WithValue(context.WithValue(context.Background(), middlewares.Tenant, tenant), middlewares.UserAgent, "Go-http-client/1.1")
+		fieldCtx := withFieldContext(userAgentCtx, "Mutation", "deprovisionRuntime", map[string]interface{}{"id": runtimeID})
+
+		// when
+		_, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return operationID, nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "unknown", event.Caller)
+		assert.Equal(t, util.PtrTo("Go-http-client/1.1"), event.UserAgent)
+	})
+
+	t.Run("Should retry recording audit event", func(t *testing.T) {
+		// given
+		factory, writeSession := sessionMocksWithWriteSession()
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(auditEventID)
+
+		writeSession.On("InsertAuditEvent", mock.AnythingOfType("model.AuditEvent")).Return(dberrors.Internal("connection reset")).Once()
+		writeSession.On("InsertAuditEvent", mock.AnythingOfType("model.AuditEvent")).Return(nil).Once()
+
+		auditLogger := middlewares.NewAuditLogger(factory, uuidGenerator)
+		fieldCtx := withFieldContext(ctx, "Mutation", "deprovisionRuntime", map[string]interface{}{"id": runtimeID})
+
+		// when
+		result, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return operationID, nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, result)
+		writeSession.AssertExpectations(t)
+	})
+
+	t.Run("Should fail mutation when audit event cannot be recorded", func(t *testing.T) {
+		// given
+		factory, writeSession := sessionMocksWithWriteSession()
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		uuidGenerator.On("New").Return(auditEventID)
+
+		writeSession.On("InsertAuditEvent", mock.AnythingOfType("model.AuditEvent")).Return(dberrors.Internal("database unavailable"))
+
+		auditLogger := middlewares.NewAuditLogger(factory, uuidGenerator)
+		fieldCtx := withFieldContext(ctx, "Mutation", "deprovisionRuntime", map[string]interface{}{"id": runtimeID})
+
+		// when
+		result, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return operationID, nil
+		})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeInternal)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Should not record queries", func(t *testing.T) {
+		// given
+		factory := &sessionMocks.Factory{}
+		auditLogger := middlewares.NewAuditLogger(factory, &uuidMocks.UUIDGenerator{})
+		fieldCtx := withFieldContext(ctx, "Query", "runtimeStatus", map[string]interface{}{"id": runtimeID})
+
+		// when
+		_, err := auditLogger.InterceptField(fieldCtx, func(context.Context) (interface{}, error) {
+			return &gqlschema.RuntimeStatus{}, nil
+		})
+
+		// then
+		require.NoError(t, err)
+		factory.AssertNotCalled(t, "NewWriteSession")
+	})
+}
+
+func sessionMocksWithWriteSession() (*sessionMocks.Factory, *sessionMocks.WriteSession) {
+	factory := &sessionMocks.Factory{}
+	writeSession := &sessionMocks.WriteSession{}
+	factory.On("NewWriteSession").Return(writeSession)
+
+	return factory, writeSession
+}
+
+func withFieldContext(ctx context.Context, object, field string, args map[string]interface{}) context.Context {
+	return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: object,
+		Field:  graphql.CollectedField{Field: &ast.Field{Name: field}},
+		Args:   args,
+	})
+}
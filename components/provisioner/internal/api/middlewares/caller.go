@@ -0,0 +1,58 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const (
+	Caller    Header = "caller"
+	UserAgent Header = "user-agent"
+
+	ClientCertHeader = "X-Forwarded-Client-Cert"
+	UserAgentHeader  = "User-Agent"
+)
+
+// ExtractCaller stores identity of the API client in the request context.
+// The identity is taken only from the client certificate forwarded by the proxy. The User-Agent header is controlled by the client,
+// so it is stored separately and never used as the identity.
+func ExtractCaller(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if caller := callerFromClientCert(r.Header.Get(ClientCertHeader)); caller != "" {
+			ctx = context.WithValue(ctx, Caller, caller)
+		}
+		if userAgent := r.Header.Get(UserAgentHeader); userAgent != "" {
+			ctx = context.WithValue(ctx, UserAgent, userAgent)
+		}
+
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func callerFromClientCert(header string) string {
+	fields := map[string]string{}
+
+	for _, field := range strings.Split(header, ";") {
+		key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+		if !found {
+			continue
+		}
+		if strings.HasPrefix(value, `"`) {
+			value = strings.Trim(value, `"`)
+		} else {
+			value, _, _ = strings.Cut(value, ",")
+		}
+		if _, exists := fields[key]; !exists {
+			fields[key] = value
+		}
+	}
+
+	if uri := fields["URI"]; uri != "" {
+		return uri
+	}
+
+	return fields["Subject"]
+}
@@ -0,0 +1,72 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+)
+
+func TestExtractCaller(t *testing.T) {
+	for _, testCase := range []struct {
+		description       string
+		headers           map[string]string
+		expectedCaller    interface{}
+		expectedUserAgent interface{}
+	}{
+		{
+			description: "should take URI from forwarded client certificate",
+			headers: map[string]string{
+				middlewares.ClientCertHeader: `By=spiffe://cluster.local/ns/kcp-system/sa/provisioner;Hash=abc;Subject="CN=kcp-kyma-environment-broker,O=SAP";URI=` + caller,
+				middlewares.UserAgentHeader:  "Go-http-client/1.1",
+			},
+			expectedCaller:    caller,
+			expectedUserAgent: "Go-http-client/1.1",
+		},
+		{
+			description: "should take Subject from forwarded client certificate without URI",
+			headers: map[string]string{
+				middlewares.ClientCertHeader: `Hash=abc;Subject="CN=kcp-kyma-environment-broker,O=SAP"`,
+			},
+			expectedCaller: "CN=kcp-kyma-environment-broker,O=SAP",
+		},
+		{
+			description: "should store User-Agent separately without using it as caller",
+			headers: map[string]string{
+				middlewares.UserAgentHeader: "Go-http-client/1.1",
+			},
+			expectedCaller:    nil,
+			expectedUserAgent: "Go-http-client/1.1",
+		},
+		{
+			description:    "should not set caller when no identity is provided",
+			headers:        map[string]string{},
+			expectedCaller: nil,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			var callerValue, userAgentValue interface{}
+			handler := middlewares.ExtractCaller(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				callerValue = r.Context().Value(middlewares.Caller)
+				userAgentValue = r.Context().Value(middlewares.UserAgent)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+			req.Header.Del(middlewares.UserAgentHeader)
+			for key, value := range testCase.headers {
+				req.Header.Set(key, value)
+			}
+
+			// when
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			// then
+			assert.Equal(t, testCase.expectedCaller, callerValue)
+			assert.Equal(t, testCase.expectedUserAgent, userAgentValue)
+		})
+	}
+}
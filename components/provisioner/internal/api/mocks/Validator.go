@@ -14,6 +14,22 @@ type Validator struct {
 	mock.Mock
 }
 
+// ValidateImportRuntimeInput provides a mock function with given fields: shootName, tenant
+func (_m *Validator) ValidateImportRuntimeInput(shootName string, tenant string) apperrors.AppError {
+	ret := _m.Called(shootName, tenant)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(shootName, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ValidateProvisioningInput provides a mock function with given fields: input
 func (_m *Validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError {
 	ret := _m.Called(input)
@@ -30,6 +46,22 @@ func (_m *Validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeI
 	return r0
 }
 
+// ValidateRuntimeMetadataInput provides a mock function with given fields: labels, description
+func (_m *Validator) ValidateRuntimeMetadataInput(labels gqlschema.Labels, description *string) apperrors.AppError {
+	ret := _m.Called(labels, description)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(gqlschema.Labels, *string) apperrors.AppError); ok {
+		r0 = rf(labels, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // ValidateUpgradeShootInput provides a mock function with given fields: input
 func (_m *Validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput) apperrors.AppError {
 	ret := _m.Called(input)
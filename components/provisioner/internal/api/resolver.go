@@ -3,7 +3,10 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
@@ -50,7 +53,7 @@ func NewResolver(provisioningService provisioning.Service, validator Validator,
 	}
 }
 
-func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput) (*gqlschema.OperationStatus, error) {
+func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, idempotencyKey *string) (*gqlschema.OperationStatus, error) {
 	err := r.validator.ValidateProvisioningInput(config)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s", err)
@@ -78,7 +81,7 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 		}
 	}
 
-	operationStatus, err := r.provisioning.ProvisionRuntime(config, tenant, subAccount)
+	operationStatus, err := r.provisioning.ProvisionRuntime(config, tenant, subAccount, idempotencyKey)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s: %s", config.RuntimeInput.Name, err)
 		return nil, err
@@ -142,6 +145,151 @@ func (r *Resolver) RuntimeStatus(ctx context.Context, runtimeID string) (*gqlsch
 	return status, nil
 }
 
+func (r *Resolver) AuditEvents(ctx context.Context, runtimeID string, from *time.Time, to *time.Time) ([]*gqlschema.AuditEvent, error) {
+	log.Infof("Requested to get audit events for Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to get audit events for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	events, err := r.provisioning.AuditEvents(runtimeID, from, to)
+	if err != nil {
+		log.Errorf("Failed to get audit events for Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+	log.Infof("Getting audit events for Runtime %s succeeded.", runtimeID)
+
+	return events, nil
+}
+
+func (r *Resolver) RuntimeConfigRevisions(ctx context.Context, id string) ([]*gqlschema.RuntimeConfigRevision, error) {
+	log.Infof("Requested to get Gardener config revisions for Runtime %s.", id)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(id, ctx)
+	if err != nil {
+		log.Errorf("Failed to get Gardener config revisions for Runtime %s: %s", id, err)
+		return nil, err
+	}
+
+	revisions, err := r.provisioning.RuntimeConfigRevisions(id)
+	if err != nil {
+		log.Errorf("Failed to get Gardener config revisions for Runtime %s: %s", id, err)
+		return nil, err
+	}
+	log.Infof("Getting Gardener config revisions for Runtime %s succeeded.", id)
+
+	return revisions, nil
+}
+
+func (r *Resolver) RuntimeConfigRevisionDiff(ctx context.Context, id string, from int, to int) ([]*gqlschema.ConfigChange, error) {
+	log.Infof("Requested to compare Gardener config revisions %d and %d for Runtime %s.", from, to, id)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(id, ctx)
+	if err != nil {
+		log.Errorf("Failed to compare Gardener config revisions for Runtime %s: %s", id, err)
+		return nil, err
+	}
+
+	changes, err := r.provisioning.RuntimeConfigRevisionDiff(id, from, to)
+	if err != nil {
+		log.Errorf("Failed to compare Gardener config revisions for Runtime %s: %s", id, err)
+		return nil, err
+	}
+	log.Infof("Comparing Gardener config revisions for Runtime %s succeeded.", id)
+
+	return changes, nil
+}
+
+func (r *Resolver) TenantQuota(ctx context.Context, tenant string) (*gqlschema.TenantQuota, error) {
+	log.Infof("Requested to get quota of tenant %s.", tenant)
+
+	err := r.verifyTenant(ctx, tenant)
+	if err != nil {
+		log.Errorf("Failed to get quota of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+
+	tenantQuota, err := r.provisioning.TenantQuota(tenant)
+	if err != nil {
+		log.Errorf("Failed to get quota of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+	log.Infof("Getting quota of tenant %s succeeded.", tenant)
+
+	return tenantQuota, nil
+}
+
+func (r *Resolver) ImportRuntime(ctx context.Context, shootName string, tenant string, subAccount *string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested import of Shoot %s for tenant %s.", shootName, tenant)
+
+	err := r.verifyTenant(ctx, tenant)
+	if err != nil {
+		log.Errorf("Failed to import Shoot %s: %s", shootName, err)
+		return nil, err
+	}
+
+	err = r.validator.ValidateImportRuntimeInput(shootName, tenant)
+	if err != nil {
+		log.Errorf("Failed to import Shoot %s: %s", shootName, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.ImportRuntime(shootName, tenant, subAccount)
+	if err != nil {
+		log.Errorf("Failed to import Shoot %s: %s", shootName, err)
+		return nil, err
+	}
+	log.Infof("Shoot %s imported as Runtime %s.", shootName, *status.RuntimeID)
+
+	return status, nil
+}
+
+func (r *Resolver) UpdateRuntimeMetadata(ctx context.Context, id string, labels gqlschema.Labels, description *string) (*gqlschema.RuntimeStatus, error) {
+	log.Infof("Requested to update metadata of Runtime %s.", id)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(id, ctx)
+	if err != nil {
+		log.Errorf("Failed to update metadata of Runtime %s: %s", id, err)
+		return nil, err
+	}
+
+	err = r.validator.ValidateRuntimeMetadataInput(labels, description)
+	if err != nil {
+		log.Errorf("Failed to update metadata of Runtime %s: %s", id, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.UpdateRuntimeMetadata(id, labels, description)
+	if err != nil {
+		log.Errorf("Failed to update metadata of Runtime %s: %s", id, err)
+		return nil, err
+	}
+	log.Infof("Updating metadata of Runtime %s succeeded.", id)
+
+	return status, nil
+}
+
+func (r *Resolver) RuntimeIDs(ctx context.Context, tenant string, labels gqlschema.Labels) ([]string, error) {
+	log.Infof("Requested to list Runtimes of tenant %s.", tenant)
+
+	err := r.verifyTenant(ctx, tenant)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+
+	runtimeIDs, err := r.provisioning.RuntimeIDs(tenant, labels)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes of tenant %s: %s", tenant, err)
+		return nil, err
+	}
+	log.Infof("Listing Runtimes of tenant %s succeeded.", tenant)
+
+	return runtimeIDs, nil
+}
+
 func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to get Runtime operation status for Operation %s.", operationID)
 
@@ -162,7 +310,7 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
-func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
+func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput, idempotencyKey *string) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
 	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
@@ -177,7 +325,7 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 		return nil, err
 	}
 
-	status, err := r.provisioning.UpgradeGardenerShoot(runtimeID, input)
+	status, err := r.provisioning.UpgradeGardenerShoot(runtimeID, input, idempotencyKey)
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
 		return nil, err
@@ -199,3 +347,16 @@ func getSubAccount(ctx context.Context) string {
 	}
 	return subAccount
 }
+
+// verifyTenant checks that the tenant passed as the argument is the tenant from the header, so that the data of other tenants are not exposed
+func (r *Resolver) verifyTenant(ctx context.Context, tenant string) apperrors.AppError {
+	headerTenant, err := r.tenantUpdater.GetTenant(ctx)
+	if err != nil {
+		return err
+	}
+	if tenant != headerTenant {
+		return apperrors.Forbidden("tenant %s does not match the tenant header", tenant)
+	}
+
+	return nil
+}
@@ -161,7 +161,7 @@ func azureGardenerClusterConfigInput(zones ...string) gqlschema.ClusterConfigInp
 			MachineImageVersion: util.PtrTo("8.0"),
 			DiskType:            util.PtrTo("Standard_LRS"),
 			VolumeSizeGb:        util.PtrTo(40),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
@@ -169,7 +169,7 @@ func azureGardenerClusterConfigInput(zones ...string) gqlschema.ClusterConfigInp
 			ExposureClassName:   util.PtrTo("exp-class"),
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
-					VnetCidr: "cidr",
+					VnetCidr: "10.250.0.0/16",
 					Zones:    zones,
 				},
 			},
@@ -193,14 +193,14 @@ func azureGardenerClusterConfigInputNoSeed(zones ...string) gqlschema.ClusterCon
 			MachineImageVersion: util.PtrTo("8.0"),
 			DiskType:            util.PtrTo("Standard_LRS"),
 			VolumeSizeGb:        util.PtrTo(40),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
 			MaxUnavailable:      2,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
-					VnetCidr: "cidr",
+					VnetCidr: "10.250.0.0/16",
 					Zones:    zones,
 				},
 			},
@@ -223,7 +223,7 @@ func openStackGardenerClusterConfigInput() gqlschema.ClusterConfigInput {
 			MachineType:         "t3-xlarge",
 			MachineImage:        util.PtrTo("red-hat"),
 			MachineImageVersion: util.PtrTo("8.0"),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"os"
 	"path/filepath"
@@ -36,6 +37,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/quota"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
@@ -58,6 +60,7 @@ const (
 	namespace  = "default"
 	syncPeriod = 3 * time.Second
 	waitPeriod = 5 * time.Second
+	workers    = 5
 
 	kymaVersion                   = "1.8"
 	kymaSystemNamespace           = "kyma-system"
@@ -129,7 +132,8 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	seedInterface := seeds.NewFakeSeedsInterface(t, cfg)
 	secretsInterface := setupSecretsClient(t, cfg)
 	secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-	dbsFactory, _ := dbsession.NewFactory(connection, secretKey)
+	keyProvider, _ := dbsession.NewStaticKeyProvider(secretKey, nil)
+	dbsFactory, _ := dbsession.NewFactory(connection, keyProvider)
 
 	queueCtx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -137,22 +141,26 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	kubeconfigProviderMock := &kubeconfigprovidermock.KubeconfigProvider{}
 	kubeconfigProviderMock.On("FetchFromRequest", mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
 
+	classifier := queue.NewPlanClassifier(dbsFactory.NewReadSession(), map[string]int{})
+
 	provisioningQueue := queue.CreateProvisioningQueue(
 		testProvisioningTimeouts(),
 		dbsFactory,
 		shootInterface,
 		testOperatorRoleBinding(),
 		mockK8sClientProvider,
-		kubeconfigProviderMock)
+		kubeconfigProviderMock,
+		workers,
+		classifier)
 	provisioningQueue.Run(queueCtx.Done())
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, shootInterface)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, shootInterface, workers, classifier)
 	deprovisioningQueue.Run(queueCtx.Done())
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock)
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock, workers, classifier)
 	shootUpgradeQueue.Run(queueCtx.Done())
 
-	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
+	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath, nil)
 	require.NoError(t, err)
 
 	go func() {
@@ -185,9 +193,9 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			tmpDir, err := os.MkdirTemp("", "")
 			require.NoError(t, err)
 
-			provisioner := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath, testkit.NewTestDataWriter("kyma-dev", tmpDir, true))
+			provisioner := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath, nil, testkit.NewTestDataWriter("kyma-dev", tmpDir, true))
 
-			inputConverter := provisioning.NewInputConverter(uuidGeneratorMock, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+			inputConverter := provisioning.NewInputConverter(uuidGeneratorMock, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
 			provisioningService := provisioning.NewProvisioningService(
@@ -200,9 +208,12 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 				provisioningQueue,
 				deprovisioningQueue,
 				shootUpgradeQueue,
-				kubeconfigProviderMock)
+				kubeconfigProviderMock,
+				quota.NewChecker(model.QuotaLimits{}, dbsFactory),
+				nil,
+				nil, nil)
 
-			validator := api.NewValidator()
+			validator := api.NewValidator(nil, nil)
 
 			tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 
@@ -222,7 +233,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 func testProvisionRuntime(t *testing.T, ctx context.Context, resolver *api.Resolver, fullConfig gqlschema.ProvisionRuntimeInput, runtimeID string, shootInterface gardener_apis.ShootInterface, secretsInterface v1core.SecretInterface, auditLogConfig *gardener.AuditLogConfig) {
 
 	// when Provisioning Runtime
-	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig)
+	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig, nil)
 
 	// then
 	require.NoError(t, err)
@@ -298,7 +309,7 @@ func testUpgradeGardenerShoot(t *testing.T, ctx context.Context, resolver *api.R
 	runtimeBeforeUpgrade, err := readSession.GetCluster(runtimeID)
 	require.NoError(t, err)
 
-	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 	require.NoError(t, err)
 
 	// for wait for shoot new version step
@@ -18,6 +18,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -90,11 +91,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(operation, nil)
+		provisioningService.On("ProvisionRuntime", config, tenant, "", (*string)(nil)).Return(operation, nil)
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := resolver.ProvisionRuntime(ctx, config)
+		status, err := resolver.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.NoError(t, err)
@@ -125,7 +126,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(apperrors.BadRequest("Some error"))
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -152,11 +153,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		config := gqlschema.ProvisionRuntimeInput{RuntimeInput: runtimeInput, ClusterConfig: clusterConfig, KymaConfig: kymaConfig}
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(nil, apperrors.Internal("Provisioning failed"))
+		provisioningService.On("ProvisionRuntime", config, tenant, "", (*string)(nil)).Return(nil, apperrors.Internal("Provisioning failed"))
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -193,7 +194,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -400,12 +401,12 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
-		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
+		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput, (*string)(nil)).Return(operation, nil)
 
 		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
 
 		//when
-		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 
 		//then
 		require.NoError(t, err)
@@ -427,7 +428,7 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		resolver := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
 
 		//when
-		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 
 		//then
 		require.Error(t, err)
@@ -458,3 +459,134 @@ func dnsInput() *gqlschema.DNSConfigInput {
 		},
 	}
 }
+
+func TestResolver_TenantQuota(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should return quota of tenant from header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, &validatorMocks.Validator{}, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantQuota := &gqlschema.TenantQuota{Tenant: tenant}
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("TenantQuota", tenant).Return(tenantQuota, nil)
+
+		// when
+		result, err := provisioner.TenantQuota(ctx, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, tenantQuota, result)
+	})
+
+	t.Run("Should return error when tenant does not match tenant header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, &validatorMocks.Validator{}, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		// when
+		result, err := provisioner.TenantQuota(ctx, "other-tenant")
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Nil(t, result)
+		provisioningService.AssertNotCalled(t, "TenantQuota", mock.Anything)
+	})
+}
+
+func TestResolver_RuntimeIDs(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	labels := gqlschema.Labels{"team": "core"}
+
+	t.Run("Should return Runtimes of tenant from header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, &validatorMocks.Validator{}, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		provisioningService.On("RuntimeIDs", tenant, labels).Return([]string{"runtime-1"}, nil)
+
+		// when
+		result, err := provisioner.RuntimeIDs(ctx, tenant, labels)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"runtime-1"}, result)
+	})
+
+	t.Run("Should return error when tenant does not match tenant header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, &validatorMocks.Validator{}, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		// when
+		result, err := provisioner.RuntimeIDs(ctx, "other-tenant", labels)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Nil(t, result)
+		provisioningService.AssertNotCalled(t, "RuntimeIDs", mock.Anything, mock.Anything)
+	})
+}
+
+func TestResolver_ImportRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should import shoot for tenant from header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+		validator.On("ValidateImportRuntimeInput", "shoot", tenant).Return(nil)
+		provisioningService.On("ImportRuntime", "shoot", tenant, (*string)(nil)).Return(&gqlschema.OperationStatus{
+			ID:        util.PtrTo(operationID),
+			RuntimeID: util.PtrTo(runtimeID),
+		}, nil)
+
+		// when
+		status, err := provisioner.ImportRuntime(ctx, "shoot", tenant, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, runtimeID, *status.RuntimeID)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when tenant does not match tenant header", func(t *testing.T) {
+		// given
+		provisioningService := &mocks.Service{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, &validatorMocks.Validator{}, tenantUpdater, &testkit.TestDataWriter{})
+
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		// when
+		status, err := provisioner.ImportRuntime(ctx, "shoot", "other-tenant", nil)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Nil(t, status)
+		provisioningService.AssertNotCalled(t, "ImportRuntime", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -1,27 +1,46 @@
 package api
 
 import (
+	"net/netip"
 	"strings"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/network"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const RuntimeAgent = "compass-runtime-agent"
 
 //go:generate mockery --name=Validator
 type Validator interface {
+	// ValidateProvisioningInput validates the input, including the zones allocated for the zone allocation
+	// and the values of its Gardener config against the CloudProfile.
+	// The input is not modified, the service allocates the zones and completes the omitted versions after computing the idempotency key.
 	ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError
+	// ValidateUpgradeShootInput validates the input, including the zones allocated for the zone allocation.
+	// The input is not modified, the service allocates the zones after computing the idempotency key.
 	ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput) apperrors.AppError
+	ValidateImportRuntimeInput(shootName, tenant string) apperrors.AppError
+	ValidateRuntimeMetadataInput(labels gqlschema.Labels, description *string) apperrors.AppError
 }
 
 type validator struct {
+	cloudProfiles  cloudprofile.Provider
+	reservedRanges []netip.Prefix
 }
 
-func NewValidator() Validator {
-	return &validator{}
+// NewValidator validates the Gardener config against the CloudProfiles if the provider is not nil.
+// The networks of the shoots cannot overlap with the reserved ranges.
+func NewValidator(cloudProfiles cloudprofile.Provider, reservedRanges []netip.Prefix) Validator {
+	return &validator{
+		cloudProfiles:  cloudProfiles,
+		reservedRanges: reservedRanges,
+	}
 }
 
 func (v *validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError {
@@ -35,10 +54,41 @@ func (v *validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeIn
 		return apperrors.BadRequest("runtime input validation error while starting Runtime provisioning: runtime input is missing")
 	}
 
+	if err := v.validateRuntimeLabels(input.RuntimeInput.Labels); err != nil {
+		return err.Append("runtime input validation error while starting Runtime provisioning")
+	}
+
 	if err := v.validateClusterConfig(input.ClusterConfig); err != nil {
 		return err.Append("Cluster config validation error while starting Runtime provisioning")
 	}
 
+	if err := v.validateCloudProfileValues(*input.ClusterConfig.GardenerConfig); err != nil {
+		return err.Append("Cluster config validation error while starting Runtime provisioning")
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateImportRuntimeInput(shootName, tenant string) apperrors.AppError {
+	if shootName == "" {
+		return apperrors.BadRequest("shoot name is required")
+	}
+	if tenant == "" {
+		return apperrors.BadRequest("tenant is required")
+	}
+
+	return nil
+}
+
+func (v *validator) ValidateRuntimeMetadataInput(labels gqlschema.Labels, description *string) apperrors.AppError {
+	if labels == nil && description == nil {
+		return apperrors.BadRequest("validation error while updating Runtime metadata: labels or description must be provided")
+	}
+
+	if err := v.validateRuntimeLabels(labels); err != nil {
+		return err.Append("validation error while updating Runtime metadata")
+	}
+
 	return nil
 }
 
@@ -46,6 +96,16 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 
 	config := input.GardenerConfig
 
+	if input.Revision != nil {
+		if config != nil {
+			return apperrors.BadRequest("validation error while starting starting Shoot Upgrade: Gardener Config and revision cannot be provided together")
+		}
+		if *input.Revision < 1 {
+			return apperrors.BadRequest("validation error while starting starting Shoot Upgrade: revision must be greater than 0")
+		}
+		return nil
+	}
+
 	if config == nil {
 		return apperrors.BadRequest("validation error while starting starting Shoot Upgrade: Gardener Config is missing")
 	}
@@ -66,6 +126,24 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	// The zones added on upgrade are validated here, the zones already used by the shoot are validated against the shoot when the upgrade starts
+	providerConfig, err := network.AllocateZones(config.ProviderSpecificConfig)
+	if err != nil {
+		return err.Append("validation error while starting Shoot Upgrade")
+	}
+
+	if err := network.ValidateZones(providerConfig); err != nil {
+		return err.Append("validation error while starting Shoot Upgrade")
+	}
+
+	if err := network.ValidateNetworkingUpgrade(config.Networking); err != nil {
+		return err.Append("validation error while starting Shoot Upgrade")
+	}
+
+	if err := v.validateKubeAPIServer(config.KubeAPIServer); err != nil {
+		return err.Append("validation error while starting Shoot Upgrade")
+	}
+
 	return nil
 }
 
@@ -90,6 +168,19 @@ func (v *validator) validateComponents(kymaConfig *gqlschema.KymaConfigInput) (a
 	return nil, false
 }
 
+// Runtime labels are set on the shoot with the common prefix, so the keys must be valid names of labels
+func (v *validator) validateRuntimeLabels(labels gqlschema.Labels) apperrors.AppError {
+	for key := range labels {
+		if strings.Contains(key, "/") {
+			return apperrors.BadRequest("error: label key %s must not contain prefix", key)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return apperrors.BadRequest("error: invalid label key %s: %s", key, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
 func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfigInput) apperrors.AppError {
 	if clusterConfig == nil || clusterConfig.GardenerConfig == nil {
 		return apperrors.BadRequest("error: Cluster config with Gardener config not provided")
@@ -105,6 +196,93 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateSeedSelection(gardenerConfig); err != nil {
+		return err
+	}
+
+	providerConfig, err := network.AllocateZones(gardenerConfig.ProviderSpecificConfig)
+	if err != nil {
+		return err
+	}
+	gardenerConfig.ProviderSpecificConfig = providerConfig
+
+	if err := network.ValidateGardenerConfig(gardenerConfig, v.reservedRanges); err != nil {
+		return err
+	}
+
+	if err := network.ValidateNetworking(gardenerConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateKubeAPIServer(gardenerConfig.KubeAPIServer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *validator) validateKubeAPIServer(kubeAPIServer *gqlschema.KubeAPIServerInput) apperrors.AppError {
+	if kubeAPIServer == nil {
+		return nil
+	}
+
+	featureGates := map[string]bool{}
+	for _, featureGate := range kubeAPIServer.FeatureGates {
+		if featureGate.Name == "" {
+			return apperrors.BadRequest("error: feature gate name is empty")
+		}
+		if featureGates[featureGate.Name] {
+			return apperrors.BadRequest("error: feature gate %s is set more than once", featureGate.Name)
+		}
+		featureGates[featureGate.Name] = true
+	}
+
+	apiGroupVersions := map[string]bool{}
+	for _, entry := range kubeAPIServer.RuntimeConfig {
+		if entry.APIGroupVersion == "" {
+			return apperrors.BadRequest("error: runtime config API group version is empty")
+		}
+		if apiGroupVersions[entry.APIGroupVersion] {
+			return apperrors.BadRequest("error: runtime config API group version %s is set more than once", entry.APIGroupVersion)
+		}
+		apiGroupVersions[entry.APIGroupVersion] = true
+	}
+
+	if requests := kubeAPIServer.Requests; requests != nil {
+		if util.UnwrapOrZero(requests.MaxNonMutatingInflight) < 0 || util.UnwrapOrZero(requests.MaxMutatingInflight) < 0 {
+			return apperrors.BadRequest("error: maximum number of requests in flight must not be negative")
+		}
+	}
+
+	if serviceAccount := kubeAPIServer.ServiceAccount; serviceAccount != nil && serviceAccount.Issuer != nil && *serviceAccount.Issuer == "" {
+		return apperrors.BadRequest("error: service account issuer is empty")
+	}
+
+	if watchCacheSizes := kubeAPIServer.WatchCacheSizes; watchCacheSizes != nil {
+		if util.UnwrapOrZero(watchCacheSizes.DefaultSize) < 0 {
+			return apperrors.BadRequest("error: default watch cache size must not be negative")
+		}
+		resources := map[string]bool{}
+		for _, resource := range watchCacheSizes.Resources {
+			if resource.Resource == "" {
+				return apperrors.BadRequest("error: watch cache resource is empty")
+			}
+			if resource.Size < 0 {
+				return apperrors.BadRequest("error: watch cache size of resource %s must not be negative", resource.Resource)
+			}
+			key := util.UnwrapOrZero(resource.APIGroup) + "/" + resource.Resource
+			if resources[key] {
+				return apperrors.BadRequest("error: watch cache size of resource %s is set more than once", resource.Resource)
+			}
+			resources[key] = true
+		}
+	}
+
+	if configMap := kubeAPIServer.AuditPolicyConfigMap; configMap != nil {
+		if errs := validation.IsDNS1123Subdomain(*configMap); len(errs) > 0 {
+			return apperrors.BadRequest("error: invalid audit policy ConfigMap name %s: %s", *configMap, strings.Join(errs, ", "))
+		}
+	}
 	return nil
 }
 
@@ -115,6 +293,20 @@ func (v *validator) validateMachineImage(gardenerConfig gqlschema.GardenerConfig
 	return nil
 }
 
+// Seed selector cannot select the seed which was already chosen explicitly
+func (v *validator) validateSeedSelection(gardenerConfig gqlschema.GardenerConfigInput) apperrors.AppError {
+	if util.UnwrapOrZero(gardenerConfig.ShootAndSeedSameRegion) && util.NotNilOrEmpty(gardenerConfig.Seed) {
+		return apperrors.BadRequest("error: shootAndSeedSameRegion cannot be combined with seed")
+	}
+	return nil
+}
+
+// Values rejected by the CloudProfile would fail only in Gardener admission, after the provisioning is started
+func (v *validator) validateCloudProfileValues(gardenerConfig gqlschema.GardenerConfigInput) apperrors.AppError {
+	_, err := provisioning.ResolveCloudProfileValues(v.cloudProfiles, gardenerConfig)
+	return err
+}
+
 // OpenStack does not accept diskType or volumeSize
 func (v *validator) validateOpenStackVolume(diskType *string, volumeSizeGb *int, provider string) apperrors.AppError {
 	if strings.ToLower(provider) == "openstack" {
@@ -1,12 +1,17 @@
 package api
 
 import (
+	"net/netip"
 	"testing"
 
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	cloudProfileMocks "github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestValidator_ValidateProvisioningInput(t *testing.T) {
@@ -14,7 +19,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when config is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -31,7 +36,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when kyma config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -45,9 +50,29 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Should return error when runtime label key is invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput: &gqlschema.RuntimeInput{
+				Name:   runtimeInput.Name,
+				Labels: gqlschema.Labels{"example.com/team": "core"},
+			},
+			ClusterConfig: clusterConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
 	t.Run("Should return error when config is incorrect", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{}
 
@@ -60,7 +85,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return error when Runtime Agent component is not passed in installation config", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -87,7 +112,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("should return error when machine image version is set, but machine image is empty", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		testClusterConfig := clusterConfig
 		testClusterConfig.GardenerConfig.MachineImageVersion = util.PtrTo("24.3")
@@ -105,6 +130,50 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("should return error when shootAndSeedSameRegion is combined with seed", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		testClusterConfig := &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{
+				Name:                   "tets-clst",
+				KubernetesVersion:      "1.15.4",
+				MachineType:            "n1-standard-4",
+				Region:                 "europe",
+				Provider:               "gcp",
+				Seed:                   util.PtrTo("2"),
+				ShootAndSeedSameRegion: util.PtrTo(true),
+				TargetSecret:           "test-secret",
+				WorkerCidr:             "10.250.0.0/16",
+				AutoScalerMin:          1,
+				AutoScalerMax:          3,
+				MaxSurge:               40,
+				MaxUnavailable:         1,
+			},
+		}
+
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: testClusterConfig,
+			KymaConfig:    kymaConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+
+		testClusterConfig.GardenerConfig.Seed = nil
+
+		//when
+		err = validator.ValidateProvisioningInput(config)
+
+		//then
+		require.NoError(t, err)
+	})
+
 	t.Run("should return error when diskType or VolumeSizeGb is passed to openstack provisioning mutation", func(t *testing.T) {
 		openStackClusterConfig := &gqlschema.ClusterConfigInput{
 			GardenerConfig: &gqlschema.GardenerConfigInput{
@@ -117,7 +186,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 				Seed:                   util.PtrTo("2"),
 				TargetSecret:           "test-secret",
 				DiskType:               util.PtrTo("ssd"),
-				WorkerCidr:             "10.10.10.10/255",
+				WorkerCidr:             "10.250.0.0/16",
 				AutoScalerMin:          1,
 				AutoScalerMax:          3,
 				MaxSurge:               40,
@@ -132,7 +201,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		//when
 		err := validator.ValidateProvisioningInput(config)
@@ -151,11 +220,169 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 	})
 }
 
+func TestValidator_ValidateProvisioningInput_Networks(t *testing.T) {
+	_, runtimeInput, _ := initializeConfigs()
+
+	t.Run("Should return error when networks overlap with reserved range", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, []netip.Prefix{netip.MustParsePrefix("10.250.0.0/24")})
+
+		clusterConfig, _, _ := initializeConfigs()
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Contains(t, err.Error(), "workerCidr 10.250.0.0/16 overlaps with reserved range 10.250.0.0/24")
+	})
+
+	t.Run("Should validate allocated subnets of AWS zones without modifying input", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		clusterConfig, _, _ := initializeConfigs()
+		clusterConfig.GardenerConfig.ProviderSpecificConfig = &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/16",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a"}},
+			},
+		}
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.NoError(t, err)
+		assert.Empty(t, clusterConfig.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones)
+		assert.Equal(t, &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a"}}, clusterConfig.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZoneAllocation)
+	})
+
+	t.Run("Should return error when AWS config has no zones", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		clusterConfig, _, _ := initializeConfigs()
+		clusterConfig.GardenerConfig.ProviderSpecificConfig = &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16"},
+		}
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Contains(t, err.Error(), "AWS config requires at least one zone")
+	})
+
+	t.Run("Should return error when worker CIDR is malformed", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		clusterConfig, _, _ := initializeConfigs()
+		clusterConfig.GardenerConfig.WorkerCidr = "10.10.10.10/255"
+		config := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestValidator_ValidateProvisioningInput_CloudProfile(t *testing.T) {
+	_, runtimeInput, _ := initializeConfigs()
+
+	cloudProfile := &gardener_types.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+		Spec: gardener_types.CloudProfileSpec{
+			Kubernetes: gardener_types.KubernetesSettings{
+				Versions: []gardener_types.ExpirableVersion{
+					{Version: "1.27.4"},
+					{Version: "1.27.3", Classification: util.PtrTo(gardener_types.ClassificationDeprecated)},
+				},
+			},
+			MachineImages: []gardener_types.MachineImage{
+				{Name: "gardenlinux", Versions: []gardener_types.MachineImageVersion{{ExpirableVersion: gardener_types.ExpirableVersion{Version: "934.10.0"}}}},
+			},
+			MachineTypes: []gardener_types.MachineType{{Name: "n2-standard-4"}},
+		},
+	}
+
+	newConfig := func(kubernetesVersion, machineType string) gqlschema.ProvisionRuntimeInput {
+		clusterConfig, _, _ := initializeConfigs()
+		clusterConfig.GardenerConfig.KubernetesVersion = kubernetesVersion
+		clusterConfig.GardenerConfig.MachineType = machineType
+		clusterConfig.GardenerConfig.MachineImage = util.PtrTo("gardenlinux")
+		clusterConfig.GardenerConfig.ProviderSpecificConfig = &gqlschema.ProviderSpecificInput{GcpConfig: &gqlschema.GCPProviderConfigInput{}}
+
+		return gqlschema.ProvisionRuntimeInput{
+			RuntimeInput:  runtimeInput,
+			ClusterConfig: clusterConfig,
+		}
+	}
+
+	t.Run("Should accept versions omitted in Gardener config without modifying input", func(t *testing.T) {
+		//given
+		cloudProfiles := &cloudProfileMocks.Provider{}
+		cloudProfiles.On("CloudProfile", "gcp").Return(cloudProfile, nil)
+		validator := NewValidator(cloudProfiles, nil)
+
+		config := newConfig("1.27", "n2-standard-4")
+
+		//when
+		err := validator.ValidateProvisioningInput(config)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, "1.27", config.ClusterConfig.GardenerConfig.KubernetesVersion)
+		assert.Nil(t, config.ClusterConfig.GardenerConfig.MachineImageVersion)
+	})
+
+	t.Run("Should return error when value is not supported by CloudProfile", func(t *testing.T) {
+		//given
+		cloudProfiles := &cloudProfileMocks.Provider{}
+		cloudProfiles.On("CloudProfile", "gcp").Return(cloudProfile, nil)
+		validator := NewValidator(cloudProfiles, nil)
+
+		for _, config := range []gqlschema.ProvisionRuntimeInput{
+			newConfig("1.27.3", "n2-standard-4"),
+			newConfig("1.27.4", "n1-standard-4"),
+		} {
+			//when
+			err := validator.ValidateProvisioningInput(config)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		}
+	})
+}
+
 func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return nil when input is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -179,9 +406,59 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Should validate allocated subnets of Azure zones added on upgrade without modifying input", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+					AzureConfig: &gqlschema.AzureProviderConfigInput{
+						VnetCidr:            "10.250.0.0/16",
+						AzureZoneAllocation: &gqlschema.AzureZoneAllocationInput{Names: []int{1, 2}},
+					},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+		assert.Empty(t, input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones)
+		assert.Equal(t, &gqlschema.AzureZoneAllocationInput{Names: []int{1, 2}}, input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZoneAllocation)
+	})
+
+	t.Run("Should return error when subnets of AWS zones overlap", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+					AwsConfig: &gqlschema.AWSProviderConfigInput{
+						VpcCidr: "10.250.0.0/16",
+						AwsZones: []*gqlschema.AWSZoneInput{
+							{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+							{Name: "eu-central-1b", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.96.0/20", InternalCidr: "10.250.112.0/20"},
+						},
+					},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
 	t.Run("Should return error when Gardener config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.UpgradeShootInput{}
 
@@ -195,7 +472,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for machine type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -222,7 +499,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for disk type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -249,7 +526,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for purpose", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -276,7 +553,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for kubernetes version", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -300,6 +577,130 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
 	})
+
+	t.Run("Should return nil when only revision is provided", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			Revision: util.PtrTo(2),
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should return error when both Gardener config and revision are provided", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				KubernetesVersion: util.PtrTo("1.20.8"),
+			},
+			Revision: util.PtrTo(2),
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when revision is not positive", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			Revision: util.PtrTo(0),
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestValidator_ValidateUpgradeShootInput_KubeAPIServer(t *testing.T) {
+	t.Run("Should return nil when kube-apiserver settings are correct", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				KubeAPIServer: &gqlschema.KubeAPIServerInput{
+					FeatureGates:         []*gqlschema.FeatureGateInput{{Name: "ValidatingAdmissionPolicy", Enabled: true}},
+					RuntimeConfig:        []*gqlschema.RuntimeConfigEntryInput{{APIGroupVersion: "admissionregistration.k8s.io/v1beta1", Enabled: true}},
+					Requests:             &gqlschema.KubeAPIServerRequestsInput{MaxMutatingInflight: util.PtrTo(400)},
+					ServiceAccount:       &gqlschema.ServiceAccountInput{Issuer: util.PtrTo("https://issuer.example.com"), ExtendTokenExpiration: util.PtrTo(false)},
+					WatchCacheSizes:      &gqlschema.WatchCacheSizesInput{DefaultSize: util.PtrTo(100), Resources: []*gqlschema.ResourceWatchCacheSizeInput{{Resource: "secrets", Size: 500}}},
+					AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	for _, testCase := range []struct {
+		description   string
+		kubeAPIServer *gqlschema.KubeAPIServerInput
+	}{
+		{
+			description:   "feature gate is set more than once",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{FeatureGates: []*gqlschema.FeatureGateInput{{Name: "Gate", Enabled: true}, {Name: "Gate"}}},
+		},
+		{
+			description:   "runtime config API group version is empty",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{RuntimeConfig: []*gqlschema.RuntimeConfigEntryInput{{Enabled: true}}},
+		},
+		{
+			description:   "maximum number of requests in flight is negative",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{Requests: &gqlschema.KubeAPIServerRequestsInput{MaxNonMutatingInflight: util.PtrTo(-1)}},
+		},
+		{
+			description:   "service account issuer is empty",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{ServiceAccount: &gqlschema.ServiceAccountInput{Issuer: util.PtrTo("")}},
+		},
+		{
+			description: "watch cache size of resource is set more than once",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{WatchCacheSizes: &gqlschema.WatchCacheSizesInput{
+				Resources: []*gqlschema.ResourceWatchCacheSizeInput{{Resource: "secrets", Size: 100}, {Resource: "secrets", Size: 200}},
+			}},
+		},
+		{
+			description:   "audit policy ConfigMap name is invalid",
+			kubeAPIServer: &gqlschema.KubeAPIServerInput{AuditPolicyConfigMap: util.PtrTo("Audit_Policy")},
+		},
+	} {
+		t.Run("Should return error when "+testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator(nil, nil)
+
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{KubeAPIServer: testCase.kubeAPIServer},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
 }
 
 func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput, *gqlschema.KymaConfigInput) {
@@ -314,7 +715,7 @@ func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput
 			Seed:                   util.PtrTo("2"),
 			TargetSecret:           "test-secret",
 			DiskType:               util.PtrTo("ssd"),
-			WorkerCidr:             "10.10.10.10/255",
+			WorkerCidr:             "10.250.0.0/16",
 			AutoScalerMin:          1,
 			AutoScalerMax:          3,
 			MaxSurge:               40,
@@ -343,3 +744,75 @@ func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput
 	}
 	return clusterConfig, runtimeInput, kymaConfig
 }
+
+func TestValidator_ValidateImportRuntimeInput(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		shootName   string
+		tenant      string
+	}{
+		{description: "Should return error when shoot name is empty", shootName: "", tenant: "tenant"},
+		{description: "Should return error when tenant is empty", shootName: "shoot", tenant: ""},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator(nil, nil)
+
+			//when
+			err := validator.ValidateImportRuntimeInput(testCase.shootName, testCase.tenant)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("Should return nil when input is correct", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		//when
+		err := validator.ValidateImportRuntimeInput("shoot", "tenant")
+
+		//then
+		require.NoError(t, err)
+	})
+}
+
+func TestValidator_ValidateRuntimeMetadataInput(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		labels      gqlschema.Labels
+		runtimeDesc *string
+	}{
+		{description: "Should return error when neither labels nor description is provided"},
+		{description: "Should return error when label key has prefix", labels: gqlschema.Labels{"example.com/team": "core"}},
+		{description: "Should return error when label key is invalid", labels: gqlschema.Labels{"team name": "core"}},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			validator := NewValidator(nil, nil)
+
+			//when
+			err := validator.ValidateRuntimeMetadataInput(testCase.labels, testCase.runtimeDesc)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("Should return nil when input is correct", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		//when
+		err := validator.ValidateRuntimeMetadataInput(gqlschema.Labels{"team": "core", "cost-center": 42}, nil)
+		require.NoError(t, err)
+
+		err = validator.ValidateRuntimeMetadataInput(nil, util.PtrTo(""))
+
+		//then
+		require.NoError(t, err)
+	})
+}
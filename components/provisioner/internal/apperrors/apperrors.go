@@ -18,9 +18,10 @@ const (
 )
 
 const (
-	ErrProvisionerInternal     ErrReason = "err_provisioner_internal"
-	ErrProvisionerTimeout      ErrReason = "err_provisioner_timeout"
-	ErrProvisionerStepNotFound ErrReason = "err_provisioner_step_not_found"
+	ErrProvisionerInternal      ErrReason = "err_provisioner_internal"
+	ErrProvisionerTimeout       ErrReason = "err_provisioner_timeout"
+	ErrProvisionerStepNotFound  ErrReason = "err_provisioner_step_not_found"
+	ErrProvisionerQuotaExceeded ErrReason = "err_provisioner_quota_exceeded"
 )
 
 type ErrCode int
@@ -30,6 +31,7 @@ const (
 	CodeBadGateway ErrCode = 502
 	CodeInternal   ErrCode = 500
 	CodeExternal   ErrCode = 501
+	CodeConflict   ErrCode = 409
 	CodeForbidden  ErrCode = 403
 	CodeBadRequest ErrCode = 400
 )
@@ -83,6 +85,10 @@ func BadRequest(format string, a ...interface{}) AppError {
 	return errorf(CodeBadRequest, Unknown, format, a...)
 }
 
+func Conflict(format string, a ...interface{}) AppError {
+	return errorf(CodeConflict, Unknown, format, a...)
+}
+
 func InvalidTenant(format string, a ...interface{}) AppError {
 	return errorf(CodeBadRequest, TenantNotFound, format, a...)
 }
@@ -12,6 +12,7 @@ func TestAppError(t *testing.T) {
 		assert.Equal(t, CodeInternal, Internal("error").Code())
 		assert.Equal(t, CodeForbidden, Forbidden("error").Code())
 		assert.Equal(t, CodeBadRequest, BadRequest("error").Code())
+		assert.Equal(t, CodeConflict, Conflict("error").Code())
 	})
 
 	t.Run("should create error with simple message", func(t *testing.T) {
@@ -0,0 +1,67 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	backfill "github.com/kyma-project/control-plane/components/provisioner/internal/backfill"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NetworkingStore is an autogenerated mock type for the NetworkingStore type
+type NetworkingStore struct {
+	mock.Mock
+}
+
+// ListMissingNetworking provides a mock function with given fields:
+func (_m *NetworkingStore) ListMissingNetworking() ([]backfill.RuntimeNetworking, error) {
+	ret := _m.Called()
+
+	var r0 []backfill.RuntimeNetworking
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]backfill.RuntimeNetworking, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []backfill.RuntimeNetworking); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]backfill.RuntimeNetworking)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateNetworking provides a mock function with given fields: networking
+func (_m *NetworkingStore) UpdateNetworking(networking backfill.RuntimeNetworking) error {
+	ret := _m.Called(networking)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(backfill.RuntimeNetworking) error); ok {
+		r0 = rf(networking)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNetworkingStore creates a new instance of NetworkingStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkingStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkingStore {
+	mock := &NetworkingStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
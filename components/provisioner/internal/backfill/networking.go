@@ -0,0 +1,152 @@
+package backfill
+
+import (
+	"context"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RuntimeNetworking holds the networking CIDRs of the Runtime stored in the database
+type RuntimeNetworking struct {
+	RuntimeID    string
+	ShootName    string
+	PodsCIDR     *string
+	ServicesCIDR *string
+}
+
+//go:generate mockery --name=NetworkingStore
+type NetworkingStore interface {
+	// ListMissingNetworking lists Runtimes which are not deleted and have no pods or services CIDR stored
+	ListMissingNetworking() ([]RuntimeNetworking, error)
+	// UpdateNetworking stores the CIDRs which are missing, the stored CIDRs are left unchanged
+	UpdateNetworking(networking RuntimeNetworking) error
+}
+
+type ShootGetter interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*gardener_types.Shoot, error)
+}
+
+type NetworkingResult struct {
+	Updated int
+	Skipped int
+	Failed  int
+}
+
+// NetworkingBackfill stores pods and services CIDRs of Runtimes provisioned before the CIDRs were persisted, reading them from the shoots
+type NetworkingBackfill struct {
+	store       NetworkingStore
+	shootClient ShootGetter
+	log         log.FieldLogger
+}
+
+func NewNetworkingBackfill(store NetworkingStore, shootClient ShootGetter) *NetworkingBackfill {
+	return &NetworkingBackfill{
+		store:       store,
+		shootClient: shootClient,
+		log:         log.WithField("backfill", "networking"),
+	}
+}
+
+// Run backfills all Runtimes. It continues when a single Runtime fails, so that it can be run again to retry only the failed ones.
+func (b *NetworkingBackfill) Run(ctx context.Context) (NetworkingResult, error) {
+	runtimes, err := b.store.ListMissingNetworking()
+	if err != nil {
+		return NetworkingResult{}, errors.Wrap(err, "while listing Runtimes without networking CIDRs")
+	}
+
+	var result NetworkingResult
+	for _, runtime := range runtimes {
+		logger := b.log.WithField("runtimeID", runtime.RuntimeID).WithField("shoot", runtime.ShootName)
+
+		shoot, err := b.shootClient.Get(ctx, runtime.ShootName, metav1.GetOptions{})
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				logger.Warn("Shoot not found, skipping")
+				result.Skipped++
+				continue
+			}
+			logger.Errorf("Failed to get shoot: %s", err.Error())
+			result.Failed++
+			continue
+		}
+
+		networking := shootNetworking(runtime, shoot)
+		if networking.PodsCIDR == nil && networking.ServicesCIDR == nil {
+			logger.Warn("Shoot has no pods and services CIDRs, skipping")
+			result.Skipped++
+			continue
+		}
+
+		if err := b.store.UpdateNetworking(networking); err != nil {
+			logger.Errorf("Failed to update networking CIDRs: %s", err.Error())
+			result.Failed++
+			continue
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
+
+func shootNetworking(runtime RuntimeNetworking, shoot *gardener_types.Shoot) RuntimeNetworking {
+	networking := RuntimeNetworking{
+		RuntimeID: runtime.RuntimeID,
+		ShootName: runtime.ShootName,
+	}
+	if shoot.Spec.Networking == nil {
+		return networking
+	}
+
+	if runtime.PodsCIDR == nil {
+		networking.PodsCIDR = shoot.Spec.Networking.Pods
+	}
+	if runtime.ServicesCIDR == nil {
+		networking.ServicesCIDR = shoot.Spec.Networking.Services
+	}
+
+	return networking
+}
+
+type networkingStore struct {
+	connection *dbr.Connection
+}
+
+// NewNetworkingStore creates the store, which reads and updates the networking CIDRs in the gardener_config table
+func NewNetworkingStore(connection *dbr.Connection) NetworkingStore {
+	return networkingStore{connection: connection}
+}
+
+func (s networkingStore) ListMissingNetworking() ([]RuntimeNetworking, error) {
+	var runtimes []RuntimeNetworking
+
+	_, err := s.connection.NewSession(nil).
+		Select("gardener_config.cluster_id AS runtime_id", "gardener_config.name AS shoot_name", "pods_cidr", "services_cidr").
+		From("gardener_config").
+		Join("cluster", "gardener_config.cluster_id=cluster.id").
+		Where(dbr.And(
+			dbr.Eq("cluster.deleted", false),
+			dbr.Or(dbr.Eq("pods_cidr", nil), dbr.Eq("services_cidr", nil)),
+		)).
+		Load(&runtimes)
+	if err != nil {
+		return nil, err
+	}
+
+	return runtimes, nil
+}
+
+func (s networkingStore) UpdateNetworking(networking RuntimeNetworking) error {
+	_, err := s.connection.NewSession(nil).
+		Update("gardener_config").
+		Set("pods_cidr", dbr.Expr("COALESCE(pods_cidr, ?)", networking.PodsCIDR)).
+		Set("services_cidr", dbr.Expr("COALESCE(services_cidr, ?)", networking.ServicesCIDR)).
+		Where(dbr.Eq("cluster_id", networking.RuntimeID)).
+		Exec()
+
+	return err
+}
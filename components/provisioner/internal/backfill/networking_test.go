@@ -0,0 +1,93 @@
+package backfill_test
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/backfill"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/backfill/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const namespace = "garden-project"
+
+func TestNetworkingBackfill_Run(t *testing.T) {
+	t.Run("should store CIDRs read from shoots", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset(
+			newShoot("shoot-1", &gardener_types.Networking{Pods: util.PtrTo("100.64.0.0/12"), Services: util.PtrTo("100.104.0.0/13")}),
+			newShoot("shoot-2", &gardener_types.Networking{Pods: util.PtrTo("10.96.0.0/11"), Services: util.PtrTo("10.104.0.0/13")}),
+		).CoreV1beta1().Shoots(namespace)
+
+		store := &mocks.NetworkingStore{}
+		store.On("ListMissingNetworking").Return([]backfill.RuntimeNetworking{
+			{RuntimeID: "runtime-1", ShootName: "shoot-1"},
+			{RuntimeID: "runtime-2", ShootName: "shoot-2", PodsCIDR: util.PtrTo("10.96.0.0/11")},
+		}, nil)
+		store.On("UpdateNetworking", backfill.RuntimeNetworking{
+			RuntimeID: "runtime-1", ShootName: "shoot-1", PodsCIDR: util.PtrTo("100.64.0.0/12"), ServicesCIDR: util.PtrTo("100.104.0.0/13"),
+		}).Return(nil)
+		store.On("UpdateNetworking", backfill.RuntimeNetworking{
+			RuntimeID: "runtime-2", ShootName: "shoot-2", ServicesCIDR: util.PtrTo("10.104.0.0/13"),
+		}).Return(nil)
+
+		// when
+		result, err := backfill.NewNetworkingBackfill(store, shootClient).Run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, backfill.NetworkingResult{Updated: 2}, result)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("should skip Runtimes without shoot or networking and continue after failure", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset(
+			newShoot("no-networking", nil),
+			newShoot("failing", &gardener_types.Networking{Pods: util.PtrTo("100.64.0.0/12")}),
+		).CoreV1beta1().Shoots(namespace)
+
+		store := &mocks.NetworkingStore{}
+		store.On("ListMissingNetworking").Return([]backfill.RuntimeNetworking{
+			{RuntimeID: "runtime-1", ShootName: "missing"},
+			{RuntimeID: "runtime-2", ShootName: "no-networking"},
+			{RuntimeID: "runtime-3", ShootName: "failing"},
+		}, nil)
+		store.On("UpdateNetworking", backfill.RuntimeNetworking{
+			RuntimeID: "runtime-3", ShootName: "failing", PodsCIDR: util.PtrTo("100.64.0.0/12"),
+		}).Return(errors.New("some error"))
+
+		// when
+		result, err := backfill.NewNetworkingBackfill(store, shootClient).Run(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, backfill.NetworkingResult{Skipped: 2, Failed: 1}, result)
+		store.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to list Runtimes", func(t *testing.T) {
+		// given
+		store := &mocks.NetworkingStore{}
+		store.On("ListMissingNetworking").Return(nil, errors.New("some error"))
+
+		// when
+		_, err := backfill.NewNetworkingBackfill(store, fake.NewSimpleClientset().CoreV1beta1().Shoots(namespace)).Run(context.Background())
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func newShoot(name string, networking *gardener_types.Networking) *gardener_types.Shoot {
+	return &gardener_types.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       gardener_types.ShootSpec{Networking: networking},
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Provider is an autogenerated mock type for the Provider type
+type Provider struct {
+	mock.Mock
+}
+
+// CloudProfile provides a mock function with given fields: name
+func (_m *Provider) CloudProfile(name string) (*v1beta1.CloudProfile, apperrors.AppError) {
+	ret := _m.Called(name)
+
+	var r0 *v1beta1.CloudProfile
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (*v1beta1.CloudProfile, apperrors.AppError)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *v1beta1.CloudProfile); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.CloudProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(name)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewProvider creates a new instance of Provider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Provider {
+	mock := &Provider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
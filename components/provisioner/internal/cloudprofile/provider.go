@@ -0,0 +1,52 @@
+package cloudprofile
+
+import (
+	"context"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardener_listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/pkg/errors"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+//go:generate mockery --name=Provider
+type Provider interface {
+	// CloudProfile returns the CloudProfile with the given name, the BadRequest error is returned if it does not exist
+	CloudProfile(name string) (*gardener_types.CloudProfile, apperrors.AppError)
+}
+
+type informerProvider struct {
+	lister gardener_listers.CloudProfileLister
+}
+
+// NewInformerProvider provides CloudProfiles from the cache of the informer, so that they are not read from Gardener on every request.
+// The informer is started and the cache is synchronized before the provider is returned, the informer is stopped when the context is done.
+func NewInformerProvider(ctx context.Context, client versioned.Interface, resync time.Duration) (Provider, error) {
+	factory := externalversions.NewSharedInformerFactory(client, resync)
+	lister := factory.Core().V1beta1().CloudProfiles().Lister()
+
+	factory.Start(ctx.Done())
+	for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, errors.Errorf("failed to synchronize cache of %s informer", informerType)
+		}
+	}
+
+	return &informerProvider{lister: lister}, nil
+}
+
+func (p *informerProvider) CloudProfile(name string) (*gardener_types.CloudProfile, apperrors.AppError) {
+	profile, err := p.lister.Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, apperrors.BadRequest("CloudProfile %s does not exist", name)
+		}
+		return nil, apperrors.Internal("failed to get CloudProfile %s: %s", name, err.Error())
+	}
+
+	return profile, nil
+}
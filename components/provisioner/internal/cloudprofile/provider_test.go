@@ -0,0 +1,39 @@
+package cloudprofile
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInformerProvider_CloudProfile(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	provider, err := NewInformerProvider(ctx, fake.NewSimpleClientset(fixCloudProfile()), time.Minute)
+	require.NoError(t, err)
+
+	t.Run("should return CloudProfile from cache", func(t *testing.T) {
+		// when
+		profile, err := provider.CloudProfile("gcp")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "gcp", profile.Spec.Type)
+	})
+
+	t.Run("should return bad request when CloudProfile does not exist", func(t *testing.T) {
+		// when
+		_, err := provider.CloudProfile("az")
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
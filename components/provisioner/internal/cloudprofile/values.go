@@ -0,0 +1,204 @@
+package cloudprofile
+
+import (
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/hashicorp/go-version"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+)
+
+// Values contains the values of the shoot which are constrained by the CloudProfile, empty values are not validated
+type Values struct {
+	Region              string
+	KubernetesVersion   string
+	MachineType         string
+	MachineImage        string
+	MachineImageVersion string
+}
+
+// Resolve validates the values against the CloudProfile and completes the omitted versions.
+// The Kubernetes version without the patch is completed with its latest supported patch version,
+// and the missing version of the machine image is completed with the latest supported version of the image.
+func Resolve(profile *gardener_types.CloudProfile, values Values, now time.Time) (Values, apperrors.AppError) {
+	if err := validateRegion(profile, values.Region); err != nil {
+		return Values{}, err
+	}
+
+	if err := validateMachineType(profile, values.MachineType, values.Region); err != nil {
+		return Values{}, err
+	}
+
+	if values.KubernetesVersion != "" {
+		kubernetesVersion, err := resolveVersion(profile, "Kubernetes", profile.Spec.Kubernetes.Versions, values.KubernetesVersion, isMinorVersion(values.KubernetesVersion), now)
+		if err != nil {
+			return Values{}, err
+		}
+		values.KubernetesVersion = kubernetesVersion
+	}
+
+	if values.MachineImage != "" {
+		image, found := machineImage(profile, values.MachineImage)
+		if !found {
+			return Values{}, apperrors.BadRequest("machine image %s is not available in CloudProfile %s", values.MachineImage, profile.Name)
+		}
+
+		versions := make([]gardener_types.ExpirableVersion, 0, len(image.Versions))
+		for _, imageVersion := range image.Versions {
+			versions = append(versions, imageVersion.ExpirableVersion)
+		}
+
+		imageVersion, err := resolveVersion(profile, "machine image "+values.MachineImage, versions, values.MachineImageVersion, values.MachineImageVersion == "", now)
+		if err != nil {
+			return Values{}, err
+		}
+		values.MachineImageVersion = imageVersion
+	}
+
+	return values, nil
+}
+
+func validateRegion(profile *gardener_types.CloudProfile, region string) apperrors.AppError {
+	if region == "" || len(profile.Spec.Regions) == 0 {
+		return nil
+	}
+
+	if _, found := profileRegion(profile, region); !found {
+		return apperrors.BadRequest("region %s is not available in CloudProfile %s", region, profile.Name)
+	}
+	return nil
+}
+
+func validateMachineType(profile *gardener_types.CloudProfile, machineType, region string) apperrors.AppError {
+	if machineType == "" {
+		return nil
+	}
+
+	for _, profileMachineType := range profile.Spec.MachineTypes {
+		if profileMachineType.Name != machineType {
+			continue
+		}
+		if profileMachineType.Usable != nil && !*profileMachineType.Usable {
+			return apperrors.BadRequest("machine type %s is not usable in CloudProfile %s", machineType, profile.Name)
+		}
+		if !availableInAnyZone(profile, machineType, region) {
+			return apperrors.BadRequest("machine type %s is not available in any zone of %s region", machineType, region)
+		}
+		return nil
+	}
+
+	return apperrors.BadRequest("machine type %s is not available in CloudProfile %s", machineType, profile.Name)
+}
+
+func availableInAnyZone(profile *gardener_types.CloudProfile, machineType, region string) bool {
+	profileRegion, found := profileRegion(profile, region)
+	if !found || len(profileRegion.Zones) == 0 {
+		return true
+	}
+
+	for _, zone := range profileRegion.Zones {
+		if !contains(zone.UnavailableMachineTypes, machineType) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveVersion returns the requested version if it can be used, or the latest supported version starting with the requested prefix if latest is true
+func resolveVersion(profile *gardener_types.CloudProfile, subject string, versions []gardener_types.ExpirableVersion, requested string, latest bool, now time.Time) (string, apperrors.AppError) {
+	if latest {
+		resolved, found := latestSupportedVersion(versions, requested, now)
+		if !found && requested == "" {
+			return "", apperrors.BadRequest("no supported %s version is available in CloudProfile %s", subject, profile.Name)
+		}
+		if !found {
+			return "", apperrors.BadRequest("no supported %s version %s.x is available in CloudProfile %s", subject, requested, profile.Name)
+		}
+		return resolved, nil
+	}
+
+	for _, expirable := range versions {
+		if expirable.Version != requested {
+			continue
+		}
+		if expired(expirable, now) {
+			return "", apperrors.BadRequest("%s version %s expired on %s", subject, requested, expirable.ExpirationDate.UTC().Format(time.RFC3339))
+		}
+		if classification(expirable) == gardener_types.ClassificationDeprecated {
+			return "", apperrors.BadRequest("%s version %s is deprecated in CloudProfile %s", subject, requested, profile.Name)
+		}
+		return requested, nil
+	}
+
+	return "", apperrors.BadRequest("%s version %s is not available in CloudProfile %s", subject, requested, profile.Name)
+}
+
+func latestSupportedVersion(versions []gardener_types.ExpirableVersion, prefix string, now time.Time) (string, bool) {
+	var latest *version.Version
+	for _, expirable := range versions {
+		if prefix != "" && !strings.HasPrefix(expirable.Version, prefix+".") {
+			continue
+		}
+		if expired(expirable, now) || classification(expirable) != gardener_types.ClassificationSupported {
+			continue
+		}
+
+		parsed, err := version.NewVersion(expirable.Version)
+		if err != nil {
+			continue
+		}
+		if latest == nil || parsed.GreaterThan(latest) {
+			latest = parsed
+		}
+	}
+
+	if latest == nil {
+		return "", false
+	}
+	return latest.Original(), true
+}
+
+// isMinorVersion reports whether the Kubernetes version is requested without the patch version
+func isMinorVersion(kubernetesVersion string) bool {
+	return strings.Count(kubernetesVersion, ".") == 1
+}
+
+// Versions without classification are supported, as Gardener defaults them to supported
+func classification(expirable gardener_types.ExpirableVersion) gardener_types.VersionClassification {
+	if expirable.Classification == nil {
+		return gardener_types.ClassificationSupported
+	}
+	return *expirable.Classification
+}
+
+func expired(expirable gardener_types.ExpirableVersion, now time.Time) bool {
+	return expirable.ExpirationDate != nil && !now.Before(expirable.ExpirationDate.Time)
+}
+
+func machineImage(profile *gardener_types.CloudProfile, name string) (gardener_types.MachineImage, bool) {
+	for _, image := range profile.Spec.MachineImages {
+		if image.Name == name {
+			return image, true
+		}
+	}
+	return gardener_types.MachineImage{}, false
+}
+
+func profileRegion(profile *gardener_types.CloudProfile, name string) (gardener_types.Region, bool) {
+	for _, region := range profile.Spec.Regions {
+		if region.Name == name {
+			return region, true
+		}
+	}
+	return gardener_types.Region{}, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package cloudprofile
+
+import (
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+func TestResolve(t *testing.T) {
+	profile := fixCloudProfile()
+
+	for _, testCase := range []struct {
+		description string
+		values      Values
+		expected    Values
+	}{
+		{
+			description: "should accept supported values",
+			values:      Values{Region: "europe-west3", KubernetesVersion: "1.27.4", MachineType: "n2-standard-4", MachineImage: "gardenlinux", MachineImageVersion: "934.10.0"},
+			expected:    Values{Region: "europe-west3", KubernetesVersion: "1.27.4", MachineType: "n2-standard-4", MachineImage: "gardenlinux", MachineImageVersion: "934.10.0"},
+		},
+		{
+			description: "should accept preview version",
+			values:      Values{KubernetesVersion: "1.28.0"},
+			expected:    Values{KubernetesVersion: "1.28.0"},
+		},
+		{
+			description: "should complete latest supported patch version",
+			values:      Values{KubernetesVersion: "1.27"},
+			expected:    Values{KubernetesVersion: "1.27.4"},
+		},
+		{
+			description: "should complete latest supported machine image version",
+			values:      Values{MachineImage: "gardenlinux"},
+			expected:    Values{MachineImage: "gardenlinux", MachineImageVersion: "934.10.0"},
+		},
+		{
+			description: "should not complete machine image version without machine image",
+			values:      Values{MachineType: "n2-standard-4"},
+			expected:    Values{MachineType: "n2-standard-4"},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			values, err := Resolve(profile, testCase.values, now)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, values)
+		})
+	}
+
+	for _, testCase := range []struct {
+		description string
+		values      Values
+		message     string
+	}{
+		{
+			description: "should reject unknown region",
+			values:      Values{Region: "mars-north1"},
+			message:     "region mars-north1 is not available in CloudProfile gcp",
+		},
+		{
+			description: "should reject unknown machine type",
+			values:      Values{MachineType: "n1-unknown"},
+			message:     "machine type n1-unknown is not available in CloudProfile gcp",
+		},
+		{
+			description: "should reject machine type which is not usable",
+			values:      Values{MachineType: "n1-standard-1"},
+			message:     "machine type n1-standard-1 is not usable in CloudProfile gcp",
+		},
+		{
+			description: "should reject machine type unavailable in all zones of region",
+			values:      Values{Region: "europe-west3", MachineType: "a2-highgpu-1g"},
+			message:     "machine type a2-highgpu-1g is not available in any zone of europe-west3 region",
+		},
+		{
+			description: "should reject unknown Kubernetes version",
+			values:      Values{KubernetesVersion: "1.26.9"},
+			message:     "Kubernetes version 1.26.9 is not available in CloudProfile gcp",
+		},
+		{
+			description: "should reject deprecated Kubernetes version",
+			values:      Values{KubernetesVersion: "1.27.3"},
+			message:     "Kubernetes version 1.27.3 is deprecated in CloudProfile gcp",
+		},
+		{
+			description: "should reject expired Kubernetes version",
+			values:      Values{KubernetesVersion: "1.25.9"},
+			message:     "Kubernetes version 1.25.9 expired on 2026-01-01T00:00:00Z",
+		},
+		{
+			description: "should reject minor Kubernetes version without supported patch",
+			values:      Values{KubernetesVersion: "1.28"},
+			message:     "no supported Kubernetes version 1.28.x is available in CloudProfile gcp",
+		},
+		{
+			description: "should reject unknown machine image",
+			values:      Values{MachineImage: "ubuntu"},
+			message:     "machine image ubuntu is not available in CloudProfile gcp",
+		},
+		{
+			description: "should reject mismatched machine image version",
+			values:      Values{MachineImage: "gardenlinux", MachineImageVersion: "576.1.0"},
+			message:     "machine image gardenlinux version 576.1.0 is not available in CloudProfile gcp",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			_, err := Resolve(profile, testCase.values, now)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
+
+func fixCloudProfile() *gardener_types.CloudProfile {
+	return &gardener_types.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+		Spec: gardener_types.CloudProfileSpec{
+			Type: "gcp",
+			Kubernetes: gardener_types.KubernetesSettings{
+				Versions: []gardener_types.ExpirableVersion{
+					{Version: "1.28.0", Classification: util.PtrTo(gardener_types.ClassificationPreview)},
+					{Version: "1.27.4", Classification: util.PtrTo(gardener_types.ClassificationSupported)},
+					{Version: "1.27.3", Classification: util.PtrTo(gardener_types.ClassificationDeprecated)},
+					{Version: "1.27.10", Classification: util.PtrTo(gardener_types.ClassificationSupported), ExpirationDate: &metav1.Time{Time: now.Add(-time.Hour)}},
+					{Version: "1.25.9", ExpirationDate: &metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+				},
+			},
+			MachineImages: []gardener_types.MachineImage{
+				{
+					Name: "gardenlinux",
+					Versions: []gardener_types.MachineImageVersion{
+						{ExpirableVersion: gardener_types.ExpirableVersion{Version: "934.9.0"}},
+						{ExpirableVersion: gardener_types.ExpirableVersion{Version: "934.10.0"}},
+						{ExpirableVersion: gardener_types.ExpirableVersion{Version: "1000.0.0", Classification: util.PtrTo(gardener_types.ClassificationPreview)}},
+					},
+				},
+			},
+			MachineTypes: []gardener_types.MachineType{
+				{Name: "n2-standard-4"},
+				{Name: "n1-standard-1", Usable: util.PtrTo(false)},
+				{Name: "a2-highgpu-1g"},
+			},
+			Regions: []gardener_types.Region{
+				{
+					Name: "europe-west3",
+					Zones: []gardener_types.AvailabilityZone{
+						{Name: "europe-west3-a", UnavailableMachineTypes: []string{"a2-highgpu-1g"}},
+						{Name: "europe-west3-b", UnavailableMachineTypes: []string{"a2-highgpu-1g"}},
+					},
+				},
+			},
+		},
+	}
+}
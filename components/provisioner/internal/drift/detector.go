@@ -0,0 +1,102 @@
+package drift
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// Result of the comparison of the Gardener config with the shoot
+type Result struct {
+	// Config is the Gardener config with the adopted values
+	Config model.GardenerConfig
+	// Adopted contains names of the fields whose values were adopted from the shoot
+	Adopted []string
+	// Reported contains the drift which was not adopted, sorted by field
+	Reported []model.DriftItem
+}
+
+type Detector struct {
+	policies Policies
+	now      func() time.Time
+}
+
+func NewDetector(policies Policies) *Detector {
+	return &Detector{
+		policies: policies,
+		now:      time.Now,
+	}
+}
+
+// Detect compares the Gardener config with the shoot. Detection time of the previously reported drift is kept if the values did not change.
+func (d *Detector) Detect(shoot gardener_types.Shoot, config model.GardenerConfig, previous []model.DriftItem) Result {
+	previousByField := make(map[string]model.DriftItem, len(previous))
+	for _, item := range previous {
+		previousByField[item.Field] = item
+	}
+
+	result := Result{Config: config}
+	now := d.now()
+
+	for _, f := range fields {
+		actual, known := f.actual(shoot)
+		if !known {
+			continue
+		}
+		stored := f.stored(config)
+		if equalValues(stored, actual) {
+			continue
+		}
+
+		if d.policies[f.name] == model.DriftPolicyAdopt && f.adopt(&result.Config, shoot) {
+			result.Adopted = append(result.Adopted, f.name)
+			continue
+		}
+
+		item := model.DriftItem{
+			RuntimeID:   config.ClusterID,
+			Field:       f.name,
+			StoredValue: stored,
+			ActualValue: actual,
+			DetectedAt:  now,
+		}
+		if prev, found := previousByField[f.name]; found && sameValues(prev, item) {
+			item.DetectedAt = prev.DetectedAt
+		}
+		result.Reported = append(result.Reported, item)
+	}
+
+	// the drift is listed from the database sorted by field, so it is compared in the same order
+	slices.SortFunc(result.Reported, func(a, b model.DriftItem) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return result
+}
+
+// Changed returns true if the reported drift differs from the previous one
+func (r Result) Changed(previous []model.DriftItem) bool {
+	if len(r.Reported) != len(previous) {
+		return true
+	}
+	for i := range r.Reported {
+		if r.Reported[i].Field != previous[i].Field || !sameValues(r.Reported[i], previous[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameValues(a, b model.DriftItem) bool {
+	return equalValues(a.StoredValue, b.StoredValue) && equalValues(a.ActualValue, b.ActualValue)
+}
+
+func equalValues(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,324 @@
+package drift
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+const runtimeID = "runtime-id"
+
+func TestLoadPolicies(t *testing.T) {
+	t.Run("Should override default policies", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "drift.json")
+		err := os.WriteFile(path, []byte(`{"kubernetesVersion": "adopt", "machineType": "adopt"}`), 0600)
+		require.NoError(t, err)
+
+		// when
+		policies, err := LoadPolicies(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.DriftPolicyAdopt, policies[FieldKubernetesVersion])
+		assert.Equal(t, model.DriftPolicyAdopt, policies[FieldMachineType])
+		assert.Equal(t, model.DriftPolicyReport, policies[FieldMachineImageVersion])
+	})
+
+	t.Run("Should return default policies if path is empty", func(t *testing.T) {
+		// when
+		policies, err := LoadPolicies("")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, DefaultPolicies(), policies)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		content     string
+	}{
+		{description: "Should return error for unknown field", content: `{"unknown": "adopt"}`},
+		{description: "Should return error for invalid policy", content: `{"machineType": "ignore"}`},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			path := filepath.Join(t.TempDir(), "drift.json")
+			err := os.WriteFile(path, []byte(testCase.content), 0600)
+			require.NoError(t, err)
+
+			// when
+			_, err = LoadPolicies(path)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDetector_Detect(t *testing.T) {
+	detectedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	config := model.GardenerConfig{
+		ClusterID:                           runtimeID,
+		KubernetesVersion:                   "1.27.5",
+		Purpose:                             util.PtrTo("production"),
+		MachineType:                         "m5.xlarge",
+		MachineImage:                        util.PtrTo("gardenlinux"),
+		MachineImageVersion:                 util.PtrTo("1.0.0"),
+		DiskType:                            util.PtrTo("gp3"),
+		VolumeSizeGB:                        util.PtrTo(50),
+		AutoScalerMin:                       3,
+		AutoScalerMax:                       10,
+		MaxSurge:                            3,
+		MaxUnavailable:                      0,
+		EnableKubernetesVersionAutoUpdate:   true,
+		EnableMachineImageVersionAutoUpdate: false,
+		Extensions: []model.Extension{
+			{Type: "shoot-dns-service", ProviderConfig: []byte(`{"dnsProviderReplication":{"enabled":true}}`)},
+		},
+	}
+
+	newShoot := func(modify func(shoot *gardener_types.Shoot)) gardener_types.Shoot {
+		shoot := gardener_types.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot"},
+			Spec: gardener_types.ShootSpec{
+				Kubernetes: gardener_types.Kubernetes{Version: "1.27.5"},
+				Purpose:    util.PtrTo(gardener_types.ShootPurpose("production")),
+				Provider: gardener_types.Provider{
+					Workers: []gardener_types.Worker{
+						{
+							Machine: gardener_types.Machine{
+								Type:  "m5.xlarge",
+								Image: &gardener_types.ShootMachineImage{Name: "gardenlinux", Version: util.PtrTo("1.0.0")},
+							},
+							Volume:         &gardener_types.Volume{Type: util.PtrTo("gp3"), VolumeSize: "50Gi"},
+							Minimum:        3,
+							Maximum:        10,
+							MaxSurge:       util.PtrTo(intstr.FromInt(3)),
+							MaxUnavailable: util.PtrTo(intstr.FromInt(0)),
+						},
+					},
+				},
+				Maintenance: &gardener_types.Maintenance{
+					AutoUpdate: &gardener_types.MaintenanceAutoUpdate{
+						KubernetesVersion:   true,
+						MachineImageVersion: util.PtrTo(false),
+					},
+				},
+				Extensions: []gardener_types.Extension{
+					{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(true)},
+					{Type: "shoot-dns-service", ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"service.dns.extensions.gardener.cloud/v1alpha1","dnsProviderReplication":{"enabled":true}}`)}},
+					{Type: model.AuditLogExtensionType, ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"tenantId":"tenant"}`)}},
+				},
+			},
+		}
+		if modify != nil {
+			modify(&shoot)
+		}
+		return shoot
+	}
+
+	detector := NewDetector(DefaultPolicies())
+	detector.now = func() time.Time { return now }
+
+	t.Run("Should not detect drift if shoot matches config", func(t *testing.T) {
+		// when
+		result := detector.Detect(newShoot(nil), config, nil)
+
+		// then
+		assert.Equal(t, config, result.Config)
+		assert.Empty(t, result.Adopted)
+		assert.Empty(t, result.Reported)
+		assert.False(t, result.Changed(nil))
+	})
+
+	t.Run("Should only report drift with default policies", func(t *testing.T) {
+		// given
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Kubernetes.Version = "1.28.2"
+			shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+		})
+
+		// when
+		result := detector.Detect(shoot, config, nil)
+
+		// then
+		assert.Equal(t, config, result.Config)
+		assert.Empty(t, result.Adopted)
+		assert.Equal(t, []model.DriftItem{
+			{RuntimeID: runtimeID, Field: FieldKubernetesVersion, StoredValue: util.PtrTo("1.27.5"), ActualValue: util.PtrTo("1.28.2"), DetectedAt: now},
+			{RuntimeID: runtimeID, Field: FieldMachineType, StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: now},
+		}, result.Reported)
+	})
+
+	t.Run("Should adopt and report drift according to policies", func(t *testing.T) {
+		// given
+		policies := DefaultPolicies()
+		policies[FieldKubernetesVersion] = model.DriftPolicyAdopt
+		policies[FieldMachineImageVersion] = model.DriftPolicyAdopt
+		policies[FieldShootNetworkingFilterDisabled] = model.DriftPolicyAdopt
+		adoptingDetector := NewDetector(policies)
+		adoptingDetector.now = func() time.Time { return now }
+
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Kubernetes.Version = "1.28.2"
+			shoot.Spec.Provider.Workers[0].Machine.Image.Version = util.PtrTo("1.1.0")
+			shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+			shoot.Spec.Provider.Workers[0].Maximum = 20
+			shoot.Spec.Extensions[0].Disabled = util.PtrTo(false)
+		})
+
+		// when
+		result := adoptingDetector.Detect(shoot, config, nil)
+
+		// then
+		expectedConfig := config
+		expectedConfig.KubernetesVersion = "1.28.2"
+		expectedConfig.MachineImageVersion = util.PtrTo("1.1.0")
+		expectedConfig.ShootNetworkingFilterDisabled = util.PtrTo(false)
+
+		assert.Equal(t, expectedConfig, result.Config)
+		assert.Equal(t, []string{FieldKubernetesVersion, FieldMachineImageVersion, FieldShootNetworkingFilterDisabled}, result.Adopted)
+		assert.Equal(t, []model.DriftItem{
+			{RuntimeID: runtimeID, Field: FieldAutoScalerMax, StoredValue: util.PtrTo("10"), ActualValue: util.PtrTo("20"), DetectedAt: now},
+			{RuntimeID: runtimeID, Field: FieldMachineType, StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: now},
+		}, result.Reported)
+	})
+
+	t.Run("Should report drift which cannot be adopted", func(t *testing.T) {
+		// given
+		policies := DefaultPolicies()
+		policies[FieldMaxSurge] = model.DriftPolicyAdopt
+		adoptingDetector := NewDetector(policies)
+		adoptingDetector.now = func() time.Time { return now }
+
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Provider.Workers[0].MaxSurge = util.PtrTo(intstr.FromString("25%"))
+		})
+
+		// when
+		result := adoptingDetector.Detect(shoot, config, nil)
+
+		// then
+		assert.Equal(t, config, result.Config)
+		assert.Empty(t, result.Adopted)
+		assert.Equal(t, []model.DriftItem{
+			{RuntimeID: runtimeID, Field: FieldMaxSurge, StoredValue: util.PtrTo("3"), ActualValue: util.PtrTo("25%"), DetectedAt: now},
+		}, result.Reported)
+	})
+
+	t.Run("Should keep detection time of unchanged drift", func(t *testing.T) {
+		// given
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "m5.2xlarge"
+			shoot.Spec.Provider.Workers[0].Maximum = 20
+		})
+		previous := []model.DriftItem{
+			{RuntimeID: runtimeID, Field: FieldAutoScalerMax, StoredValue: util.PtrTo("10"), ActualValue: util.PtrTo("15"), DetectedAt: detectedAt},
+			{RuntimeID: runtimeID, Field: FieldMachineType, StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: detectedAt},
+		}
+
+		// when
+		result := detector.Detect(shoot, config, previous)
+
+		// then
+		assert.Equal(t, []model.DriftItem{
+			{RuntimeID: runtimeID, Field: FieldAutoScalerMax, StoredValue: util.PtrTo("10"), ActualValue: util.PtrTo("20"), DetectedAt: now},
+			{RuntimeID: runtimeID, Field: FieldMachineType, StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: detectedAt},
+		}, result.Reported)
+		assert.True(t, result.Changed(previous))
+		assert.False(t, result.Changed(result.Reported))
+	})
+
+	t.Run("Should report changed extension set without audit log extension", func(t *testing.T) {
+		// given
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardener_types.Extension{Type: "shoot-cert-service"})
+		})
+
+		// when
+		result := detector.Detect(shoot, config, nil)
+
+		// then
+		assert.Equal(t, config, result.Config)
+		assert.Equal(t, []model.DriftItem{
+			{
+				RuntimeID:   runtimeID,
+				Field:       FieldExtensions,
+				StoredValue: util.PtrTo(`[{"type":"shoot-dns-service","disabled":false}]`),
+				ActualValue: util.PtrTo(`[{"type":"shoot-cert-service","disabled":false},{"type":"shoot-dns-service","disabled":false}]`),
+				DetectedAt:  now,
+			},
+		}, result.Reported)
+	})
+
+	t.Run("Should compare shoot extensions with default extensions if config has none", func(t *testing.T) {
+		// given
+		configWithoutExtensions := config
+		configWithoutExtensions.Extensions = nil
+
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Extensions = append(shoot.Spec.Extensions,
+				gardener_types.Extension{Type: "shoot-cert-service"},
+				gardener_types.Extension{Type: "shoot-oidc-service", Disabled: util.PtrTo(false)},
+			)
+		})
+
+		// when
+		result := detector.Detect(shoot, configWithoutExtensions, nil)
+
+		// then
+		assert.Empty(t, result.Reported)
+	})
+
+	t.Run("Should adopt extension set without audit log extension", func(t *testing.T) {
+		// given
+		policies := DefaultPolicies()
+		policies[FieldExtensions] = model.DriftPolicyAdopt
+		adoptingDetector := NewDetector(policies)
+
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Extensions[1].Disabled = util.PtrTo(true)
+		})
+
+		// when
+		result := adoptingDetector.Detect(shoot, config, nil)
+
+		// then
+		assert.Equal(t, []string{FieldExtensions}, result.Adopted)
+		assert.Empty(t, result.Reported)
+		assert.Equal(t, []model.Extension{
+			{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(true)},
+			{Type: "shoot-dns-service", Disabled: util.PtrTo(true), ProviderConfig: []byte(`{"apiVersion":"service.dns.extensions.gardener.cloud/v1alpha1","dnsProviderReplication":{"enabled":true}}`)},
+		}, result.Config.Extensions)
+	})
+
+	t.Run("Should skip fields not set in shoot", func(t *testing.T) {
+		// given
+		shoot := newShoot(func(shoot *gardener_types.Shoot) {
+			shoot.Spec.Provider.Workers = nil
+			shoot.Spec.Maintenance = nil
+			shoot.Spec.Extensions = nil
+		})
+
+		// when
+		result := detector.Detect(shoot, config, nil)
+
+		// then
+		assert.Empty(t, result.Adopted)
+		assert.Empty(t, result.Reported)
+	})
+}
@@ -0,0 +1,437 @@
+package drift
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	FieldKubernetesVersion                   = "kubernetesVersion"
+	FieldPurpose                             = "purpose"
+	FieldMachineType                         = "machineType"
+	FieldMachineImage                        = "machineImage"
+	FieldMachineImageVersion                 = "machineImageVersion"
+	FieldDiskType                            = "diskType"
+	FieldVolumeSizeGB                        = "volumeSizeGB"
+	FieldAutoScalerMin                       = "autoScalerMin"
+	FieldAutoScalerMax                       = "autoScalerMax"
+	FieldMaxSurge                            = "maxSurge"
+	FieldMaxUnavailable                      = "maxUnavailable"
+	FieldEnableKubernetesVersionAutoUpdate   = "enableKubernetesVersionAutoUpdate"
+	FieldEnableMachineImageVersionAutoUpdate = "enableMachineImageVersionAutoUpdate"
+	FieldExposureClassName                   = "exposureClassName"
+	FieldOIDCConfig                          = "oidcConfig"
+	FieldShootNetworkingFilterDisabled       = "shootNetworkingFilterDisabled"
+	FieldExtensions                          = "extensions"
+)
+
+// field compares a single value of the Gardener config with the shoot
+type field struct {
+	name string
+	// stored returns the value kept in the database, or nil if it is not set
+	stored func(config model.GardenerConfig) *string
+	// actual returns the value set in the shoot, or false if the shoot does not provide it
+	actual func(shoot gardener_types.Shoot) (*string, bool)
+	// adopt sets the value from the shoot in the config, or returns false if the value cannot be stored
+	adopt func(config *model.GardenerConfig, shoot gardener_types.Shoot) bool
+}
+
+var fields = []field{
+	{
+		name:   FieldKubernetesVersion,
+		stored: func(c model.GardenerConfig) *string { return &c.KubernetesVersion },
+		actual: func(s gardener_types.Shoot) (*string, bool) { return &s.Spec.Kubernetes.Version, true },
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			c.KubernetesVersion = s.Spec.Kubernetes.Version
+			return true
+		},
+	},
+	{
+		name:   FieldPurpose,
+		stored: func(c model.GardenerConfig) *string { return emptyToNil(c.Purpose) },
+		actual: func(s gardener_types.Shoot) (*string, bool) {
+			if s.Spec.Purpose == nil {
+				return nil, true
+			}
+			return util.PtrTo(string(*s.Spec.Purpose)), true
+		},
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			if s.Spec.Purpose == nil {
+				return false
+			}
+			c.Purpose = util.PtrTo(string(*s.Spec.Purpose))
+			return true
+		},
+	},
+	{
+		name:   FieldMachineType,
+		stored: func(c model.GardenerConfig) *string { return &c.MachineType },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) { return &w.Machine.Type, true }),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			c.MachineType = w.Machine.Type
+			return true
+		}),
+	},
+	{
+		name:   FieldMachineImage,
+		stored: func(c model.GardenerConfig) *string { return emptyToNil(c.MachineImage) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			if w.Machine.Image == nil {
+				return nil, false
+			}
+			return &w.Machine.Image.Name, true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			c.MachineImage = util.PtrTo(w.Machine.Image.Name)
+			return true
+		}),
+	},
+	{
+		name:   FieldMachineImageVersion,
+		stored: func(c model.GardenerConfig) *string { return emptyToNil(c.MachineImageVersion) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			if w.Machine.Image == nil {
+				return nil, false
+			}
+			return emptyToNil(w.Machine.Image.Version), true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			if w.Machine.Image.Version == nil {
+				return false
+			}
+			c.MachineImageVersion = util.PtrTo(*w.Machine.Image.Version)
+			return true
+		}),
+	},
+	{
+		name:   FieldDiskType,
+		stored: func(c model.GardenerConfig) *string { return emptyToNil(c.DiskType) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			if w.Volume == nil {
+				return nil, false
+			}
+			return emptyToNil(w.Volume.Type), true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			if w.Volume.Type == nil {
+				return false
+			}
+			c.DiskType = util.PtrTo(*w.Volume.Type)
+			return true
+		}),
+	},
+	{
+		name: FieldVolumeSizeGB,
+		stored: func(c model.GardenerConfig) *string {
+			if c.VolumeSizeGB == nil {
+				return nil
+			}
+			return util.PtrTo(strconv.Itoa(*c.VolumeSizeGB))
+		},
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			size, ok := volumeSizeGB(w)
+			if !ok {
+				return nil, false
+			}
+			return util.PtrTo(strconv.Itoa(size)), true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			size, ok := volumeSizeGB(w)
+			if !ok {
+				return false
+			}
+			c.VolumeSizeGB = util.PtrTo(size)
+			return true
+		}),
+	},
+	{
+		name:   FieldAutoScalerMin,
+		stored: func(c model.GardenerConfig) *string { return util.PtrTo(strconv.Itoa(c.AutoScalerMin)) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			return util.PtrTo(strconv.Itoa(int(w.Minimum))), true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			c.AutoScalerMin = int(w.Minimum)
+			return true
+		}),
+	},
+	{
+		name:   FieldAutoScalerMax,
+		stored: func(c model.GardenerConfig) *string { return util.PtrTo(strconv.Itoa(c.AutoScalerMax)) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) {
+			return util.PtrTo(strconv.Itoa(int(w.Maximum))), true
+		}),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			c.AutoScalerMax = int(w.Maximum)
+			return true
+		}),
+	},
+	{
+		name:   FieldMaxSurge,
+		stored: func(c model.GardenerConfig) *string { return util.PtrTo(strconv.Itoa(c.MaxSurge)) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) { return intOrStringValue(w.MaxSurge) }),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			return adoptInt(&c.MaxSurge, w.MaxSurge)
+		}),
+	},
+	{
+		name:   FieldMaxUnavailable,
+		stored: func(c model.GardenerConfig) *string { return util.PtrTo(strconv.Itoa(c.MaxUnavailable)) },
+		actual: workerValue(func(w gardener_types.Worker) (*string, bool) { return intOrStringValue(w.MaxUnavailable) }),
+		adopt: adoptFromWorker(func(c *model.GardenerConfig, w gardener_types.Worker) bool {
+			return adoptInt(&c.MaxUnavailable, w.MaxUnavailable)
+		}),
+	},
+	{
+		name: FieldEnableKubernetesVersionAutoUpdate,
+		stored: func(c model.GardenerConfig) *string {
+			return util.PtrTo(strconv.FormatBool(c.EnableKubernetesVersionAutoUpdate))
+		},
+		actual: func(s gardener_types.Shoot) (*string, bool) {
+			if s.Spec.Maintenance == nil || s.Spec.Maintenance.AutoUpdate == nil {
+				return nil, false
+			}
+			return util.PtrTo(strconv.FormatBool(s.Spec.Maintenance.AutoUpdate.KubernetesVersion)), true
+		},
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			c.EnableKubernetesVersionAutoUpdate = s.Spec.Maintenance.AutoUpdate.KubernetesVersion
+			return true
+		},
+	},
+	{
+		name: FieldEnableMachineImageVersionAutoUpdate,
+		stored: func(c model.GardenerConfig) *string {
+			return util.PtrTo(strconv.FormatBool(c.EnableMachineImageVersionAutoUpdate))
+		},
+		actual: func(s gardener_types.Shoot) (*string, bool) {
+			if s.Spec.Maintenance == nil || s.Spec.Maintenance.AutoUpdate == nil || s.Spec.Maintenance.AutoUpdate.MachineImageVersion == nil {
+				return nil, false
+			}
+			return util.PtrTo(strconv.FormatBool(*s.Spec.Maintenance.AutoUpdate.MachineImageVersion)), true
+		},
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			c.EnableMachineImageVersionAutoUpdate = *s.Spec.Maintenance.AutoUpdate.MachineImageVersion
+			return true
+		},
+	},
+	{
+		name:   FieldExposureClassName,
+		stored: func(c model.GardenerConfig) *string { return emptyToNil(c.ExposureClassName) },
+		actual: func(s gardener_types.Shoot) (*string, bool) { return emptyToNil(s.Spec.ExposureClassName), true },
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			if s.Spec.ExposureClassName == nil {
+				return false
+			}
+			c.ExposureClassName = util.PtrTo(*s.Spec.ExposureClassName)
+			return true
+		},
+	},
+	{
+		name:   FieldOIDCConfig,
+		stored: func(c model.GardenerConfig) *string { return oidcConfigValue(c.OIDCConfig) },
+		actual: func(s gardener_types.Shoot) (*string, bool) { return oidcConfigValue(shootOIDCConfig(s)), true },
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			// Removing the OIDC config is not supported by the database sessions
+			oidcConfig := shootOIDCConfig(s)
+			if oidcConfig == nil {
+				return false
+			}
+			c.OIDCConfig = oidcConfig
+			return true
+		},
+	},
+	{
+		name: FieldShootNetworkingFilterDisabled,
+		stored: func(c model.GardenerConfig) *string {
+			disabled := util.UnwrapOrDefault(c.ShootNetworkingFilterDisabled, model.ShootNetworkingFilterDisabledDefault)
+			return util.PtrTo(strconv.FormatBool(disabled))
+		},
+		actual: func(s gardener_types.Shoot) (*string, bool) {
+			disabled := shootNetworkingFilterDisabled(s)
+			if disabled == nil {
+				return nil, false
+			}
+			return util.PtrTo(strconv.FormatBool(*disabled)), true
+		},
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			c.ShootNetworkingFilterDisabled = util.PtrTo(*shootNetworkingFilterDisabled(s))
+			return true
+		},
+	},
+	{
+		name:   FieldExtensions,
+		stored: func(c model.GardenerConfig) *string { return extensionsValue(storedExtensions(c)) },
+		actual: func(s gardener_types.Shoot) (*string, bool) {
+			if len(s.Spec.Extensions) == 0 {
+				return nil, false
+			}
+			return extensionsValue(shootExtensions(s)), true
+		},
+		adopt: func(c *model.GardenerConfig, s gardener_types.Shoot) bool {
+			c.Extensions = shootExtensions(s)
+			return true
+		},
+	},
+}
+
+// workerValue reads the value from the first worker group, as the Runtimes have a single worker group
+func workerValue(value func(worker gardener_types.Worker) (*string, bool)) func(shoot gardener_types.Shoot) (*string, bool) {
+	return func(shoot gardener_types.Shoot) (*string, bool) {
+		if len(shoot.Spec.Provider.Workers) == 0 {
+			return nil, false
+		}
+		return value(shoot.Spec.Provider.Workers[0])
+	}
+}
+
+func adoptFromWorker(adopt func(config *model.GardenerConfig, worker gardener_types.Worker) bool) func(config *model.GardenerConfig, shoot gardener_types.Shoot) bool {
+	return func(config *model.GardenerConfig, shoot gardener_types.Shoot) bool {
+		return adopt(config, shoot.Spec.Provider.Workers[0])
+	}
+}
+
+func volumeSizeGB(worker gardener_types.Worker) (int, bool) {
+	if worker.Volume == nil {
+		return 0, false
+	}
+	quantity, err := resource.ParseQuantity(worker.Volume.VolumeSize)
+	if err != nil {
+		return 0, false
+	}
+	return int(quantity.Value() >> 30), true
+}
+
+func intOrStringValue(value *intstr.IntOrString) (*string, bool) {
+	if value == nil {
+		return nil, false
+	}
+	return util.PtrTo(value.String()), true
+}
+
+// adoptInt stores only integer values, as percentages are not supported in the database
+func adoptInt(target *int, value *intstr.IntOrString) bool {
+	if value.Type != intstr.Int {
+		return false
+	}
+	*target = value.IntValue()
+	return true
+}
+
+func shootOIDCConfig(shoot gardener_types.Shoot) *model.OIDCConfig {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig == nil {
+		return nil
+	}
+	oidcConfig := shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig
+
+	return &model.OIDCConfig{
+		ClientID:       util.UnwrapOrDefault(oidcConfig.ClientID, ""),
+		GroupsClaim:    util.UnwrapOrDefault(oidcConfig.GroupsClaim, ""),
+		IssuerURL:      util.UnwrapOrDefault(oidcConfig.IssuerURL, ""),
+		SigningAlgs:    oidcConfig.SigningAlgs,
+		UsernameClaim:  util.UnwrapOrDefault(oidcConfig.UsernameClaim, ""),
+		UsernamePrefix: util.UnwrapOrDefault(oidcConfig.UsernamePrefix, ""),
+	}
+}
+
+// oidcConfigValue returns nil for the empty config, as the database sessions return it for Runtimes without OIDC config
+func oidcConfigValue(oidcConfig *model.OIDCConfig) *string {
+	if oidcConfig == nil {
+		return nil
+	}
+	normalized := *oidcConfig
+	if len(normalized.SigningAlgs) == 0 {
+		normalized.SigningAlgs = nil
+	}
+	if reflect.DeepEqual(normalized, model.OIDCConfig{}) {
+		return nil
+	}
+	value, err := json.Marshal(normalized)
+	if err != nil {
+		return nil
+	}
+	return util.PtrTo(string(value))
+}
+
+func shootNetworkingFilterDisabled(shoot gardener_types.Shoot) *bool {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == model.ShootNetworkingFilterExtensionType {
+			return extension.Disabled
+		}
+	}
+	return nil
+}
+
+// storedExtensions returns the extensions which the config sets in the shoot, the default ones if the config does not contain any
+func storedExtensions(config model.GardenerConfig) []model.Extension {
+	if len(config.Extensions) > 0 {
+		return config.Extensions
+	}
+	defaultExtensions, err := model.DefaultExtensions()
+	if err != nil {
+		return nil
+	}
+	return defaultExtensions
+}
+
+// shootExtensions returns the extensions of the shoot without the audit log extension, which is managed by the shoot controller
+func shootExtensions(shoot gardener_types.Shoot) []model.Extension {
+	var extensions []model.Extension
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == model.AuditLogExtensionType {
+			continue
+		}
+		var providerConfig json.RawMessage
+		if extension.ProviderConfig != nil {
+			providerConfig = extension.ProviderConfig.Raw
+		}
+		extensions = append(extensions, model.Extension{
+			Type:           extension.Type,
+			Disabled:       extension.Disabled,
+			ProviderConfig: providerConfig,
+		})
+	}
+	return extensions
+}
+
+type extensionState struct {
+	Type     string `json:"type"`
+	Disabled bool   `json:"disabled"`
+}
+
+// extensionsValue lists the types of the extensions and whether they are disabled, sorted by type.
+// The provider configs are not compared, as Gardener defaults their fields. The audit log extension is managed by the shoot controller,
+// and the Shoot Networking Filter extension is compared by the shootNetworkingFilterDisabled field, so both are skipped.
+func extensionsValue(extensions []model.Extension) *string {
+	states := []extensionState{}
+	for _, extension := range extensions {
+		if extension.Type == model.AuditLogExtensionType || extension.Type == model.ShootNetworkingFilterExtensionType {
+			continue
+		}
+		states = append(states, extensionState{Type: extension.Type, Disabled: util.UnwrapOrDefault(extension.Disabled, false)})
+	}
+	slices.SortFunc(states, func(a, b extensionState) int {
+		return strings.Compare(a.Type, b.Type)
+	})
+
+	value, err := json.Marshal(states)
+	if err != nil {
+		return nil
+	}
+	return util.PtrTo(string(value))
+}
+
+func emptyToNil(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,53 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// Policies define for each field whether the value from the shoot is adopted in the database or only reported
+type Policies map[string]model.DriftPolicy
+
+// DefaultPolicies report all fields, adopting values in the database must be enabled per field in the policy config
+func DefaultPolicies() Policies {
+	policies := Policies{}
+	for _, f := range fields {
+		policies[f.name] = model.DriftPolicyReport
+	}
+
+	return policies
+}
+
+// LoadPolicies reads policies which override the default ones. Empty path means default policies.
+func LoadPolicies(path string) (Policies, error) {
+	policies := DefaultPolicies()
+	if path == "" {
+		return policies, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open drift policy config file: %s", err.Error())
+	}
+	defer file.Close()
+
+	var overrides map[string]model.DriftPolicy
+	if err := json.NewDecoder(file).Decode(&overrides); err != nil {
+		return nil, fmt.Errorf("failed to decode drift policy config file: %s", err.Error())
+	}
+
+	for name, policy := range overrides {
+		if _, found := policies[name]; !found {
+			return nil, fmt.Errorf("unknown drift field %q", name)
+		}
+		if policy != model.DriftPolicyAdopt && policy != model.DriftPolicyReport {
+			return nil, fmt.Errorf("invalid drift policy %q for field %q", policy, name)
+		}
+		policies[name] = policy
+	}
+
+	return policies, nil
+}
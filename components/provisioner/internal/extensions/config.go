@@ -0,0 +1,116 @@
+package extensions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// Config defines the Gardener extensions of the provisioned shoots and the extensions which can be set with the input
+type Config struct {
+	// Allowed are the extension types which can be set with the input in addition to the types of the default extensions
+	Allowed []string `json:"allowed,omitempty"`
+	// Defaults are the extensions of the provisioned shoots. Shoots without extensions get the extensions of the model defaults.
+	Defaults []model.Extension `json:"defaults,omitempty"`
+}
+
+// DefaultConfig is used when no extensions config is provided. It allows only the types of the default extensions.
+func DefaultConfig() (Config, error) {
+	defaults, err := model.DefaultExtensions()
+	if err != nil {
+		return Config{}, err
+	}
+	return Config{Defaults: defaults}, nil
+}
+
+// LoadConfig reads the config from the file, or returns the default config if the path is empty
+func LoadConfig(path string) (Config, error) {
+	if path == "" {
+		return DefaultConfig()
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("failed to read extensions config file: %s", err.Error())
+	}
+	return ParseConfig(content)
+}
+
+// ParseConfig reads the config from JSON and validates it
+func ParseConfig(data []byte) (Config, error) {
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, fmt.Errorf("failed to decode extensions config: %s", err.Error())
+	}
+
+	for _, extensionType := range config.Allowed {
+		if err := validateType(extensionType); err != nil {
+			return Config{}, fmt.Errorf("extensions config contains invalid allowed type: %s", err.Error())
+		}
+	}
+	if err := validateExtensions(config.Defaults); err != nil {
+		return Config{}, fmt.Errorf("extensions config contains invalid default extension: %s", err.Error())
+	}
+
+	return config, nil
+}
+
+// Resolve merges the input extensions into the current extensions of the shoot.
+// The input can contain only the allowed types and the types of the default extensions.
+func (c Config) Resolve(current []model.Extension, input []model.Extension) ([]model.Extension, apperrors.AppError) {
+	if len(input) == 0 {
+		return current, nil
+	}
+
+	if err := validateExtensions(input); err != nil {
+		return nil, apperrors.BadRequest(err.Error())
+	}
+	for _, extension := range input {
+		if !c.allows(extension.Type) {
+			return nil, apperrors.BadRequest("extension %s is not allowed", extension.Type)
+		}
+	}
+
+	return model.MergeExtensions(current, input), nil
+}
+
+func (c Config) allows(extensionType string) bool {
+	if slices.Contains(c.Allowed, extensionType) {
+		return true
+	}
+	_, found := model.FindExtension(c.Defaults, extensionType)
+	return found
+}
+
+func validateExtensions(extensions []model.Extension) error {
+	types := make(map[string]bool, len(extensions))
+	for _, extension := range extensions {
+		if err := validateType(extension.Type); err != nil {
+			return err
+		}
+		if types[extension.Type] {
+			return fmt.Errorf("extension %s is set more than once", extension.Type)
+		}
+		types[extension.Type] = true
+
+		if extension.ProviderConfig != nil && !json.Valid(extension.ProviderConfig) {
+			return fmt.Errorf("provider config of extension %s is not valid JSON", extension.Type)
+		}
+	}
+	return nil
+}
+
+func validateType(extensionType string) error {
+	if extensionType == "" {
+		return fmt.Errorf("extension type is empty")
+	}
+	// The audit log extension is managed by the shoot controller, which would override the configured one
+	if extensionType == model.AuditLogExtensionType {
+		return fmt.Errorf("extension %s is managed by the audit log configuration", extensionType)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package extensions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("should return default config for empty path", func(t *testing.T) {
+		// when
+		config, err := LoadConfig("")
+
+		// then
+		require.NoError(t, err)
+		defaults, err := model.DefaultExtensions()
+		require.NoError(t, err)
+		assert.Equal(t, Config{Defaults: defaults}, config)
+	})
+
+	t.Run("should read config from file", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "config")
+		err := os.WriteFile(path, []byte(`{"allowed": ["shoot-lakom-service"], "defaults": [{"type": "shoot-dns-service", "providerConfig": {"kind": "DNSConfig"}}]}`), 0600)
+		require.NoError(t, err)
+
+		// when
+		config, err := LoadConfig(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Config{
+			Allowed:  []string{"shoot-lakom-service"},
+			Defaults: []model.Extension{{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind": "DNSConfig"}`)}},
+		}, config)
+	})
+
+	t.Run("should return error for missing file", func(t *testing.T) {
+		// when
+		_, err := LoadConfig(filepath.Join(t.TempDir(), "missing"))
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestParseConfig(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		content     string
+	}{
+		{description: "should return error for invalid JSON", content: `{"allowed": {}}`},
+		{description: "should return error for empty allowed type", content: `{"allowed": [""]}`},
+		{description: "should return error for default without type", content: `{"defaults": [{"disabled": true}]}`},
+		{description: "should return error for duplicated default", content: `{"defaults": [{"type": "shoot-dns-service"}, {"type": "shoot-dns-service"}]}`},
+		{description: "should return error for allowed audit log extension", content: `{"allowed": ["shoot-auditlog-service"]}`},
+		{description: "should return error for default audit log extension", content: `{"defaults": [{"type": "shoot-auditlog-service"}]}`},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			_, err := ParseConfig([]byte(testCase.content))
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestConfig_Resolve(t *testing.T) {
+	config := Config{
+		Allowed: []string{"shoot-lakom-service"},
+		Defaults: []model.Extension{
+			{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: "shoot-oidc-service", Disabled: util.PtrTo(false)},
+		},
+	}
+
+	t.Run("should merge input into current extensions", func(t *testing.T) {
+		// when
+		extensions, err := config.Resolve(config.Defaults, []model.Extension{
+			{Type: "shoot-dns-service", Disabled: util.PtrTo(true)},
+			{Type: "shoot-lakom-service", ProviderConfig: []byte(`{"kind":"LakomConfig"}`)},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.Extension{
+			{Type: "shoot-dns-service", Disabled: util.PtrTo(true), ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: "shoot-oidc-service", Disabled: util.PtrTo(false)},
+			{Type: "shoot-lakom-service", ProviderConfig: []byte(`{"kind":"LakomConfig"}`)},
+		}, extensions)
+		assert.Nil(t, config.Defaults[0].Disabled)
+	})
+
+	t.Run("should keep current extensions without input", func(t *testing.T) {
+		// when
+		extensions, err := config.Resolve(nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, extensions)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		input       []model.Extension
+		message     string
+	}{
+		{
+			description: "extension which is not allowed",
+			input:       []model.Extension{{Type: "shoot-falco-service"}},
+			message:     "extension shoot-falco-service is not allowed",
+		},
+		{
+			description: "audit log extension",
+			input:       []model.Extension{{Type: model.AuditLogExtensionType}},
+			message:     "extension shoot-auditlog-service is managed by the audit log configuration",
+		},
+		{
+			description: "duplicated extension",
+			input:       []model.Extension{{Type: "shoot-dns-service"}, {Type: "shoot-dns-service"}},
+			message:     "extension shoot-dns-service is set more than once",
+		},
+		{
+			description: "invalid provider config",
+			input:       []model.Extension{{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":`)}},
+			message:     "provider config of extension shoot-dns-service is not valid JSON",
+		},
+		{
+			description: "extension without type",
+			input:       []model.Extension{{Disabled: util.PtrTo(true)}},
+			message:     "extension type is empty",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			_, err := config.Resolve(config.Defaults, testCase.input)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
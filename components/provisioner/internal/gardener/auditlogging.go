@@ -8,6 +8,7 @@ import (
 	"regexp"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -17,7 +18,7 @@ const (
 	auditLogConditionType    = "AuditlogServiceAvailability"
 	auditInstanceCodePattern = `cf\.[a-z0-9]+`
 	auditlogSecretReference  = "auditlog-credentials"
-	auditlogExtensionType    = "shoot-auditlog-service"
+	auditlogExtensionType    = model.AuditLogExtensionType
 )
 
 type AuditLogConfigurator interface {
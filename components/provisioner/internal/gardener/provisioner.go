@@ -13,13 +13,13 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/mitchellh/mapstructure"
 	"github.com/sirupsen/logrus"
-	v12 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
 	log "github.com/sirupsen/logrus"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 )
@@ -42,6 +42,7 @@ func NewProvisioner(
 	factory dbsession.Factory,
 	policyConfigMapName string,
 	maintenanceWindowConfigPath string,
+	regionPolicies regionpolicy.Provider,
 	testDataWriter OutputDataWriter) *GardenerProvisioner {
 	return &GardenerProvisioner{
 		namespace:                   namespace,
@@ -49,6 +50,7 @@ func NewProvisioner(
 		dbSessionFactory:            factory,
 		policyConfigMapName:         policyConfigMapName,
 		maintenanceWindowConfigPath: maintenanceWindowConfigPath,
+		regionPolicies:              regionPolicies,
 		testDataWriter:              testDataWriter,
 	}
 }
@@ -59,6 +61,7 @@ type GardenerProvisioner struct {
 	dbSessionFactory            dbsession.Factory
 	policyConfigMapName         string
 	maintenanceWindowConfigPath string
+	regionPolicies              regionpolicy.Provider
 	testDataWriter              OutputDataWriter
 }
 
@@ -70,11 +73,8 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 
 	region := cluster.ClusterConfig.Region
 
-	if region == "me-central2" {
-		toleration := v1beta1.Toleration{
-			Key: "ksa-assured-workload",
-		}
-		shootTemplate.Spec.Tolerations = append(shootTemplate.Spec.Tolerations, toleration)
+	if g.regionPolicies != nil {
+		g.regionPolicies.Policy(cluster.ClusterConfig.Provider, region).ApplyToShoot(shootTemplate)
 	}
 
 	purpose := ""
@@ -94,9 +94,11 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 	annotate(shootTemplate, operationIDAnnotation, operationId)
 	annotate(shootTemplate, legacyRuntimeIDAnnotation, cluster.ID)
 	annotate(shootTemplate, legacyOperationIDAnnotation, operationId)
+	setRuntimeMetadata(shootTemplate, cluster)
 
-	if g.policyConfigMapName != "" {
-		g.applyAuditConfig(shootTemplate)
+	// The audit policy of the runtime overrides the global one
+	if policyConfigMapName := cluster.ClusterConfig.KubeAPIServer.AuditPolicyConfigMapName(g.policyConfigMapName); policyConfigMapName != "" {
+		applyAuditConfig(shootTemplate, policyConfigMapName)
 	}
 
 	if g.testDataWriter.Enabled() {
@@ -133,6 +135,10 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 			return appErr.Append("error while updating Gardener shoot configuration")
 		}
 
+		if g.regionPolicies != nil {
+			g.regionPolicies.Policy(upgradeConfig.Provider, upgradeConfig.Region).ApplyToUpgradedShoot(shoot)
+		}
+
 		setObjectFields(shoot)
 
 		shootData, err := json.Marshal(shoot)
@@ -195,6 +201,71 @@ func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operatio
 	return newDeprovisionOperation(operationId, cluster.ID, message, model.InProgress, model.DeleteCluster, deletionTime), nil
 }
 
+func (g *GardenerProvisioner) GetShoot(shootName string) (v1beta1.Shoot, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(context.Background(), shootName, v1.GetOptions{})
+	if err != nil {
+		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return v1beta1.Shoot{}, appError.Append("error getting Shoot %s", shootName)
+	}
+
+	return *shoot, nil
+}
+
+// UpdateRuntimeMetadata sets the current labels and description of the Runtime on its shoot
+func (g *GardenerProvisioner) UpdateRuntimeMetadata(cluster model.Cluster) apperrors.AppError {
+	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error getting Shoot for %s cluster", cluster.ID)
+	}
+
+	patchData, err := json.Marshal(runtimeMetadataPatch(*shoot, cluster))
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+		return apperr.Append("error during marshaling Shoot patch")
+	}
+
+	_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.MergePatchType, patchData, v1.PatchOptions{})
+	if err != nil {
+		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error updating metadata of Shoot for %s cluster", cluster.ID)
+	}
+
+	return nil
+}
+
+// AdoptCluster marks the existing shoot as managed by the provisioner, the same way as the shoots created by ProvisionCluster
+func (g *GardenerProvisioner) AdoptCluster(cluster model.Cluster, operationId string) apperrors.AppError {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				runtimeIDAnnotation:         cluster.ID,
+				operationIDAnnotation:       operationId,
+				legacyRuntimeIDAnnotation:   cluster.ID,
+				legacyOperationIDAnnotation: operationId,
+			},
+			"labels": map[string]string{
+				model.AccountLabel:    cluster.Tenant,
+				model.SubAccountLabel: util.UnwrapOrZero(cluster.SubAccountId),
+			},
+		},
+	}
+
+	patchData, err := json.Marshal(patch)
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+		return apperr.Append("error during marshaling Shoot patch")
+	}
+
+	_, err = g.shootClient.Patch(context.Background(), cluster.ClusterConfig.Name, types.MergePatchType, patchData, v1.PatchOptions{})
+	if err != nil {
+		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error annotating Shoot for %s cluster", cluster.ID)
+	}
+
+	return nil
+}
+
 func annotateWithConfirmDeletion(shoot *v1beta1.Shoot) {
 	if shoot.Annotations == nil {
 		shoot.Annotations = map[string]string{}
@@ -219,16 +290,12 @@ func newDeprovisionOperation(id, runtimeId, message string, state model.Operatio
 	}
 }
 
-func (g *GardenerProvisioner) applyAuditConfig(template *v1beta1.Shoot) {
+func applyAuditConfig(template *v1beta1.Shoot, policyConfigMapName string) {
 	if template.Spec.Kubernetes.KubeAPIServer == nil {
 		template.Spec.Kubernetes.KubeAPIServer = &v1beta1.KubeAPIServerConfig{}
 	}
 
-	template.Spec.Kubernetes.KubeAPIServer.AuditConfig = &v1beta1.AuditConfig{
-		AuditPolicy: &v1beta1.AuditPolicy{
-			ConfigMapRef: &v12.ObjectReference{Name: g.policyConfigMapName},
-		},
-	}
+	template.Spec.Kubernetes.KubeAPIServer.AuditConfig = model.NewAuditConfig(policyConfigMapName)
 }
 
 func (g *GardenerProvisioner) setMaintenanceWindow(template *v1beta1.Shoot, region string) apperrors.AppError {
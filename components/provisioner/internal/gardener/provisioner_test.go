@@ -17,6 +17,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
@@ -52,7 +53,7 @@ func TestGardenerProvisioner_ProvisionCluster(t *testing.T) {
 		// given
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath, &testkit.TestDataWriter{})
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath, nil, &testkit.TestDataWriter{})
 
 		// when
 		apperr := provisionerClient.ProvisionCluster(cluster, operationId)
@@ -73,6 +74,80 @@ func TestGardenerProvisioner_ProvisionCluster(t *testing.T) {
 		require.NotNil(t, shoot.Spec.Maintenance.TimeWindow)
 		assert.Equal(t, auditLogsPolicyCMName, shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
 	})
+
+	t.Run("should apply audit policy of the runtime instead of the global one", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+		runtimeCluster := newClusterConfig("test-cluster", nil, gcpGardenerConfig, region, purpose)
+		runtimeCluster.ClusterConfig.KubeAPIServer = &model.KubeAPIServerConfig{AuditPolicyConfigMap: util.PtrTo("runtime-audit-policy")}
+
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisionerClient.ProvisionCluster(runtimeCluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig)
+		assert.Equal(t, "runtime-audit-policy", shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
+	})
+
+	t.Run("should apply region policy", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+
+		regionPolicies := regionpolicy.NewStaticProvider(regionpolicy.Policies{
+			"gcp": {
+				region: {
+					Tolerations:   []string{"sovereign-workload"},
+					SeedSelector:  map[string]string{"sovereign": "true"},
+					ForceEuAccess: true,
+					Annotations:   map[string]string{"sovereign/annotation": "value"},
+					Labels:        map[string]string{"sovereign/label": "value"},
+				},
+			},
+		})
+
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", regionPolicies, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisionerClient.ProvisionCluster(cluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, []gardener_types.Toleration{{Key: "sovereign-workload"}}, shoot.Spec.Tolerations)
+		require.NotNil(t, shoot.Spec.SeedSelector)
+		assert.Equal(t, map[string]string{"sovereign": "true"}, shoot.Spec.SeedSelector.MatchLabels)
+		assertAnnotation(t, shoot, model.EuAccessAnnotation, "true")
+		assertAnnotation(t, shoot, "sovereign/annotation", "value")
+		assert.Equal(t, "value", shoot.Labels["sovereign/label"])
+	})
+
+	t.Run("should set Runtime labels and description", func(t *testing.T) {
+		// given
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+
+		labeledCluster := cluster
+		labeledCluster.Labels = map[string]interface{}{"team": "core"}
+		labeledCluster.Description = util.PtrTo("Runtime of the core team")
+
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisionerClient.ProvisionCluster(labeledCluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assertAnnotation(t, shoot, runtimeLabelPrefix+"team", "core")
+		assertAnnotation(t, shoot, runtimeDescriptionAnnotation, "Runtime of the core team")
+		assert.Equal(t, "core", shoot.Labels[runtimeLabelPrefix+"team"])
+	})
 }
 
 func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
@@ -104,7 +179,7 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
 
 		// when
 		sessionFactoryMock.On("NewWriteSession").Return(session)
@@ -131,7 +206,7 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
 
 		// when
 		sessionFactoryMock.On("NewWriteSession").Return(session)
@@ -185,7 +260,7 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
 		sessionFactory := &sessionMocks.Factory{}
-		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
 
 		// when
 		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
@@ -197,12 +272,42 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 
 		assert.Equal(t, expectedShoot, shoot)
 	})
+	t.Run("should apply region policy to upgraded shoot", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		regionPolicies := regionpolicy.NewStaticProvider(regionpolicy.Policies{
+			cluster.ClusterConfig.Provider: {
+				region: {
+					Tolerations:  []string{"ksa-assured-workload"},
+					SeedSelector: map[string]string{"sovereign": "ksa"},
+					Labels:       map[string]string{"label": "value"},
+				},
+			},
+		})
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", regionPolicies, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, []gardener_types.Toleration{{Key: "ksa-assured-workload"}}, shoot.Spec.Tolerations)
+		assert.Equal(t, "value", shoot.Labels["label"])
+		assert.Nil(t, shoot.Spec.SeedSelector)
+	})
 	t.Run("should return error when failed to get shoot from Gardener", func(t *testing.T) {
 		clientset := fake.NewSimpleClientset()
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
 		sessionFactory := &sessionMocks.Factory{}
-		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", &testkit.TestDataWriter{})
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
 
 		// when
 		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
@@ -254,10 +359,10 @@ func TestGardenerProvisioner_ClusterPurpose(t *testing.T) {
 
 	t.Run("should start provisioning with 2 clusters with different purpose", func(t *testing.T) {
 		shootClient_A := clientset_A.CoreV1beta1().Shoots(gardenerNamespace)
-		provisionerClient_A := NewProvisioner(gardenerNamespace, shootClient_A, nil, auditLogsPolicyCMName, maintWindowConfigPath, &testkit.TestDataWriter{})
+		provisionerClient_A := NewProvisioner(gardenerNamespace, shootClient_A, nil, auditLogsPolicyCMName, maintWindowConfigPath, nil, &testkit.TestDataWriter{})
 
 		shootClient_B := clientset_B.CoreV1beta1().Shoots(gardenerNamespace)
-		provisionerClient_B := NewProvisioner(gardenerNamespace, shootClient_B, nil, auditLogsPolicyCMName, maintWindowConfigPath, &testkit.TestDataWriter{})
+		provisionerClient_B := NewProvisioner(gardenerNamespace, shootClient_B, nil, auditLogsPolicyCMName, maintWindowConfigPath, nil, &testkit.TestDataWriter{})
 
 		//when
 		apperr_A := provisionerClient_A.ProvisionCluster(cluster_A, operationId)
@@ -274,6 +379,117 @@ func TestGardenerProvisioner_ClusterPurpose(t *testing.T) {
 	})
 }
 
+func TestGardenerProvisioner_AdoptCluster(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, util.PtrTo("sub-account"), gcpGardenerConfig, region, purpose)
+
+	t.Run("should annotate and label shoot", func(t *testing.T) {
+		// given
+		initialShoot := testkit.NewTestShoot(clusterName).
+			InNamespace(gardenerNamespace).
+			ToShoot()
+
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.AdoptCluster(cluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+
+		assertAnnotation(t, shoot, operationIDAnnotation, operationId)
+		assertAnnotation(t, shoot, runtimeIDAnnotation, runtimeId)
+		assertAnnotation(t, shoot, legacyOperationIDAnnotation, operationId)
+		assertAnnotation(t, shoot, legacyRuntimeIDAnnotation, runtimeId)
+		assert.Equal(t, tenant, shoot.Labels[model.AccountLabel])
+		assert.Equal(t, "sub-account", shoot.Labels[model.SubAccountLabel])
+	})
+
+	t.Run("should return error when shoot does not exist", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.AdoptCluster(cluster, operationId)
+
+		// then
+		require.Error(t, apperr)
+	})
+}
+
+func TestGardenerProvisioner_UpdateRuntimeMetadata(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should replace Runtime labels and description of shoot", func(t *testing.T) {
+		// given
+		initialShoot := testkit.NewTestShoot(clusterName).
+			InNamespace(gardenerNamespace).
+			ToShoot()
+		initialShoot.Annotations = map[string]string{
+			runtimeIDAnnotation:              runtimeId,
+			runtimeLabelPrefix + "team":      "core",
+			runtimeDescriptionAnnotation:     "old description",
+			runtimeLabelPrefix + "cost-unit": "42",
+		}
+		initialShoot.Labels = map[string]string{
+			model.AccountLabel:               tenant,
+			runtimeLabelPrefix + "team":      "core",
+			runtimeLabelPrefix + "cost-unit": "42",
+		}
+
+		clientset := fake.NewSimpleClientset(initialShoot)
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		updatedCluster := cluster
+		updatedCluster.Labels = map[string]interface{}{"team": "edge", "zones": []interface{}{"a", "b"}}
+
+		// when
+		apperr := provisioner.UpdateRuntimeMetadata(updatedCluster)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+
+		assert.Equal(t, map[string]string{
+			runtimeIDAnnotation:          runtimeId,
+			runtimeLabelPrefix + "team":  "edge",
+			runtimeLabelPrefix + "zones": `["a","b"]`,
+		}, shoot.Annotations)
+		assert.Equal(t, map[string]string{
+			model.AccountLabel:          tenant,
+			runtimeLabelPrefix + "team": "edge",
+		}, shoot.Labels)
+	})
+
+	t.Run("should return error when shoot does not exist", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", nil, &testkit.TestDataWriter{})
+
+		// when
+		apperr := provisioner.UpdateRuntimeMetadata(cluster)
+
+		// then
+		require.Error(t, apperr)
+	})
+}
+
 func assertAnnotation(t *testing.T, shoot *gardener_types.Shoot, name, value string) {
 	annotations := shoot.Annotations
 	if annotations == nil {
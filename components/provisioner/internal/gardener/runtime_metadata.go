@@ -0,0 +1,103 @@
+package gardener
+
+import (
+	"encoding/json"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	runtimeLabelPrefix           string = "runtime.kcp.provisioner.kyma-project.io/"
+	runtimeDescriptionAnnotation string = "kcp.provisioner.kyma-project.io/runtime-description"
+)
+
+// runtimeMetadata returns the annotations and labels of the shoot which reflect labels and description of the Runtime.
+// Every Runtime label is set as the prefixed annotation, the string values which are valid label values are set as the prefixed labels as well.
+func runtimeMetadata(cluster model.Cluster) (annotations map[string]string, labels map[string]string) {
+	annotations = map[string]string{}
+	labels = map[string]string{}
+
+	for key, value := range cluster.Labels {
+		name := runtimeLabelPrefix + key
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			log.Warnf("Runtime label %s of %s cluster is not propagated to Shoot: %s", key, cluster.ID, strings.Join(errs, ", "))
+			continue
+		}
+
+		if text, ok := value.(string); ok {
+			annotations[name] = text
+			if len(validation.IsValidLabelValue(text)) == 0 {
+				labels[name] = text
+			}
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			log.Warnf("Runtime label %s of %s cluster is not propagated to Shoot: %s", key, cluster.ID, err)
+			continue
+		}
+		annotations[name] = string(encoded)
+	}
+
+	if description := util.UnwrapOrZero(cluster.Description); description != "" {
+		annotations[runtimeDescriptionAnnotation] = description
+	}
+
+	return annotations, labels
+}
+
+func setRuntimeMetadata(shoot *gardener_types.Shoot, cluster model.Cluster) {
+	annotations, labels := runtimeMetadata(cluster)
+
+	for key, value := range annotations {
+		annotate(shoot, key, value)
+	}
+
+	if len(labels) > 0 && shoot.Labels == nil {
+		shoot.Labels = map[string]string{}
+	}
+	for key, value := range labels {
+		shoot.Labels[key] = value
+	}
+}
+
+// runtimeMetadataPatch replaces the Runtime labels and description set on the shoot. The values which are no longer present are removed.
+func runtimeMetadataPatch(shoot gardener_types.Shoot, cluster model.Cluster) map[string]interface{} {
+	annotations, labels := runtimeMetadata(cluster)
+
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": metadataPatch(shoot.Annotations, annotations, isRuntimeMetadataAnnotation),
+			"labels":      metadataPatch(shoot.Labels, labels, isRuntimeLabel),
+		},
+	}
+}
+
+func metadataPatch(current, desired map[string]string, managed func(key string) bool) map[string]interface{} {
+	patch := map[string]interface{}{}
+
+	for key := range current {
+		if _, found := desired[key]; managed(key) && !found {
+			patch[key] = nil
+		}
+	}
+	for key, value := range desired {
+		patch[key] = value
+	}
+
+	return patch
+}
+
+func isRuntimeLabel(key string) bool {
+	return strings.HasPrefix(key, runtimeLabelPrefix)
+}
+
+func isRuntimeMetadataAnnotation(key string) bool {
+	return isRuntimeLabel(key) || key == runtimeDescriptionAnnotation
+}
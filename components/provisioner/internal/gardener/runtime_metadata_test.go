@@ -0,0 +1,39 @@
+package gardener
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_runtimeMetadata(t *testing.T) {
+	// given
+	cluster := model.Cluster{
+		ID: runtimeId,
+		Labels: map[string]interface{}{
+			"team":                  "core",
+			"owner":                 "Core Team",
+			"tier":                  float64(1),
+			"invalid/key":           "value",
+			strings.Repeat("a", 64): "value",
+		},
+		Description: util.PtrTo("Runtime of the core team"),
+	}
+
+	// when
+	annotations, labels := runtimeMetadata(cluster)
+
+	// then
+	assert.Equal(t, map[string]string{
+		runtimeLabelPrefix + "team":  "core",
+		runtimeLabelPrefix + "owner": "Core Team",
+		runtimeLabelPrefix + "tier":  "1",
+		runtimeDescriptionAnnotation: "Runtime of the core team",
+	}, annotations)
+	assert.Equal(t, map[string]string{
+		runtimeLabelPrefix + "team": "core",
+	}, labels)
+}
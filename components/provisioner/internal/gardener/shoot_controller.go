@@ -3,6 +3,7 @@ package gardener
 import (
 	"fmt"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/drift"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -17,7 +18,8 @@ import (
 func NewShootController(
 	mgr manager.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogTenantConfigPath string) (*ShootController, error) {
+	auditLogTenantConfigPath string,
+	driftDetector *drift.Detector) (*ShootController, error) {
 
 	err := gardener_types.AddToScheme(mgr.GetScheme())
 	if err != nil {
@@ -26,7 +28,7 @@ func NewShootController(
 
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&gardener_types.Shoot{}).
-		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath)))
+		Complete(NewReconciler(mgr, dbsFactory, NewAuditLogConfigurator(auditLogTenantConfigPath), driftDetector))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create controller: %w", err)
 	}
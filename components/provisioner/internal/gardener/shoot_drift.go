@@ -0,0 +1,106 @@
+package gardener
+
+import (
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+// checkDrift compares the shoot with the Gardener config stored in the database, adopts the allowed values and records the remaining drift.
+// Runtimes with operations in progress are skipped, as their shoots are expected to differ from the database.
+func (r *Reconciler) checkDrift(log logrus.FieldLogger, shoot gardener_types.Shoot, runtimeID string) error {
+	if runtimeID == "" {
+		return nil
+	}
+
+	session := r.dbsFactory.NewReadSession()
+
+	cluster, err := session.GetCluster(runtimeID)
+	if err != nil {
+		if err.Code() == dberrors.CodeNotFound {
+			return nil
+		}
+		return err
+	}
+	if cluster.Deleted {
+		return nil
+	}
+
+	lastOperation, err := session.GetLastOperation(runtimeID)
+	if err != nil && err.Code() != dberrors.CodeNotFound {
+		return err
+	}
+	if err == nil && lastOperation.State == model.InProgress {
+		log.Debug("Operation in progress, skipping drift detection")
+		return nil
+	}
+
+	previous, err := session.ListDriftItems(runtimeID)
+	if err != nil {
+		return err
+	}
+
+	result := r.driftDetector.Detect(shoot, cluster.ClusterConfig, previous)
+	if len(result.Adopted) == 0 && !result.Changed(previous) {
+		return nil
+	}
+
+	txSession, err := r.dbsFactory.NewSessionWithinTransaction()
+	if err != nil {
+		return err
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	resourceVersion, err := txSession.LockGardenerConfig(runtimeID)
+	if err != nil {
+		return err
+	}
+	if resourceVersion != cluster.ClusterConfig.ResourceVersion {
+		log.Debug("Gardener config modified during drift detection, skipping")
+		return nil
+	}
+
+	inProgress, err := txSession.HasInProgressOperation(runtimeID)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		log.Debug("Operation started during drift detection, skipping")
+		return nil
+	}
+
+	if len(result.Adopted) > 0 {
+		if err := txSession.UpdateGardenerClusterConfig(result.Config); err != nil {
+			return err
+		}
+
+		if err := txSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+			RuntimeID:         runtimeID,
+			CreationTimestamp: time.Now(),
+			Config:            result.Config,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := txSession.ReplaceDriftItems(runtimeID, result.Reported); err != nil {
+		return err
+	}
+
+	if err := txSession.Commit(); err != nil {
+		return err
+	}
+
+	if len(result.Adopted) > 0 {
+		log.Infof("Adopted %v fields from shoot", result.Adopted)
+	}
+	if len(result.Reported) > 0 {
+		log.Infof("Detected drift of %d fields", len(result.Reported))
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package gardener
+
+import (
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/drift"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/inmemory"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+func TestReconciler_CheckDrift(t *testing.T) {
+	const driftRuntimeID = "e3a5fa1c-2b4d-4f5e-9d1b-0c6f2a7e8b90"
+
+	newDriftFixture := func(t *testing.T, operationState model.OperationState) (dbsession.Factory, *Reconciler) {
+		factory := inmemory.NewFactory()
+		session := factory.NewWriteSession()
+
+		cluster := model.Cluster{
+			ID:                driftRuntimeID,
+			Tenant:            "tenant",
+			CreationTimestamp: time.Now(),
+			ClusterConfig: model.GardenerConfig{
+				ID:                "gardener-config-id",
+				ClusterID:         driftRuntimeID,
+				Name:              "shoot",
+				KubernetesVersion: "1.27.5",
+				MachineType:       "m5.xlarge",
+				AutoScalerMin:     3,
+				AutoScalerMax:     10,
+			},
+		}
+		require.NoError(t, session.InsertCluster(cluster))
+		require.NoError(t, session.InsertGardenerConfig(cluster.ClusterConfig))
+		require.NoError(t, session.InsertOperation(model.Operation{
+			ID:             "operation-id",
+			Type:           model.Provision,
+			StartTimestamp: time.Now(),
+			State:          operationState,
+			ClusterID:      driftRuntimeID,
+		}))
+
+		policies := drift.DefaultPolicies()
+		policies[drift.FieldKubernetesVersion] = model.DriftPolicyAdopt
+
+		reconciler := &Reconciler{
+			dbsFactory:    factory,
+			log:           logrus.WithField("Component", "ShootReconciler"),
+			driftDetector: drift.NewDetector(policies),
+		}
+		return factory, reconciler
+	}
+
+	shoot := gardener_types.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "shoot",
+			Annotations: map[string]string{runtimeIDAnnotation: driftRuntimeID},
+		},
+		Spec: gardener_types.ShootSpec{
+			Kubernetes: gardener_types.Kubernetes{Version: "1.28.2"},
+			Provider: gardener_types.Provider{
+				Workers: []gardener_types.Worker{
+					{Machine: gardener_types.Machine{Type: "m5.2xlarge"}, Minimum: 3, Maximum: 10},
+				},
+			},
+		},
+	}
+
+	t.Run("should adopt allowed fields and record remaining drift", func(t *testing.T) {
+		// given
+		factory, reconciler := newDriftFixture(t, model.Succeeded)
+
+		// when
+		err := reconciler.checkDrift(reconciler.log, shoot, driftRuntimeID)
+
+		// then
+		require.NoError(t, err)
+
+		session := factory.NewReadSession()
+		cluster, dberr := session.GetCluster(driftRuntimeID)
+		require.NoError(t, dberr)
+		assert.Equal(t, "1.28.2", cluster.ClusterConfig.KubernetesVersion)
+		assert.Equal(t, "m5.xlarge", cluster.ClusterConfig.MachineType)
+
+		revisions, dberr := session.ListGardenerConfigRevisions(driftRuntimeID)
+		require.NoError(t, dberr)
+		require.Len(t, revisions, 1)
+		assert.Nil(t, revisions[0].OperationID)
+		assert.Equal(t, "1.28.2", revisions[0].Config.KubernetesVersion)
+
+		items, dberr := session.ListDriftItems(driftRuntimeID)
+		require.NoError(t, dberr)
+		require.Len(t, items, 1)
+		assert.Equal(t, drift.FieldMachineType, items[0].Field)
+		assert.Equal(t, util.PtrTo("m5.xlarge"), items[0].StoredValue)
+		assert.Equal(t, util.PtrTo("m5.2xlarge"), items[0].ActualValue)
+	})
+
+	t.Run("should skip Runtime with operation in progress", func(t *testing.T) {
+		// given
+		factory, reconciler := newDriftFixture(t, model.InProgress)
+
+		// when
+		err := reconciler.checkDrift(reconciler.log, shoot, driftRuntimeID)
+
+		// then
+		require.NoError(t, err)
+
+		session := factory.NewReadSession()
+		cluster, dberr := session.GetCluster(driftRuntimeID)
+		require.NoError(t, dberr)
+		assert.Equal(t, "1.27.5", cluster.ClusterConfig.KubernetesVersion)
+
+		items, dberr := session.ListDriftItems(driftRuntimeID)
+		require.NoError(t, dberr)
+		assert.Empty(t, items)
+	})
+
+	t.Run("should ignore shoot of unknown Runtime", func(t *testing.T) {
+		// given
+		_, reconciler := newDriftFixture(t, model.Succeeded)
+
+		// when
+		err := reconciler.checkDrift(reconciler.log, shoot, "f1d2c3b4-0000-4000-8000-000000000000")
+
+		// then
+		require.NoError(t, err)
+	})
+}
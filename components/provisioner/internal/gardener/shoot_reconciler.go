@@ -3,6 +3,7 @@ package gardener
 import (
 	"context"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/drift"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -21,7 +22,8 @@ import (
 func NewReconciler(
 	mgr ctrl.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogConfigurator AuditLogConfigurator) *Reconciler {
+	auditLogConfigurator AuditLogConfigurator,
+	driftDetector *drift.Detector) *Reconciler {
 	return &Reconciler{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
@@ -29,6 +31,7 @@ func NewReconciler(
 
 		dbsFactory:           dbsFactory,
 		auditLogConfigurator: auditLogConfigurator,
+		driftDetector:        driftDetector,
 	}
 }
 
@@ -40,6 +43,8 @@ type Reconciler struct {
 	log *logrus.Entry
 
 	auditLogConfigurator AuditLogConfigurator
+	// driftDetector is nil if drift detection is disabled
+	driftDetector *drift.Detector
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -76,6 +81,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	if r.driftDetector != nil {
+		if err := r.checkDrift(log, shoot, runtimeId); err != nil {
+			log.Warnf("Failed to check drift of %s shoot: %s", shoot.Name, err.Error())
+		}
+	}
+
 	return ctrl.Result{}, nil
 }
 
@@ -7,11 +7,21 @@ const (
 	prometheusSubsystem = "provisioner"
 )
 
-func Register(opsStatsGetter OperationsStatsGetter) error {
+func Register(opsStatsGetter OperationsStatsGetter, driftGetter DriftGetter, purgedRows *PurgedRowsCounter) error {
 	err := prometheus.Register(NewInProgressOperationsCollector(opsStatsGetter))
 	if err != nil {
 		return err
 	}
 
+	err = prometheus.Register(NewRuntimeDriftCollector(driftGetter))
+	if err != nil {
+		return err
+	}
+
+	err = prometheus.Register(purgedRows)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// DriftGetter is an autogenerated mock type for the DriftGetter type
+type DriftGetter struct {
+	mock.Mock
+}
+
+// ListAllDriftItems provides a mock function with given fields:
+func (_m *DriftGetter) ListAllDriftItems() ([]model.DriftItem, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []model.DriftItem
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func() ([]model.DriftItem, apperrors.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.DriftItem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DriftItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewDriftGetter creates a new instance of DriftGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriftGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriftGetter {
+	mock := &DriftGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// PurgedRowsCounter counts rows removed by the retention of deleted clusters, by the kind of purged data
+type PurgedRowsCounter struct {
+	counter *prometheus.CounterVec
+}
+
+func NewPurgedRowsCounter() *PurgedRowsCounter {
+	return &PurgedRowsCounter{
+		counter: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "retention_purged_rows_total",
+			Help:      "The number of rows purged by the retention of deleted clusters",
+		}, []string{"kind"}),
+	}
+}
+
+func (c *PurgedRowsCounter) Add(kind string, rows int) {
+	c.counter.WithLabelValues(kind).Add(float64(rows))
+}
+
+func (c *PurgedRowsCounter) Describe(ch chan<- *prometheus.Desc) {
+	c.counter.Describe(ch)
+}
+
+func (c *PurgedRowsCounter) Collect(ch chan<- prometheus.Metric) {
+	c.counter.Collect(ch)
+}
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PurgedRowsCounter_Add(t *testing.T) {
+	counter := NewPurgedRowsCounter()
+
+	counter.Add("kubeconfig", 3)
+	counter.Add("kubeconfig", 2)
+	counter.Add("deleted_cluster", 0)
+
+	assert.Equal(t, float64(5), testutil.ToFloat64(counter.counter.WithLabelValues("kubeconfig")))
+	assert.Equal(t, float64(0), testutil.ToFloat64(counter.counter.WithLabelValues("deleted_cluster")))
+	assert.Equal(t, 2, testutil.CollectAndCount(counter, "kcp_provisioner_retention_purged_rows_total"))
+}
@@ -0,0 +1,58 @@
+package metrics
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name=DriftGetter
+type DriftGetter interface {
+	ListAllDriftItems() ([]model.DriftItem, dberrors.Error)
+}
+
+// RuntimeDriftCollector exposes fields of Runtimes whose shoots differ from the Gardener config stored in the database
+type RuntimeDriftCollector struct {
+	driftGetter DriftGetter
+
+	driftDesc *prometheus.Desc
+
+	log logrus.FieldLogger
+}
+
+func NewRuntimeDriftCollector(driftGetter DriftGetter) *RuntimeDriftCollector {
+	return &RuntimeDriftCollector{
+		driftGetter: driftGetter,
+
+		driftDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "runtime_drift"),
+			"Fields of the Runtime whose values in the shoot differ from the database",
+			[]string{"runtime_id", "field"},
+			nil),
+
+		log: logrus.WithField("collector", "runtime-drift"),
+	}
+}
+
+func (c *RuntimeDriftCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.driftDesc
+}
+
+func (c *RuntimeDriftCollector) Collect(ch chan<- prometheus.Metric) {
+	items, err := c.driftGetter.ListAllDriftItems()
+	if err != nil {
+		c.log.Errorf("failed to list drift of Runtimes while collecting metrics: %s", err.Error())
+
+		return
+	}
+
+	for _, item := range items {
+		m, err := prometheus.NewConstMetric(c.driftDesc, prometheus.GaugeValue, 1, item.RuntimeID, item.Field)
+		if err != nil {
+			c.log.Errorf("unable to register metric %s", err.Error())
+			continue
+		}
+		ch <- m
+	}
+}
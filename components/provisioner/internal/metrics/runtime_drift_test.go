@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RuntimeDriftCollector_Collect(t *testing.T) {
+	t.Run("should expose drift of Runtimes", func(t *testing.T) {
+		driftGetter := &mocks.DriftGetter{}
+		driftGetter.On("ListAllDriftItems").Return([]model.DriftItem{
+			{RuntimeID: "runtime-1", Field: "machineType"},
+			{RuntimeID: "runtime-2", Field: "autoScalerMax"},
+		}, nil)
+
+		collector := NewRuntimeDriftCollector(driftGetter)
+
+		receiver := make(chan prometheus.Metric, 5)
+		defer close(receiver)
+
+		collector.Collect(receiver)
+
+		require.Len(t, receiver, 2)
+		assertDriftMetric(t, <-receiver, "runtime-1", "machineType")
+		assertDriftMetric(t, <-receiver, "runtime-2", "autoScalerMax")
+	})
+
+	t.Run("should not expose metrics if drift cannot be listed", func(t *testing.T) {
+		driftGetter := &mocks.DriftGetter{}
+		driftGetter.On("ListAllDriftItems").Return(nil, dberrors.Internal("error"))
+
+		collector := NewRuntimeDriftCollector(driftGetter)
+
+		receiver := make(chan prometheus.Metric, 5)
+		defer close(receiver)
+
+		collector.Collect(receiver)
+
+		assert.Empty(t, receiver)
+	})
+}
+
+func Test_RuntimeDriftCollector_Describe(t *testing.T) {
+	collector := NewRuntimeDriftCollector(nil)
+
+	receiver := make(chan *prometheus.Desc, 5)
+	defer close(receiver)
+
+	collector.Describe(receiver)
+
+	assert.Contains(t, (<-receiver).String(), "kcp_provisioner_runtime_drift")
+}
+
+func assertDriftMetric(t *testing.T, metric prometheus.Metric, runtimeID, field string) {
+	assertGaugeValue(t, metric, float64(1))
+
+	metricDto := dto.Metric{}
+	err := metric.Write(&metricDto)
+	require.NoError(t, err)
+
+	labels := map[string]string{}
+	for _, label := range metricDto.Label {
+		labels[label.GetName()] = label.GetValue()
+	}
+	assert.Equal(t, map[string]string{"runtime_id": runtimeID, "field": field}, labels)
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+type AuditEventOutcome string
+
+const (
+	AuditEventSucceeded AuditEventOutcome = "SUCCEEDED"
+	AuditEventFailed    AuditEventOutcome = "FAILED"
+)
+
+type AuditEvent struct {
+	ID        string
+	Timestamp time.Time
+	Caller    string
+	// UserAgent is sent by the client and is not verified, so it does not identify the caller
+	UserAgent    *string
+	Tenant       string
+	SubAccountID *string
+	Mutation     string
+	RuntimeID    *string
+	Input        *string
+	OperationID  *string
+	Outcome      AuditEventOutcome
+	ErrMessage   *string
+}
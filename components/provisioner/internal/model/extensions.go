@@ -0,0 +1,118 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+// AuditLogExtensionType is the type of the extension managed by the audit log reconciler of the shoot controller, which cannot be configured with the input
+const AuditLogExtensionType = "shoot-auditlog-service"
+
+// Extension is the Gardener extension of the shoot
+type Extension struct {
+	Type           string          `json:"type"`
+	Disabled       *bool           `json:"disabled,omitempty"`
+	ProviderConfig json.RawMessage `json:"providerConfig,omitempty"`
+}
+
+// DefaultExtensions are added to the shoots whose config does not contain extensions
+func DefaultExtensions() ([]Extension, error) {
+	dnsConfig, err := json.Marshal(NewDNSConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding DNS extension config: %s", err.Error())
+	}
+	certConfig, err := json.Marshal(NewCertConfig())
+	if err != nil {
+		return nil, fmt.Errorf("error encoding Cert extension config: %s", err.Error())
+	}
+
+	return []Extension{
+		{Type: "shoot-dns-service", ProviderConfig: dnsConfig},
+		{Type: "shoot-cert-service", ProviderConfig: certConfig},
+		{Type: ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(ShootNetworkingFilterDisabledDefault)},
+		{Type: "shoot-oidc-service", Disabled: util.PtrTo(false)},
+	}, nil
+}
+
+// FindExtension returns the extension of the type
+func FindExtension(extensions []Extension, extensionType string) (Extension, bool) {
+	for _, extension := range extensions {
+		if extension.Type == extensionType {
+			return extension, true
+		}
+	}
+	return Extension{}, false
+}
+
+// MergeExtensions overrides the extensions with the overrides of the same type and appends the overrides of the new types.
+// The fields which are not set in the override keep their values.
+func MergeExtensions(extensions []Extension, overrides []Extension) []Extension {
+	merged := make([]Extension, 0, len(extensions)+len(overrides))
+	merged = append(merged, extensions...)
+
+	for _, override := range overrides {
+		index := -1
+		for i := range merged {
+			if merged[i].Type == override.Type {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			merged = append(merged, override)
+			continue
+		}
+
+		if override.Disabled != nil {
+			merged[index].Disabled = override.Disabled
+		}
+		if override.ProviderConfig != nil {
+			merged[index].ProviderConfig = override.ProviderConfig
+		}
+	}
+	return merged
+}
+
+// mergeShootExtensions applies the extensions of the config to the shoot. The extensions of other types, like the ones managed by the shoot controller, stay untouched.
+func mergeShootExtensions(shootExtensions []gardener_types.Extension, extensions []Extension) []gardener_types.Extension {
+	for _, extension := range extensions {
+		index := -1
+		for i := range shootExtensions {
+			if shootExtensions[i].Type == extension.Type {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			shootExtensions = append(shootExtensions, toShootExtension(extension))
+			continue
+		}
+
+		if extension.Disabled != nil {
+			shootExtensions[index].Disabled = extension.Disabled
+		}
+		if extension.ProviderConfig != nil {
+			shootExtensions[index].ProviderConfig = toShootRawExtension(extension.ProviderConfig)
+		}
+	}
+	return shootExtensions
+}
+
+func toShootExtensions(extensions []Extension) []gardener_types.Extension {
+	shootExtensions := make([]gardener_types.Extension, 0, len(extensions))
+	for _, extension := range extensions {
+		shootExtensions = append(shootExtensions, toShootExtension(extension))
+	}
+	return shootExtensions
+}
+
+func toShootExtension(extension Extension) gardener_types.Extension {
+	return gardener_types.Extension{
+		Type:           extension.Type,
+		Disabled:       extension.Disabled,
+		ProviderConfig: toShootRawExtension(extension.ProviderConfig),
+	}
+}
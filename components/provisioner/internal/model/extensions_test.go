@@ -0,0 +1,82 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGardenerConfig_ToShootTemplate_Extensions(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	t.Run("should add extensions of the config", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("gcp", gcpGardenerProvider)
+		config.ShootNetworkingFilterDisabled = util.PtrTo(false)
+		config.Extensions = []Extension{
+			{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: "shoot-lakom-service", Disabled: util.PtrTo(true)},
+		}
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []gardener_types.Extension{
+			{Type: "shoot-dns-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"DNSConfig"}`)}},
+			{Type: "shoot-lakom-service", Disabled: util.PtrTo(true)},
+			{Type: ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(false)},
+		}, template.Spec.Extensions)
+	})
+
+	t.Run("should add default extensions when config has no extensions", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("gcp", gcpGardenerProvider)
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		defaults, encodingErr := DefaultExtensions()
+		require.NoError(t, encodingErr)
+		assert.Equal(t, toShootExtensions(defaults), template.Spec.Extensions)
+	})
+}
+
+func TestEditShootConfig_Extensions(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	// given
+	config := fixGardenerConfig("gcp", gcpGardenerProvider)
+	config.Extensions = []Extension{
+		{Type: "shoot-dns-service", Disabled: util.PtrTo(true)},
+		{Type: "shoot-lakom-service", ProviderConfig: []byte(`{"kind":"LakomConfig"}`)},
+	}
+	shoot := testkit.NewTestShoot("shoot").
+		WithWorkers(testkit.NewTestWorker("peon").WithZones("fix-zone-1").ToWorker()).
+		ToShoot()
+	shoot.Spec.Extensions = []gardener_types.Extension{
+		{Type: "shoot-dns-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"DNSConfig"}`)}},
+		{Type: AuditLogExtensionType, ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"tenantId":"tenant"}`)}},
+	}
+
+	// when
+	err = gcpGardenerProvider.EditShootConfig(config, shoot)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []gardener_types.Extension{
+		{Type: "shoot-dns-service", Disabled: util.PtrTo(true), ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"DNSConfig"}`)}},
+		{Type: AuditLogExtensionType, ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"tenantId":"tenant"}`)}},
+		{Type: "shoot-lakom-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"LakomConfig"}`)}},
+	}, shoot.Spec.Extensions)
+}
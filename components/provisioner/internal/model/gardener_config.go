@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/go-version"
+	"slices"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
@@ -25,9 +26,9 @@ const (
 	EuAccessAnnotation                   = "support.gardener.cloud/eu-access-for-cluster-nodes"
 	ShootNetworkingFilterExtensionType   = "shoot-networking-filter"
 	ShootNetworkingFilterDisabledDefault = true
+	SeedRegionLabel                      = "seed.gardener.cloud/region"
 )
 
-var networkingType = "calico"
 var awsIMDSv2HTTPPutResponseHopLimit int64 = 2
 
 type OIDCConfig struct {
@@ -62,8 +63,10 @@ type GardenerConfig struct {
 	EnableMachineImageVersionAutoUpdate bool
 	EuAccess                            bool
 	ExposureClassName                   *string
+	Extensions                          []Extension `db:"-"`
 	GardenerProviderConfig              GardenerProviderConfig
 	ID                                  string
+	KubeAPIServer                       *KubeAPIServerConfig `db:"-"`
 	KubernetesVersion                   string
 	LicenceType                         *string
 	MachineImage                        *string
@@ -72,14 +75,17 @@ type GardenerConfig struct {
 	MaxSurge                            int
 	MaxUnavailable                      int
 	Name                                string
+	Networking                          *NetworkingConfig `db:"-"`
 	OIDCConfig                          *OIDCConfig
 	PodsCIDR                            *string
 	ProjectName                         string
 	Provider                            string
 	Purpose                             *string
 	Region                              string
+	ResourceVersion                     int
 	Seed                                string
 	ServicesCIDR                        *string
+	ShootAndSeedSameRegion              bool
 	ShootNetworkingFilterDisabled       *bool
 	TargetSecret                        string
 	VolumeSizeGB                        *int
@@ -149,16 +155,16 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 		annotations[EuAccessAnnotation] = fmt.Sprintf("%t", c.EuAccess)
 	}
 
-	dnsConfig := NewDNSConfig()
-	jsonDNSConfig, encodingErr := json.Marshal(dnsConfig)
-	if encodingErr != nil {
-		return nil, apperrors.Internal("error encoding DNS extension config: %s", encodingErr.Error())
+	extensions := c.Extensions
+	if len(extensions) == 0 {
+		defaultExtensions, encodingErr := DefaultExtensions()
+		if encodingErr != nil {
+			return nil, apperrors.Internal(encodingErr.Error())
+		}
+		extensions = defaultExtensions
 	}
-
-	certConfig := NewCertConfig()
-	jsonCertConfig, encodingErr := json.Marshal(certConfig)
-	if encodingErr != nil {
-		return nil, apperrors.Internal("error encoding Cert extension config: %s", encodingErr.Error())
+	if c.ShootNetworkingFilterDisabled != nil {
+		extensions = MergeExtensions(extensions, []Extension{{Type: ShootNetworkingFilterExtensionType, Disabled: c.ShootNetworkingFilterDisabled}})
 	}
 
 	var controlPlane *gardener_types.ControlPlane = nil
@@ -193,10 +199,12 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				EnableStaticTokenKubeconfig: util.PtrTo(false),
 			},
 			Networking: &gardener_types.Networking{
-				Type:     &networkingType, // Default value - we may consider adding it to API (if Hydroform will support it)
-				Nodes:    util.PtrTo(c.GardenerProviderConfig.NodeCIDR(c)),
-				Pods:     c.PodsCIDR,
-				Services: c.ServicesCIDR,
+				Type:           util.PtrTo(c.Networking.NetworkingType()),
+				ProviderConfig: toShootRawExtension(c.Networking.providerConfig()),
+				Nodes:          util.PtrTo(c.GardenerProviderConfig.NodeCIDR(c)),
+				Pods:           c.PodsCIDR,
+				Services:       c.ServicesCIDR,
+				IPFamilies:     toShootIPFamilies(c.Networking.ipFamilies()),
 			},
 			Purpose:           purpose,
 			ExposureClassName: exposureClassName,
@@ -206,17 +214,22 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 					MachineImageVersion: &c.EnableMachineImageVersionAutoUpdate,
 				},
 			},
-			DNS: gardenerDnsConfig(dnsInputConfig),
-			Extensions: []gardener_types.Extension{
-				{Type: "shoot-dns-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonDNSConfig}},
-				{Type: "shoot-cert-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonCertConfig}},
-				{Type: ShootNetworkingFilterExtensionType, Disabled: util.OkOrDefault(c.ShootNetworkingFilterDisabled, util.PtrTo(ShootNetworkingFilterDisabledDefault))},
-				{Type: "shoot-oidc-service", Disabled: util.PtrTo(false)},
-			},
+			DNS:          gardenerDnsConfig(dnsInputConfig),
+			Extensions:   toShootExtensions(extensions),
 			ControlPlane: controlPlane,
 		},
 	}
 
+	applyKubeAPIServerConfig(shoot.Spec.Kubernetes.KubeAPIServer, c.KubeAPIServer)
+
+	if c.ShootAndSeedSameRegion {
+		shoot.Spec.SeedSelector = &gardener_types.SeedSelector{
+			LabelSelector: v1.LabelSelector{
+				MatchLabels: map[string]string{SeedRegionLabel: c.Region},
+			},
+		}
+	}
+
 	err := c.GardenerProviderConfig.ExtendShootConfig(c, shoot)
 	if err != nil {
 		return nil, err.Append("error extending shoot config with Provider")
@@ -335,13 +348,19 @@ func (c GCPGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecific
 }
 
 func (c GCPGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	return updateShootConfig(gardenerConfig, shoot)
-}
+	err := updateShootConfig(gardenerConfig, shoot)
+	if err != nil {
+		return err
+	}
 
-func (c GCPGardenerConfig) ValidateShootConfigChange(*gardener_types.Shoot) apperrors.AppError {
+	addWorkerZones(shoot, addedZones(shoot, c.input.Zones))
 	return nil
 }
 
+func (c GCPGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError {
+	return validateZoneChange(shoot, c.input.Zones)
+}
+
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
@@ -423,7 +442,7 @@ type AWSGardenerConfig struct {
 }
 
 func (c AzureGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError {
-	// Check if the zone is already configured. Deny change to CIDR. Deny new zones (no support for extension of zones).
+	// Check if the zone is already configured. Deny change to CIDR. New zones get new subnets, if the shoot has subnets per zone.
 	infra := azure.InfrastructureConfig{}
 	if c.input.AzureZones != nil {
 		err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
@@ -441,11 +460,49 @@ func (c AzureGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Sho
 				}
 			}
 		}
-		if !zoneFound {
-			return apperrors.BadRequest("extension of shoot network zones is not supported")
+		if !zoneFound && len(infra.Networks.Zones) == 0 {
+			return apperrors.BadRequest("cannot add zone %d with its own subnet to shoot network with single subnet", inputZone.Name)
+		}
+	}
+
+	return validateZoneChange(shoot, c.zoneNames())
+}
+
+func (c AzureGardenerConfig) zoneNames() []string {
+	if len(c.input.AzureZones) > 0 {
+		return getAzureZonesNames(c.input.AzureZones)
+	}
+	return c.input.Zones
+}
+
+// addZones adds the new zones to the worker, and the subnets of the new zones to the infrastructure config
+func (c AzureGardenerConfig) addZones(shoot *gardener_types.Shoot) apperrors.AppError {
+	added := addedZones(shoot, c.zoneNames())
+	if len(added) == 0 {
+		return nil
+	}
+
+	if len(c.input.AzureZones) > 0 {
+		infra := azure.InfrastructureConfig{}
+		err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
+		if err != nil {
+			return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
+		}
+
+		for _, zone := range createAzureZones(c.input) {
+			if slices.Contains(added, fmt.Sprint(zone.Name)) && !slices.ContainsFunc(infra.Networks.Zones, func(infraZone azure.Zone) bool { return infraZone.Name == zone.Name }) {
+				infra.Networks.Zones = append(infra.Networks.Zones, zone)
+			}
+		}
+
+		jsonData, err := json.Marshal(infra)
+		if err != nil {
+			return apperrors.Internal("error encoding infrastructure config: %s", err.Error())
 		}
+		shoot.Spec.Provider.InfrastructureConfig = &apimachineryRuntime.RawExtension{Raw: jsonData}
 	}
 
+	addWorkerZones(shoot, added)
 	return nil
 }
 
@@ -454,6 +511,10 @@ func (c AzureGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoo
 	if err != nil {
 		return err
 	}
+	err = c.addZones(shoot)
+	if err != nil {
+		return err
+	}
 	if c.input.EnableNatGateway != nil {
 		infra := azure.InfrastructureConfig{}
 		err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
@@ -499,10 +560,7 @@ func (c AzureGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoo
 func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "az"
 
-	zoneNames := c.input.Zones
-	if len(c.input.AzureZones) > 0 {
-		zoneNames = getAzureZonesNames(c.input.AzureZones)
-	}
+	zoneNames := c.zoneNames()
 	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
@@ -572,11 +630,10 @@ func (c AWSGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 	if err != nil {
 		return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
 	}
+	// Check if the zone is already configured. Deny change to CIDRs. New zones get new subnets.
 	for _, inputZone := range c.input.AwsZones {
-		zoneFound := false
 		for _, zone := range infra.Networks.Zones {
 			if inputZone.Name == zone.Name {
-				zoneFound = true
 				if inputZone.WorkerCidr != zone.Workers {
 					return apperrors.BadRequest("cannot change shoot network zone workers CIDR from %s to %s", zone.Workers, inputZone.WorkerCidr)
 				}
@@ -584,16 +641,50 @@ func (c AWSGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 					return apperrors.BadRequest("cannot change shoot network zone internal CIDR from %s to %s", zone.Internal, inputZone.InternalCidr)
 				}
 				if inputZone.PublicCidr != zone.Public {
-					return apperrors.BadRequest("cannot change shoot network zone internal CIDR from %s to %s", zone.Public, inputZone.PublicCidr)
+					return apperrors.BadRequest("cannot change shoot network zone public CIDR from %s to %s", zone.Public, inputZone.PublicCidr)
 				}
 			}
 		}
+	}
+
+	zoneNames := getAWSZonesNames(c.input.AwsZones)
+	if len(zoneNames) > 0 {
+		for _, zone := range infra.Networks.Zones {
+			if !slices.Contains(zoneNames, zone.Name) {
+				return apperrors.BadRequest("removal of zone %s is not supported, as the nodes, volumes, and subnets of the zone cannot be moved to the remaining zones", zone.Name)
+			}
+		}
+	}
+
+	return validateZoneChange(shoot, zoneNames)
+}
+
+// addZones adds the new zones to the worker, and the subnets of the new zones to the infrastructure config
+func (c AWSGardenerConfig) addZones(shoot *gardener_types.Shoot) apperrors.AppError {
+	added := addedZones(shoot, getAWSZonesNames(c.input.AwsZones))
+	if len(added) == 0 {
+		return nil
+	}
+
+	infra := aws.InfrastructureConfig{}
+	err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
+	if err != nil {
+		return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
+	}
 
-		if !zoneFound {
-			return apperrors.BadRequest("extension of shoot network zones is not supported")
+	for _, zone := range createAWSZones(c.input.AwsZones) {
+		if slices.Contains(added, zone.Name) && !slices.ContainsFunc(infra.Networks.Zones, func(infraZone aws.Zone) bool { return infraZone.Name == zone.Name }) {
+			infra.Networks.Zones = append(infra.Networks.Zones, zone)
 		}
 	}
 
+	jsonData, err := json.Marshal(infra)
+	if err != nil {
+		return apperrors.Internal("error encoding infrastructure config: %s", err.Error())
+	}
+	shoot.Spec.Provider.InfrastructureConfig = &apimachineryRuntime.RawExtension{Raw: jsonData}
+
+	addWorkerZones(shoot, added)
 	return nil
 }
 
@@ -602,6 +693,10 @@ func (c AWSGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot
 	if err != nil {
 		return err
 	}
+	err = c.addZones(shoot)
+	if err != nil {
+		return err
+	}
 
 	if c.input.EnableIMDSv2 != nil && *c.input.EnableIMDSv2 {
 		var (
@@ -829,6 +924,20 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.ExposureClassName = upgradeConfig.ExposureClassName
 	}
 
+	// The networking type and the IP families are immutable, only the provider config of the networking plugin can be changed
+	if providerConfig := upgradeConfig.Networking.providerConfig(); providerConfig != nil && shoot.Spec.Networking != nil {
+		shoot.Spec.Networking.ProviderConfig = toShootRawExtension(providerConfig)
+	}
+
+	if upgradeConfig.KubeAPIServer != nil {
+		if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{}
+		}
+		applyKubeAPIServerConfig(shoot.Spec.Kubernetes.KubeAPIServer, upgradeConfig.KubeAPIServer)
+	}
+
+	shoot.Spec.Extensions = mergeShootExtensions(shoot.Spec.Extensions, upgradeConfig.Extensions)
+
 	if upgradeConfig.ShootNetworkingFilterDisabled != nil {
 		upgradedExtensions := []gardener_types.Extension{}
 		for _, extension := range shoot.Spec.Extensions {
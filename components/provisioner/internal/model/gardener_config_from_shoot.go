@@ -0,0 +1,327 @@
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/gcp"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/openstack"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const gardenerProjectNamespacePrefix = "garden-"
+
+// NewGardenerConfigFromShoot reads the Gardener config from the shoot, which is the reverse of ToShootTemplate. IDs of the config and the cluster are not set.
+func NewGardenerConfigFromShoot(shoot gardener_types.Shoot) (GardenerConfig, apperrors.AppError) {
+	worker, err := firstWorker(shoot)
+	if err != nil {
+		return GardenerConfig{}, err
+	}
+
+	config := GardenerConfig{
+		Name:                          shoot.Name,
+		ProjectName:                   strings.TrimPrefix(shoot.Namespace, gardenerProjectNamespacePrefix),
+		KubernetesVersion:             shoot.Spec.Kubernetes.Version,
+		Region:                        shoot.Spec.Region,
+		Provider:                      shoot.Spec.Provider.Type,
+		Seed:                          util.UnwrapOrZero(shoot.Spec.SeedName),
+		TargetSecret:                  util.UnwrapOrZero(shoot.Spec.SecretBindingName),
+		ExposureClassName:             shoot.Spec.ExposureClassName,
+		MachineType:                   worker.Machine.Type,
+		AutoScalerMin:                 int(worker.Minimum),
+		AutoScalerMax:                 int(worker.Maximum),
+		MaxSurge:                      intOrStringValue(worker.MaxSurge),
+		MaxUnavailable:                intOrStringValue(worker.MaxUnavailable),
+		OIDCConfig:                    oidcConfigFromShoot(shoot),
+		KubeAPIServer:                 kubeAPIServerFromShoot(shoot.Spec.Kubernetes.KubeAPIServer),
+		DNSConfig:                     dnsConfigFromShoot(shoot),
+		ShootNetworkingFilterDisabled: shootNetworkingFilterDisabledFromShoot(shoot),
+	}
+
+	if selector := shoot.Spec.SeedSelector; selector != nil && shoot.Spec.Region != "" {
+		config.ShootAndSeedSameRegion = selector.MatchLabels[SeedRegionLabel] == shoot.Spec.Region
+	}
+
+	if shoot.Spec.Purpose != nil {
+		config.Purpose = util.PtrTo(string(*shoot.Spec.Purpose))
+	}
+	if licenceType, found := shoot.Annotations[LicenceTypeAnnotation]; found {
+		config.LicenceType = util.PtrTo(licenceType)
+	}
+	config.EuAccess = shoot.Annotations[EuAccessAnnotation] == "true"
+
+	if worker.Machine.Image != nil {
+		config.MachineImage = util.PtrTo(worker.Machine.Image.Name)
+		config.MachineImageVersion = worker.Machine.Image.Version
+	}
+	if worker.Volume != nil {
+		config.DiskType = worker.Volume.Type
+		size, parseErr := resource.ParseQuantity(worker.Volume.VolumeSize)
+		if parseErr != nil {
+			return GardenerConfig{}, apperrors.BadRequest("invalid volume size %s of shoot %s: %s", worker.Volume.VolumeSize, shoot.Name, parseErr.Error())
+		}
+		config.VolumeSizeGB = util.PtrTo(int(size.Value() >> 30))
+	}
+
+	config.WorkerCidr = workerCIDRFromShoot(shoot)
+	if shoot.Spec.Networking != nil {
+		config.PodsCIDR = shoot.Spec.Networking.Pods
+		config.ServicesCIDR = shoot.Spec.Networking.Services
+		config.Networking = networkingFromShoot(shoot.Spec.Networking)
+	}
+
+	if maintenance := shoot.Spec.Maintenance; maintenance != nil && maintenance.AutoUpdate != nil {
+		config.EnableKubernetesVersionAutoUpdate = maintenance.AutoUpdate.KubernetesVersion
+		config.EnableMachineImageVersionAutoUpdate = util.UnwrapOrZero(maintenance.AutoUpdate.MachineImageVersion)
+	}
+
+	if controlPlane := shoot.Spec.ControlPlane; controlPlane != nil && controlPlane.HighAvailability != nil {
+		config.ControlPlaneFailureTolerance = util.PtrTo(string(controlPlane.HighAvailability.FailureTolerance.Type))
+	}
+
+	providerConfig, err := NewGardenerProviderConfigFromShoot(shoot)
+	if err != nil {
+		return GardenerConfig{}, err.Append("failed to read provider config of shoot %s", shoot.Name)
+	}
+	config.GardenerProviderConfig = providerConfig
+
+	return config, nil
+}
+
+// NewGardenerProviderConfigFromShoot reads the provider config from the shoot, depending on its provider type
+func NewGardenerProviderConfigFromShoot(shoot gardener_types.Shoot) (GardenerProviderConfig, apperrors.AppError) {
+	switch shoot.Spec.Provider.Type {
+	case "gcp":
+		return toGardenerProviderConfig(NewGCPGardenerConfigFromShoot(shoot))
+	case "azure":
+		return toGardenerProviderConfig(NewAzureGardenerConfigFromShoot(shoot))
+	case "aws":
+		return toGardenerProviderConfig(NewAWSGardenerConfigFromShoot(shoot))
+	case "openstack":
+		return toGardenerProviderConfig(NewOpenStackGardenerConfigFromShoot(shoot))
+	default:
+		return nil, apperrors.BadRequest("provider %s is not supported", shoot.Spec.Provider.Type)
+	}
+}
+
+// toGardenerProviderConfig prevents returning a nil pointer wrapped in the interface
+func toGardenerProviderConfig[T GardenerProviderConfig](config T, err apperrors.AppError) (GardenerProviderConfig, apperrors.AppError) {
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// NewGCPGardenerConfigFromShoot reads the zones from the workers of the shoot, or from the control plane config if the workers have none
+func NewGCPGardenerConfigFromShoot(shoot gardener_types.Shoot) (*GCPGardenerConfig, apperrors.AppError) {
+	worker, err := firstWorker(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var controlPlane gcp.ControlPlaneConfig
+	if err := decodeProviderConfig(shoot.Spec.Provider.ControlPlaneConfig, &controlPlane); err != nil {
+		return nil, err
+	}
+
+	zones := worker.Zones
+	if len(zones) == 0 && controlPlane.Zone != "" {
+		zones = []string{controlPlane.Zone}
+	}
+
+	return NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: zones})
+}
+
+// NewAzureGardenerConfigFromShoot reads the VNet, zones and NAT gateway from the infrastructure config of the shoot.
+// Zones with their own subnets are read as AzureZones, otherwise the zones of the workers are used.
+func NewAzureGardenerConfigFromShoot(shoot gardener_types.Shoot) (*AzureGardenerConfig, apperrors.AppError) {
+	worker, err := firstWorker(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var infra azure.InfrastructureConfig
+	if err := decodeProviderConfig(shoot.Spec.Provider.InfrastructureConfig, &infra); err != nil {
+		return nil, err
+	}
+
+	input := &gqlschema.AzureProviderConfigInput{
+		VnetCidr: util.UnwrapOrZero(infra.Networks.VNet.CIDR),
+	}
+
+	natGateway := infra.Networks.NatGateway
+	if len(infra.Networks.Zones) > 0 {
+		for _, zone := range infra.Networks.Zones {
+			input.AzureZones = append(input.AzureZones, &gqlschema.AzureZoneInput{Name: zone.Name, Cidr: zone.CIDR})
+		}
+		natGateway = infra.Networks.Zones[0].NatGateway
+	} else if len(worker.Zones) > 0 {
+		input.Zones = worker.Zones
+	}
+
+	input.EnableNatGateway = util.PtrTo(natGateway != nil && natGateway.Enabled)
+	if *input.EnableNatGateway {
+		input.IdleConnectionTimeoutMinutes = util.PtrTo(natGateway.IdleConnectionTimeoutMinutes)
+	}
+
+	return NewAzureGardenerConfig(input)
+}
+
+// NewAWSGardenerConfigFromShoot reads the VPC and zones from the infrastructure config of the shoot, and IMDSv2 from the provider config of its workers
+func NewAWSGardenerConfigFromShoot(shoot gardener_types.Shoot) (*AWSGardenerConfig, apperrors.AppError) {
+	worker, err := firstWorker(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var infra aws.InfrastructureConfig
+	if err := decodeProviderConfig(shoot.Spec.Provider.InfrastructureConfig, &infra); err != nil {
+		return nil, err
+	}
+
+	enableIMDSv2 := false
+	if worker.ProviderConfig != nil {
+		var workerConfig aws.WorkerConfig
+		if err := decodeProviderConfig(worker.ProviderConfig, &workerConfig); err != nil {
+			return nil, err
+		}
+		options := workerConfig.InstanceMetadataOptions
+		enableIMDSv2 = options != nil && options.HTTPTokens != nil && *options.HTTPTokens == aws.HTTPTokensRequired
+	}
+
+	input := &gqlschema.AWSProviderConfigInput{
+		VpcCidr:      util.UnwrapOrZero(infra.Networks.VPC.CIDR),
+		AwsZones:     make([]*gqlschema.AWSZoneInput, 0, len(infra.Networks.Zones)),
+		EnableIMDSv2: util.PtrTo(enableIMDSv2),
+	}
+	for _, zone := range infra.Networks.Zones {
+		input.AwsZones = append(input.AwsZones, &gqlschema.AWSZoneInput{
+			Name:         zone.Name,
+			PublicCidr:   zone.Public,
+			InternalCidr: zone.Internal,
+			WorkerCidr:   zone.Workers,
+		})
+	}
+
+	return NewAWSGardenerConfig(input)
+}
+
+// NewOpenStackGardenerConfigFromShoot reads the floating pool from the infrastructure config of the shoot, and the load balancer provider from its control plane config
+func NewOpenStackGardenerConfigFromShoot(shoot gardener_types.Shoot) (*OpenStackGardenerConfig, apperrors.AppError) {
+	worker, err := firstWorker(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var infra openstack.InfrastructureConfig
+	if err := decodeProviderConfig(shoot.Spec.Provider.InfrastructureConfig, &infra); err != nil {
+		return nil, err
+	}
+
+	var controlPlane openstack.ControlPlaneConfig
+	if err := decodeProviderConfig(shoot.Spec.Provider.ControlPlaneConfig, &controlPlane); err != nil {
+		return nil, err
+	}
+
+	return NewOpenStackGardenerConfig(&gqlschema.OpenStackProviderConfigInput{
+		Zones:                worker.Zones,
+		FloatingPoolName:     util.PtrTo(infra.FloatingPoolName),
+		CloudProfileName:     util.PtrTo(shoot.Spec.CloudProfileName),
+		LoadBalancerProvider: controlPlane.LoadBalancerProvider,
+	})
+}
+
+// workerCIDRFromShoot returns the workers CIDR of Azure shoots from the infrastructure config, as their nodes CIDR is the VNet CIDR.
+// AWS shoots and Azure shoots with zone subnets do not keep the workers CIDR, so their nodes CIDR is returned.
+func workerCIDRFromShoot(shoot gardener_types.Shoot) string {
+	if shoot.Spec.Provider.Type == "azure" {
+		var infra azure.InfrastructureConfig
+		if err := decodeProviderConfig(shoot.Spec.Provider.InfrastructureConfig, &infra); err == nil && infra.Networks.Workers != nil {
+			return *infra.Networks.Workers
+		}
+	}
+	if shoot.Spec.Networking == nil {
+		return ""
+	}
+	return util.UnwrapOrZero(shoot.Spec.Networking.Nodes)
+}
+
+func firstWorker(shoot gardener_types.Shoot) (gardener_types.Worker, apperrors.AppError) {
+	if len(shoot.Spec.Provider.Workers) == 0 {
+		return gardener_types.Worker{}, apperrors.BadRequest("shoot %s has no workers", shoot.Name)
+	}
+	return shoot.Spec.Provider.Workers[0], nil
+}
+
+func decodeProviderConfig(raw *apimachineryRuntime.RawExtension, target interface{}) apperrors.AppError {
+	if raw == nil || len(raw.Raw) == 0 {
+		return apperrors.BadRequest("provider config is missing")
+	}
+	if err := json.Unmarshal(raw.Raw, target); err != nil {
+		return apperrors.BadRequest("error decoding provider config: %s", err.Error())
+	}
+	return nil
+}
+
+// intOrStringValue returns 0 for percentages, as only integer values are stored
+func intOrStringValue(value *intstr.IntOrString) int {
+	if value == nil || value.Type != intstr.Int {
+		return 0
+	}
+	return value.IntValue()
+}
+
+func oidcConfigFromShoot(shoot gardener_types.Shoot) *OIDCConfig {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig == nil {
+		return nil
+	}
+	oidcConfig := shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig
+
+	return &OIDCConfig{
+		ClientID:       util.UnwrapOrZero(oidcConfig.ClientID),
+		GroupsClaim:    util.UnwrapOrZero(oidcConfig.GroupsClaim),
+		IssuerURL:      util.UnwrapOrZero(oidcConfig.IssuerURL),
+		SigningAlgs:    oidcConfig.SigningAlgs,
+		UsernameClaim:  util.UnwrapOrZero(oidcConfig.UsernameClaim),
+		UsernamePrefix: util.UnwrapOrZero(oidcConfig.UsernamePrefix),
+	}
+}
+
+func dnsConfigFromShoot(shoot gardener_types.Shoot) *DNSConfig {
+	if shoot.Spec.DNS == nil || shoot.Spec.DNS.Domain == nil {
+		return nil
+	}
+
+	dnsConfig := &DNSConfig{Domain: *shoot.Spec.DNS.Domain}
+	for _, provider := range shoot.Spec.DNS.Providers {
+		dnsProvider := &DNSProvider{
+			Primary:    util.UnwrapOrZero(provider.Primary),
+			SecretName: util.UnwrapOrZero(provider.SecretName),
+			Type:       util.UnwrapOrZero(provider.Type),
+		}
+		if provider.Domains != nil {
+			dnsProvider.DomainsInclude = provider.Domains.Include
+		}
+		dnsConfig.Providers = append(dnsConfig.Providers, dnsProvider)
+	}
+
+	return dnsConfig
+}
+
+func shootNetworkingFilterDisabledFromShoot(shoot gardener_types.Shoot) *bool {
+	for _, extension := range shoot.Spec.Extensions {
+		if extension.Type == ShootNetworkingFilterExtensionType {
+			return extension.Disabled
+		}
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGardenerConfigFromShoot(t *testing.T) {
+	gcpConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"zone-1", "zone-2"}))
+	require.NoError(t, err)
+	azureConfig, err := NewAzureGardenerConfig(fixAzureGardenerInput([]string{"1", "2"}, util.PtrTo(true)))
+	require.NoError(t, err)
+	azureZoneSubnetsConfig, err := NewAzureGardenerConfig(fixAzureZoneSubnetsInput(true))
+	require.NoError(t, err)
+	awsConfig, err := NewAWSGardenerConfig(fixAWSGardenerInput(true))
+	require.NoError(t, err)
+	openStackConfig, err := NewOpenStackGardenerConfig(fixOpenStackGardenerInput())
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		description        string
+		provider           string
+		providerConfig     GardenerProviderConfig
+		expectedWorkerCidr string
+	}{
+		{description: "should read GCP Gardener config", provider: "gcp", providerConfig: gcpConfig, expectedWorkerCidr: "10.10.10.10/255"},
+		{description: "should read Azure Gardener config", provider: "azure", providerConfig: azureConfig, expectedWorkerCidr: "10.10.10.10/255"},
+		{description: "should read Azure Gardener config with zone subnets", provider: "azure", providerConfig: azureZoneSubnetsConfig, expectedWorkerCidr: "10.10.11.11/255"},
+		{description: "should read AWS Gardener config", provider: "aws", providerConfig: awsConfig, expectedWorkerCidr: "10.10.11.11/255"},
+		{description: "should read OpenStack Gardener config", provider: "openstack", providerConfig: openStackConfig, expectedWorkerCidr: "10.10.10.10/255"},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			config := fixGardenerConfig(testCase.provider, testCase.providerConfig)
+			config.LicenceType = util.PtrTo("licence")
+			config.DNSConfig = dnsConfig()
+
+			shoot, err := config.ToShootTemplate("garden-project", "account", "sub-account", config.OIDCConfig, config.DNSConfig)
+			require.NoError(t, err)
+
+			// when
+			imported, err := NewGardenerConfigFromShoot(*shoot)
+
+			// then
+			require.NoError(t, err)
+
+			expected := config
+			expected.ShootNetworkingFilterDisabled = util.PtrTo(ShootNetworkingFilterDisabledDefault)
+			expected.WorkerCidr = testCase.expectedWorkerCidr
+			assert.Equal(t, expected, imported)
+		})
+	}
+
+	t.Run("should read seed selection with the shoot region", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("gcp", gcpConfig)
+		config.Seed = ""
+		config.ShootAndSeedSameRegion = true
+
+		shoot, err := config.ToShootTemplate("garden-project", "account", "sub-account", config.OIDCConfig, config.DNSConfig)
+		require.NoError(t, err)
+
+		// when
+		imported, err := NewGardenerConfigFromShoot(*shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, imported.ShootAndSeedSameRegion)
+		assert.Empty(t, imported.Seed)
+	})
+
+	t.Run("should return error for shoot without workers", func(t *testing.T) {
+		// given
+		shoot := gardener_types.Shoot{Spec: gardener_types.ShootSpec{Provider: gardener_types.Provider{Type: "gcp"}}}
+
+		// when
+		_, err := NewGardenerConfigFromShoot(shoot)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("should return error for unsupported provider", func(t *testing.T) {
+		// given
+		shoot := gardener_types.Shoot{Spec: gardener_types.ShootSpec{Provider: gardener_types.Provider{
+			Type:    "alicloud",
+			Workers: []gardener_types.Worker{{Name: "cpu-worker-0"}},
+		}}}
+
+		// when
+		_, err := NewGardenerConfigFromShoot(shoot)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestNewGardenerProviderConfigFromShoot(t *testing.T) {
+	for _, testCase := range []struct {
+		description   string
+		provider      string
+		input         interface{}
+		expectedInput interface{}
+	}{
+		{
+			description:   "should read GCP zones",
+			provider:      "gcp",
+			input:         fixGCPGardenerInput([]string{"zone-1", "zone-2"}),
+			expectedInput: fixGCPGardenerInput([]string{"zone-1", "zone-2"}),
+		},
+		{
+			description:   "should read Azure zones with NAT gateway",
+			provider:      "azure",
+			input:         fixAzureGardenerInput([]string{"1", "2"}, util.PtrTo(true)),
+			expectedInput: fixAzureGardenerInput([]string{"1", "2"}, util.PtrTo(true)),
+		},
+		{
+			description: "should read Azure zones without NAT gateway",
+			provider:    "azure",
+			input:       fixAzureGardenerInput([]string{"1", "2"}, nil),
+			expectedInput: &gqlschema.AzureProviderConfigInput{
+				VnetCidr:         "10.10.11.11/255",
+				Zones:            []string{"1", "2"},
+				EnableNatGateway: util.PtrTo(false),
+			},
+		},
+		{
+			description:   "should read Azure zone subnets with NAT gateway",
+			provider:      "azure",
+			input:         fixAzureZoneSubnetsInput(true),
+			expectedInput: fixAzureZoneSubnetsInput(true),
+		},
+		{
+			description:   "should read AWS zones with IMDSv2",
+			provider:      "aws",
+			input:         fixAWSGardenerInput(true),
+			expectedInput: fixAWSGardenerInput(true),
+		},
+		{
+			description:   "should read AWS zones without IMDSv2",
+			provider:      "aws",
+			input:         fixAWSGardenerInput(false),
+			expectedInput: fixAWSGardenerInput(false),
+		},
+		{
+			description:   "should read OpenStack config",
+			provider:      "openstack",
+			input:         fixOpenStackGardenerInput(),
+			expectedInput: fixOpenStackGardenerInput(),
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			providerConfig := newProviderConfig(t, testCase.input)
+			expected := newProviderConfig(t, testCase.expectedInput)
+
+			shoot, err := fixGardenerConfig(testCase.provider, providerConfig).ToShootTemplate("garden-project", "account", "sub-account", nil, nil)
+			require.NoError(t, err)
+
+			// when
+			imported, err := NewGardenerProviderConfigFromShoot(*shoot)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, expected, imported)
+		})
+	}
+
+	t.Run("should read GCP zone from control plane config if workers have no zones", func(t *testing.T) {
+		// given
+		shoot, err := fixGardenerConfig("gcp", newProviderConfig(t, fixGCPGardenerInput([]string{"zone-1"}))).ToShootTemplate("garden-project", "account", "sub-account", nil, nil)
+		require.NoError(t, err)
+		shoot.Spec.Provider.Workers[0].Zones = nil
+
+		// when
+		imported, err := NewGCPGardenerConfigFromShoot(*shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, newProviderConfig(t, fixGCPGardenerInput([]string{"zone-1"})), imported)
+	})
+
+	t.Run("should return error for shoot without infrastructure config", func(t *testing.T) {
+		// given
+		shoot, err := fixGardenerConfig("aws", newProviderConfig(t, fixAWSGardenerInput(false))).ToShootTemplate("garden-project", "account", "sub-account", nil, nil)
+		require.NoError(t, err)
+		shoot.Spec.Provider.InfrastructureConfig = nil
+
+		// when
+		_, err = NewAWSGardenerConfigFromShoot(*shoot)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("should return error for invalid worker provider config", func(t *testing.T) {
+		// given
+		shoot, err := fixGardenerConfig("aws", newProviderConfig(t, fixAWSGardenerInput(true))).ToShootTemplate("garden-project", "account", "sub-account", nil, nil)
+		require.NoError(t, err)
+		shoot.Spec.Provider.Workers[0].ProviderConfig.Raw = []byte("invalid")
+
+		// when
+		_, err = NewAWSGardenerConfigFromShoot(*shoot)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func newProviderConfig(t *testing.T, input interface{}) GardenerProviderConfig {
+	var providerConfig GardenerProviderConfig
+	var err apperrors.AppError
+
+	switch input := input.(type) {
+	case *gqlschema.GCPProviderConfigInput:
+		providerConfig, err = NewGCPGardenerConfig(input)
+	case *gqlschema.AzureProviderConfigInput:
+		providerConfig, err = NewAzureGardenerConfig(input)
+	case *gqlschema.AWSProviderConfigInput:
+		providerConfig, err = NewAWSGardenerConfig(input)
+	case *gqlschema.OpenStackProviderConfigInput:
+		providerConfig, err = NewOpenStackGardenerConfig(input)
+	default:
+		t.Fatalf("unsupported provider config input %T", input)
+	}
+	require.NoError(t, err)
+
+	return providerConfig
+}
+
+func fixOpenStackGardenerInput() *gqlschema.OpenStackProviderConfigInput {
+	return &gqlschema.OpenStackProviderConfigInput{
+		Zones:                []string{"eu-de-1a"},
+		FloatingPoolName:     util.PtrTo("FloatingIP-external"),
+		CloudProfileName:     util.PtrTo("converged-cloud-cp"),
+		LoadBalancerProvider: "f5",
+	}
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// GardenerConfigRevision is an immutable snapshot of the Gardener config applied to the Runtime.
+// Revisions are numbered from 1 in the order in which they were applied.
+type GardenerConfigRevision struct {
+	RuntimeID         string
+	Revision          int
+	OperationID       *string
+	CreationTimestamp time.Time
+	Config            GardenerConfig
+}
@@ -187,7 +187,7 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				Spec: gardener_types.ShootSpec{
 					CloudProfileName: "gcp",
 					Networking: &gardener_types.Networking{
-						Type:     &networkingType,
+						Type:     util.PtrTo(CalicoNetworkingType),
 						Nodes:    util.PtrTo("10.10.10.10/255"),
 						Pods:     util.PtrTo("10.10.11.10/24"),
 						Services: util.PtrTo("10.10.12.10/24"),
@@ -276,7 +276,7 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				Spec: gardener_types.ShootSpec{
 					CloudProfileName: "az",
 					Networking: &gardener_types.Networking{
-						Type:     &networkingType,
+						Type:     util.PtrTo(CalicoNetworkingType),
 						Nodes:    util.PtrTo("10.10.11.11/255"),
 						Pods:     util.PtrTo("10.10.11.10/24"),
 						Services: util.PtrTo("10.10.12.10/24"),
@@ -365,7 +365,7 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				Spec: gardener_types.ShootSpec{
 					CloudProfileName: "az",
 					Networking: &gardener_types.Networking{
-						Type:     &networkingType,
+						Type:     util.PtrTo(CalicoNetworkingType),
 						Nodes:    util.PtrTo("10.10.11.11/255"),
 						Pods:     util.PtrTo("10.10.11.10/24"),
 						Services: util.PtrTo("10.10.12.10/24"),
@@ -454,7 +454,7 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				Spec: gardener_types.ShootSpec{
 					CloudProfileName: "az",
 					Networking: &gardener_types.Networking{
-						Type:     &networkingType,
+						Type:     util.PtrTo(CalicoNetworkingType),
 						Nodes:    util.PtrTo("10.10.11.11/255"),
 						Pods:     util.PtrTo("10.10.11.10/24"),
 						Services: util.PtrTo("10.10.12.10/24"),
@@ -543,7 +543,7 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 				Spec: gardener_types.ShootSpec{
 					CloudProfileName: "aws",
 					Networking: &gardener_types.Networking{
-						Type:     &networkingType,
+						Type:     util.PtrTo(CalicoNetworkingType),
 						Nodes:    util.PtrTo("10.10.11.11/255"),
 						Pods:     util.PtrTo("10.10.11.10/24"),
 						Services: util.PtrTo("10.10.12.10/24"),
@@ -631,6 +631,39 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 	}
 }
 
+func TestGardenerConfig_ToShootTemplate_SeedSelector(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	t.Run("should set seed selector with shoot region", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("gcp", gcpGardenerProvider)
+		config.Seed = ""
+		config.ShootAndSeedSameRegion = true
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, template.Spec.SeedSelector)
+		assert.Equal(t, map[string]string{SeedRegionLabel: "eu"}, template.Spec.SeedSelector.MatchLabels)
+		assert.Nil(t, template.Spec.SeedName)
+	})
+
+	t.Run("should not set seed selector when disabled", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("gcp", gcpGardenerProvider)
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, template.Spec.SeedSelector)
+	})
+}
+
 func TestAdjustStaticKubeconfigFlagK8s126(t *testing.T) {
 	//given old (1.26) shoot and request to upgrade not relevant to k8s version
 	config := GardenerConfig{}
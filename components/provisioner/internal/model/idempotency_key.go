@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+type IdempotencyKey struct {
+	Tenant      string
+	Key         string
+	Mutation    string
+	RequestHash string
+	OperationID string
+	CreatedAt   time.Time
+}
@@ -0,0 +1,154 @@
+package model
+
+import (
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v12 "k8s.io/api/core/v1"
+)
+
+// KubeAPIServerConfig contains the settings of the kube-apiserver of the shoot, which are set in addition to the OIDC config
+type KubeAPIServerConfig struct {
+	FeatureGates    map[string]bool        `json:"featureGates,omitempty"`
+	RuntimeConfig   map[string]bool        `json:"runtimeConfig,omitempty"`
+	Requests        *KubeAPIServerRequests `json:"requests,omitempty"`
+	ServiceAccount  *ServiceAccountConfig  `json:"serviceAccount,omitempty"`
+	WatchCacheSizes *WatchCacheSizes       `json:"watchCacheSizes,omitempty"`
+	// AuditPolicyConfigMap overrides the global audit policy ConfigMap of the provisioner
+	AuditPolicyConfigMap *string `json:"auditPolicyConfigMap,omitempty"`
+}
+
+type KubeAPIServerRequests struct {
+	MaxNonMutatingInflight *int `json:"maxNonMutatingInflight,omitempty"`
+	MaxMutatingInflight    *int `json:"maxMutatingInflight,omitempty"`
+}
+
+type ServiceAccountConfig struct {
+	Issuer                *string `json:"issuer,omitempty"`
+	ExtendTokenExpiration *bool   `json:"extendTokenExpiration,omitempty"`
+}
+
+type WatchCacheSizes struct {
+	Default   *int                     `json:"default,omitempty"`
+	Resources []ResourceWatchCacheSize `json:"resources,omitempty"`
+}
+
+type ResourceWatchCacheSize struct {
+	APIGroup *string `json:"apiGroup,omitempty"`
+	Resource string  `json:"resource"`
+	Size     int     `json:"size"`
+}
+
+// AuditPolicyConfigMapName returns the name of the audit policy ConfigMap of the shoot, or the default name if the config does not override it
+func (k *KubeAPIServerConfig) AuditPolicyConfigMapName(defaultName string) string {
+	if k == nil || k.AuditPolicyConfigMap == nil {
+		return defaultName
+	}
+	return *k.AuditPolicyConfigMap
+}
+
+// applyKubeAPIServerConfig sets the settings of the config on the kube-apiserver config of the shoot. The settings which are not set in the config stay untouched.
+func applyKubeAPIServerConfig(kubeAPIServer *gardener_types.KubeAPIServerConfig, config *KubeAPIServerConfig) {
+	if config == nil {
+		return
+	}
+
+	if config.FeatureGates != nil {
+		kubeAPIServer.FeatureGates = config.FeatureGates
+	}
+	if config.RuntimeConfig != nil {
+		kubeAPIServer.RuntimeConfig = config.RuntimeConfig
+	}
+	if config.Requests != nil {
+		kubeAPIServer.Requests = &gardener_types.KubeAPIServerRequests{
+			MaxNonMutatingInflight: toInt32(config.Requests.MaxNonMutatingInflight),
+			MaxMutatingInflight:    toInt32(config.Requests.MaxMutatingInflight),
+		}
+	}
+	if config.ServiceAccount != nil {
+		// The service account config of the shoot can contain other settings, like the accepted issuers, which must be kept
+		if kubeAPIServer.ServiceAccountConfig == nil {
+			kubeAPIServer.ServiceAccountConfig = &gardener_types.ServiceAccountConfig{}
+		}
+		kubeAPIServer.ServiceAccountConfig.Issuer = config.ServiceAccount.Issuer
+		kubeAPIServer.ServiceAccountConfig.ExtendTokenExpiration = config.ServiceAccount.ExtendTokenExpiration
+	}
+	if config.WatchCacheSizes != nil {
+		watchCacheSizes := &gardener_types.WatchCacheSizes{
+			Default: toInt32(config.WatchCacheSizes.Default),
+		}
+		for _, resource := range config.WatchCacheSizes.Resources {
+			watchCacheSizes.Resources = append(watchCacheSizes.Resources, gardener_types.ResourceWatchCacheSize{
+				APIGroup:  resource.APIGroup,
+				Resource:  resource.Resource,
+				CacheSize: int32(resource.Size),
+			})
+		}
+		kubeAPIServer.WatchCacheSizes = watchCacheSizes
+	}
+	if config.AuditPolicyConfigMap != nil {
+		kubeAPIServer.AuditConfig = NewAuditConfig(*config.AuditPolicyConfigMap)
+	}
+}
+
+// NewAuditConfig returns the audit config of the kube-apiserver with the policy from the ConfigMap
+func NewAuditConfig(policyConfigMapName string) *gardener_types.AuditConfig {
+	return &gardener_types.AuditConfig{
+		AuditPolicy: &gardener_types.AuditPolicy{
+			ConfigMapRef: &v12.ObjectReference{Name: policyConfigMapName},
+		},
+	}
+}
+
+// kubeAPIServerFromShoot returns the settings of the kube-apiserver of the shoot. The audit policy is not returned, as the shoot cannot tell whether it overrides the global one.
+func kubeAPIServerFromShoot(kubeAPIServer *gardener_types.KubeAPIServerConfig) *KubeAPIServerConfig {
+	if kubeAPIServer == nil {
+		return nil
+	}
+
+	config := KubeAPIServerConfig{
+		FeatureGates:  kubeAPIServer.FeatureGates,
+		RuntimeConfig: kubeAPIServer.RuntimeConfig,
+	}
+	if requests := kubeAPIServer.Requests; requests != nil {
+		config.Requests = &KubeAPIServerRequests{
+			MaxNonMutatingInflight: fromInt32(requests.MaxNonMutatingInflight),
+			MaxMutatingInflight:    fromInt32(requests.MaxMutatingInflight),
+		}
+	}
+	if serviceAccount := kubeAPIServer.ServiceAccountConfig; serviceAccount != nil && (serviceAccount.Issuer != nil || serviceAccount.ExtendTokenExpiration != nil) {
+		config.ServiceAccount = &ServiceAccountConfig{
+			Issuer:                serviceAccount.Issuer,
+			ExtendTokenExpiration: serviceAccount.ExtendTokenExpiration,
+		}
+	}
+	if watchCacheSizes := kubeAPIServer.WatchCacheSizes; watchCacheSizes != nil {
+		config.WatchCacheSizes = &WatchCacheSizes{Default: fromInt32(watchCacheSizes.Default)}
+		for _, resource := range watchCacheSizes.Resources {
+			config.WatchCacheSizes.Resources = append(config.WatchCacheSizes.Resources, ResourceWatchCacheSize{
+				APIGroup: resource.APIGroup,
+				Resource: resource.Resource,
+				Size:     int(resource.CacheSize),
+			})
+		}
+	}
+
+	if config.FeatureGates == nil && config.RuntimeConfig == nil && config.Requests == nil && config.ServiceAccount == nil && config.WatchCacheSizes == nil {
+		return nil
+	}
+	return &config
+}
+
+func toInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
+
+func fromInt32(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGardenerConfig_ToShootTemplate_KubeAPIServer(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	// given
+	config := fixGardenerConfig("gcp", gcpGardenerProvider)
+	config.KubeAPIServer = &KubeAPIServerConfig{
+		FeatureGates:  map[string]bool{"ValidatingAdmissionPolicy": true},
+		RuntimeConfig: map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+		Requests:      &KubeAPIServerRequests{MaxNonMutatingInflight: util.PtrTo(800), MaxMutatingInflight: util.PtrTo(400)},
+		ServiceAccount: &ServiceAccountConfig{
+			Issuer:                util.PtrTo("https://issuer.example.com"),
+			ExtendTokenExpiration: util.PtrTo(false),
+		},
+		WatchCacheSizes: &WatchCacheSizes{
+			Default:   util.PtrTo(100),
+			Resources: []ResourceWatchCacheSize{{APIGroup: util.PtrTo("apps"), Resource: "deployments", Size: 500}},
+		},
+		AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+	}
+
+	// when
+	template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	kubeAPIServer := template.Spec.Kubernetes.KubeAPIServer
+	require.NotNil(t, kubeAPIServer)
+	assert.NotNil(t, kubeAPIServer.OIDCConfig)
+	assert.Equal(t, map[string]bool{"ValidatingAdmissionPolicy": true}, kubeAPIServer.FeatureGates)
+	assert.Equal(t, map[string]bool{"admissionregistration.k8s.io/v1beta1": true}, kubeAPIServer.RuntimeConfig)
+	assert.Equal(t, &gardener_types.KubeAPIServerRequests{MaxNonMutatingInflight: util.PtrTo(int32(800)), MaxMutatingInflight: util.PtrTo(int32(400))}, kubeAPIServer.Requests)
+	assert.Equal(t, &gardener_types.ServiceAccountConfig{Issuer: util.PtrTo("https://issuer.example.com"), ExtendTokenExpiration: util.PtrTo(false)}, kubeAPIServer.ServiceAccountConfig)
+	assert.Equal(t, &gardener_types.WatchCacheSizes{
+		Default:   util.PtrTo(int32(100)),
+		Resources: []gardener_types.ResourceWatchCacheSize{{APIGroup: util.PtrTo("apps"), Resource: "deployments", CacheSize: 500}},
+	}, kubeAPIServer.WatchCacheSizes)
+	assert.Equal(t, NewAuditConfig("audit-policy"), kubeAPIServer.AuditConfig)
+}
+
+func TestEditShootConfig_KubeAPIServer(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	// given
+	config := fixGardenerConfig("gcp", gcpGardenerProvider)
+	config.KubeAPIServer = &KubeAPIServerConfig{
+		FeatureGates:   map[string]bool{"ValidatingAdmissionPolicy": false},
+		ServiceAccount: &ServiceAccountConfig{ExtendTokenExpiration: util.PtrTo(false)},
+	}
+	shoot := testkit.NewTestShoot("shoot").
+		WithWorkers(testkit.NewTestWorker("peon").WithZones("fix-zone-1").ToWorker()).
+		ToShoot()
+	shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{
+		ServiceAccountConfig: &gardener_types.ServiceAccountConfig{AcceptedIssuers: []string{"https://old-issuer.example.com"}},
+		AuditConfig:          NewAuditConfig("global-audit-policy"),
+		Requests:             &gardener_types.KubeAPIServerRequests{MaxMutatingInflight: util.PtrTo(int32(200))},
+	}
+
+	// when
+	err = gcpGardenerProvider.EditShootConfig(config, shoot)
+
+	// then
+	require.NoError(t, err)
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	assert.Equal(t, map[string]bool{"ValidatingAdmissionPolicy": false}, kubeAPIServer.FeatureGates)
+	assert.Equal(t, &gardener_types.ServiceAccountConfig{
+		AcceptedIssuers:       []string{"https://old-issuer.example.com"},
+		ExtendTokenExpiration: util.PtrTo(false),
+	}, kubeAPIServer.ServiceAccountConfig)
+	assert.Equal(t, NewAuditConfig("global-audit-policy"), kubeAPIServer.AuditConfig)
+	assert.Equal(t, &gardener_types.KubeAPIServerRequests{MaxMutatingInflight: util.PtrTo(int32(200))}, kubeAPIServer.Requests)
+}
+
+func TestKubeAPIServerFromShoot(t *testing.T) {
+	t.Run("should return nil for kube-apiserver with OIDC and audit config only", func(t *testing.T) {
+		// when
+		kubeAPIServer := kubeAPIServerFromShoot(&gardener_types.KubeAPIServerConfig{
+			OIDCConfig:  &gardener_types.OIDCConfig{ClientID: util.PtrTo("client")},
+			AuditConfig: NewAuditConfig("audit-policy"),
+		})
+
+		// then
+		assert.Nil(t, kubeAPIServer)
+	})
+
+	t.Run("should return kube-apiserver settings", func(t *testing.T) {
+		// when
+		kubeAPIServer := kubeAPIServerFromShoot(&gardener_types.KubeAPIServerConfig{
+			KubernetesConfig: gardener_types.KubernetesConfig{FeatureGates: map[string]bool{"ValidatingAdmissionPolicy": true}},
+			WatchCacheSizes: &gardener_types.WatchCacheSizes{
+				Resources: []gardener_types.ResourceWatchCacheSize{{Resource: "secrets", CacheSize: 500}},
+			},
+		})
+
+		// then
+		assert.Equal(t, &KubeAPIServerConfig{
+			FeatureGates:    map[string]bool{"ValidatingAdmissionPolicy": true},
+			WatchCacheSizes: &WatchCacheSizes{Resources: []ResourceWatchCacheSize{{Resource: "secrets", Size: 500}}},
+		}, kubeAPIServer)
+	})
+}
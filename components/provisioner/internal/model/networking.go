@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	CalicoNetworkingType = "calico"
+	CiliumNetworkingType = "cilium"
+
+	IPv4Family = "IPv4"
+	IPv6Family = "IPv6"
+
+	networkConfigKind = "NetworkConfig"
+)
+
+// NetworkingConfig is the networking plugin of the shoot. The type and the IP families cannot be changed after the shoot is created.
+type NetworkingConfig struct {
+	Type           string          `json:"type,omitempty"`
+	IPFamilies     []string        `json:"ipFamilies,omitempty"`
+	ProviderConfig json.RawMessage `json:"providerConfig,omitempty"`
+}
+
+// NetworkingType returns the type of the networking plugin, Calico if the type is not set
+func (n *NetworkingConfig) NetworkingType() string {
+	if n == nil || n.Type == "" {
+		return CalicoNetworkingType
+	}
+	return n.Type
+}
+
+// Families returns the IP families of the shoot networks, IPv4 if the families are not set
+func (n *NetworkingConfig) Families() []string {
+	if n == nil || len(n.IPFamilies) == 0 {
+		return []string{IPv4Family}
+	}
+	return n.IPFamilies
+}
+
+func (n *NetworkingConfig) ipFamilies() []string {
+	if n == nil {
+		return nil
+	}
+	return n.IPFamilies
+}
+
+func (n *NetworkingConfig) providerConfig() json.RawMessage {
+	if n == nil {
+		return nil
+	}
+	return n.ProviderConfig
+}
+
+// NetworkConfigAPIVersion returns the API version of the network config of the networking type
+func NetworkConfigAPIVersion(networkingType string) string {
+	return networkingType + ".networking.extensions.gardener.cloud/v1alpha1"
+}
+
+// ValidateNetworkingProviderConfig checks that the provider config is the network config of the networking type
+func ValidateNetworkingProviderConfig(networkingType string, providerConfig []byte) apperrors.AppError {
+	var header struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := json.Unmarshal(providerConfig, &header); err != nil {
+		return apperrors.BadRequest("networking provider config is not valid JSON object: %s", err.Error())
+	}
+
+	apiVersion := NetworkConfigAPIVersion(networkingType)
+	if header.APIVersion != apiVersion || header.Kind != networkConfigKind {
+		return apperrors.BadRequest("networking provider config of type %s must have apiVersion %s and kind %s", networkingType, apiVersion, networkConfigKind)
+	}
+	return nil
+}
+
+// ValidateNetworkingChange checks that the networking type and the IP families of the shoot are not changed, as Gardener does not allow to change them
+func (c GardenerConfig) ValidateNetworkingChange(shoot *gardener_types.Shoot) apperrors.AppError {
+	if shoot.Spec.Networking == nil {
+		return nil
+	}
+
+	if shootType := shoot.Spec.Networking.Type; shootType != nil && *shootType != c.Networking.NetworkingType() {
+		return apperrors.BadRequest("cannot change networking type from %s to %s", *shootType, c.Networking.NetworkingType())
+	}
+
+	shootFamilies := []string{IPv4Family}
+	if len(shoot.Spec.Networking.IPFamilies) > 0 {
+		shootFamilies = ipFamiliesFromShoot(shoot.Spec.Networking.IPFamilies)
+	}
+	if !slices.Equal(shootFamilies, c.Networking.Families()) {
+		return apperrors.BadRequest("cannot change IP families from %s to %s", strings.Join(shootFamilies, ", "), strings.Join(c.Networking.Families(), ", "))
+	}
+
+	if providerConfig := c.Networking.providerConfig(); providerConfig != nil {
+		return ValidateNetworkingProviderConfig(c.Networking.NetworkingType(), providerConfig)
+	}
+	return nil
+}
+
+// networkingFromShoot returns nil for the Calico networking with IPv4 and without provider config, which is the default networking of the config
+func networkingFromShoot(networking *gardener_types.Networking) *NetworkingConfig {
+	if networking == nil {
+		return nil
+	}
+
+	config := NetworkingConfig{
+		IPFamilies: ipFamiliesFromShoot(networking.IPFamilies),
+	}
+	if networking.Type != nil && *networking.Type != CalicoNetworkingType {
+		config.Type = *networking.Type
+	}
+	if slices.Equal(config.IPFamilies, []string{IPv4Family}) {
+		config.IPFamilies = nil
+	}
+	if networking.ProviderConfig != nil {
+		config.ProviderConfig = networking.ProviderConfig.Raw
+	}
+
+	if config.Type == "" && len(config.IPFamilies) == 0 && config.ProviderConfig == nil {
+		return nil
+	}
+	return &config
+}
+
+func ipFamiliesFromShoot(families []gardener_types.IPFamily) []string {
+	var result []string
+	for _, family := range families {
+		result = append(result, string(family))
+	}
+	return result
+}
+
+func toShootIPFamilies(families []string) []gardener_types.IPFamily {
+	var result []gardener_types.IPFamily
+	for _, family := range families {
+		result = append(result, gardener_types.IPFamily(family))
+	}
+	return result
+}
+
+func toShootRawExtension(raw json.RawMessage) *apimachineryRuntime.RawExtension {
+	if raw == nil {
+		return nil
+	}
+	return &apimachineryRuntime.RawExtension{Raw: raw}
+}
@@ -0,0 +1,166 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+const ciliumNetworkConfig = `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`
+
+func TestGardenerConfig_ToShootTemplate_Networking(t *testing.T) {
+	awsGardenerProvider, err := NewAWSGardenerConfig(fixAWSGardenerInput(false))
+	require.NoError(t, err)
+
+	t.Run("should render Calico with IPv4 without networking", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("aws", awsGardenerProvider)
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, util.PtrTo(CalicoNetworkingType), template.Spec.Networking.Type)
+		assert.Nil(t, template.Spec.Networking.IPFamilies)
+		assert.Nil(t, template.Spec.Networking.ProviderConfig)
+	})
+
+	t.Run("should render dual stack Cilium with provider config", func(t *testing.T) {
+		// given
+		config := fixGardenerConfig("aws", awsGardenerProvider)
+		config.Networking = &NetworkingConfig{
+			Type:           CiliumNetworkingType,
+			IPFamilies:     []string{IPv4Family, IPv6Family},
+			ProviderConfig: []byte(ciliumNetworkConfig),
+		}
+
+		// when
+		template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, util.PtrTo(CiliumNetworkingType), template.Spec.Networking.Type)
+		assert.Equal(t, []gardener_types.IPFamily{gardener_types.IPFamilyIPv4, gardener_types.IPFamilyIPv6}, template.Spec.Networking.IPFamilies)
+		assert.Equal(t, &apimachineryRuntime.RawExtension{Raw: []byte(ciliumNetworkConfig)}, template.Spec.Networking.ProviderConfig)
+	})
+}
+
+func TestGardenerConfig_ValidateNetworkingChange(t *testing.T) {
+	fixShoot := func(networkingType string, families ...gardener_types.IPFamily) *gardener_types.Shoot {
+		shoot := testkit.NewTestShoot("shoot").ToShoot()
+		shoot.Spec.Networking = &gardener_types.Networking{Type: util.PtrTo(networkingType), IPFamilies: families}
+		return shoot
+	}
+
+	t.Run("should accept default networking of Calico shoot", func(t *testing.T) {
+		// when
+		err := GardenerConfig{}.ValidateNetworkingChange(fixShoot(CalicoNetworkingType, gardener_types.IPFamilyIPv4))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should accept provider config change of Cilium shoot", func(t *testing.T) {
+		// given
+		config := GardenerConfig{Networking: &NetworkingConfig{Type: CiliumNetworkingType, ProviderConfig: []byte(ciliumNetworkConfig)}}
+
+		// when
+		err := config.ValidateNetworkingChange(fixShoot(CiliumNetworkingType))
+
+		// then
+		require.NoError(t, err)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		config      GardenerConfig
+		shoot       *gardener_types.Shoot
+		message     string
+	}{
+		{
+			description: "networking type change",
+			config:      GardenerConfig{Networking: &NetworkingConfig{Type: CiliumNetworkingType}},
+			shoot:       fixShoot(CalicoNetworkingType),
+			message:     "cannot change networking type from calico to cilium",
+		},
+		{
+			description: "IP families change",
+			config:      GardenerConfig{Networking: &NetworkingConfig{IPFamilies: []string{IPv4Family, IPv6Family}}},
+			shoot:       fixShoot(CalicoNetworkingType, gardener_types.IPFamilyIPv4),
+			message:     "cannot change IP families from IPv4 to IPv4, IPv6",
+		},
+		{
+			description: "provider config of another type",
+			config:      GardenerConfig{Networking: &NetworkingConfig{ProviderConfig: []byte(ciliumNetworkConfig)}},
+			shoot:       fixShoot(CalicoNetworkingType),
+			message:     "networking provider config of type calico must have apiVersion calico.networking.extensions.gardener.cloud/v1alpha1 and kind NetworkConfig",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			err := testCase.config.ValidateNetworkingChange(testCase.shoot)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
+
+func TestEditShootConfig_Networking(t *testing.T) {
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	// given
+	config := fixGardenerConfig("gcp", gcpGardenerProvider)
+	config.Networking = &NetworkingConfig{Type: CiliumNetworkingType, ProviderConfig: []byte(ciliumNetworkConfig)}
+	shoot := testkit.NewTestShoot("shoot").
+		WithWorkers(testkit.NewTestWorker("peon").WithZones("fix-zone-1").ToWorker()).
+		ToShoot()
+	shoot.Spec.Networking = &gardener_types.Networking{Type: util.PtrTo(CiliumNetworkingType)}
+
+	// when
+	err = gcpGardenerProvider.EditShootConfig(config, shoot)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, util.PtrTo(CiliumNetworkingType), shoot.Spec.Networking.Type)
+	assert.Equal(t, &apimachineryRuntime.RawExtension{Raw: []byte(ciliumNetworkConfig)}, shoot.Spec.Networking.ProviderConfig)
+}
+
+func TestNetworkingFromShoot(t *testing.T) {
+	t.Run("should return nil for default networking", func(t *testing.T) {
+		// when
+		networking := networkingFromShoot(&gardener_types.Networking{
+			Type:       util.PtrTo(CalicoNetworkingType),
+			IPFamilies: []gardener_types.IPFamily{gardener_types.IPFamilyIPv4},
+		})
+
+		// then
+		assert.Nil(t, networking)
+	})
+
+	t.Run("should return dual stack Cilium networking", func(t *testing.T) {
+		// when
+		networking := networkingFromShoot(&gardener_types.Networking{
+			Type:           util.PtrTo(CiliumNetworkingType),
+			IPFamilies:     []gardener_types.IPFamily{gardener_types.IPFamilyIPv4, gardener_types.IPFamilyIPv6},
+			ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(ciliumNetworkConfig)},
+		})
+
+		// then
+		assert.Equal(t, &NetworkingConfig{
+			Type:           CiliumNetworkingType,
+			IPFamilies:     []string{IPv4Family, IPv6Family},
+			ProviderConfig: []byte(ciliumNetworkConfig),
+		}, networking)
+	})
+}
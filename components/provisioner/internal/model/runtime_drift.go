@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+type DriftPolicy string
+
+const (
+	// DriftPolicyAdopt stores the value found in the shoot in the database
+	DriftPolicyAdopt DriftPolicy = "adopt"
+	// DriftPolicyReport only records the difference between the database and the shoot
+	DriftPolicyReport DriftPolicy = "report"
+)
+
+// DriftItem is a field of the Gardener config whose value stored in the database differs from the one in the shoot
+type DriftItem struct {
+	RuntimeID   string `db:"cluster_id"`
+	Field       string
+	StoredValue *string
+	ActualValue *string
+	DetectedAt  time.Time
+}
@@ -0,0 +1,29 @@
+package model
+
+// QuotaValues holds usage of the resources restricted by the tenant quota
+type QuotaValues struct {
+	Runtimes             int
+	TotalMaxNodes        int
+	ConcurrentOperations int
+}
+
+// QuotaLimits holds limits of the resources restricted by the tenant quota; nil limit means the resource is not restricted
+type QuotaLimits struct {
+	Runtimes             *int `json:"runtimes,omitempty"`
+	TotalMaxNodes        *int `json:"totalMaxNodes,omitempty"`
+	ConcurrentOperations *int `json:"concurrentOperations,omitempty"`
+}
+
+// TenantQuotaOverride holds limits overriding the default ones for the tenant; nil value means the default limit applies
+type TenantQuotaOverride struct {
+	Tenant               string
+	Runtimes             *int
+	TotalMaxNodes        *int
+	ConcurrentOperations *int
+}
+
+type TenantQuota struct {
+	Tenant string
+	Limits QuotaLimits
+	Usage  QuotaValues
+}
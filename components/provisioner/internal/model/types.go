@@ -61,6 +61,9 @@ type Cluster struct {
 	SubAccountId       *string
 	ActiveKymaConfigId *string
 	Administrators     []string
+	Description        *string
+	// Labels are stored as JSON, the values are not restricted to strings
+	Labels map[string]interface{} `db:"-"`
 
 	ClusterConfig GardenerConfig `db:"-"`
 	KymaConfig    *KymaConfig    `db:"-"`
@@ -84,9 +87,18 @@ type Operation struct {
 	ClusterID      string
 	Stage          OperationStage
 	LastTransition *time.Time
+	// SeedPlacement describes why the seed of the shoot was chosen
+	SeedPlacement *string
 	LastError
 }
 
+// OperationOwner identifies the tenant and the plan of the Runtime the operation is executed for
+type OperationOwner struct {
+	Tenant      string
+	Purpose     *string
+	LicenceType *string
+}
+
 type RuntimeAgentConnectionStatus int
 
 const (
@@ -100,6 +112,7 @@ type RuntimeStatus struct {
 	RuntimeConnectionStatus RuntimeAgentConnectionStatus
 	RuntimeConfiguration    Cluster
 	HibernationStatus       HibernationStatus
+	Drift                   []DriftItem
 }
 
 type OperationsCount struct {
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"slices"
+	"strings"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+)
+
+// Zones can be added to the worker of a zonal shoot, but they cannot be removed, as the nodes, volumes, and subnets of a zone cannot be moved to the remaining zones.
+// The shoots provisioned without zones stay without zones.
+
+// validateZoneChange checks that the config contains all zones of the worker and does not add zones to the shoot without zones.
+// The config without zones keeps the zones of the worker.
+func validateZoneChange(shoot *gardener_types.Shoot, zones []string) apperrors.AppError {
+	if len(zones) == 0 || len(shoot.Spec.Provider.Workers) == 0 {
+		return nil
+	}
+
+	if len(shoot.Spec.Provider.Workers[0].Zones) == 0 {
+		return apperrors.BadRequest("cannot add zones %s to shoot provisioned without zones", strings.Join(zones, ", "))
+	}
+
+	var removed []string
+	for _, zone := range shoot.Spec.Provider.Workers[0].Zones {
+		if !slices.Contains(zones, zone) {
+			removed = append(removed, zone)
+		}
+	}
+
+	if len(removed) > 0 {
+		return apperrors.BadRequest("removal of zones %s is not supported, as the nodes, volumes, and subnets of the zones cannot be moved to the remaining zones", strings.Join(removed, ", "))
+	}
+	return nil
+}
+
+// addedZones returns the zones of the config which are not used by the worker of the zonal shoot yet
+func addedZones(shoot *gardener_types.Shoot, zones []string) []string {
+	if len(shoot.Spec.Provider.Workers) == 0 || len(shoot.Spec.Provider.Workers[0].Zones) == 0 {
+		return nil
+	}
+
+	var added []string
+	for _, zone := range zones {
+		if !slices.Contains(shoot.Spec.Provider.Workers[0].Zones, zone) {
+			added = append(added, zone)
+		}
+	}
+	return added
+}
+
+func addWorkerZones(shoot *gardener_types.Shoot, zones []string) {
+	shoot.Spec.Provider.Workers[0].Zones = append(shoot.Spec.Provider.Workers[0].Zones, zones...)
+}
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	awsInfraOneZone   = `{"kind":"InfrastructureConfig","apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","networks":{"vpc":{"cidr":"10.250.0.0/16"},"zones":[{"name":"eu-central-1a","internal":"10.250.48.0/20","public":"10.250.32.0/20","workers":"10.250.0.0/19"}]}}`
+	azureInfraOneZone = `{"kind":"InfrastructureConfig","apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[{"name":1,"cidr":"10.250.0.0/19"}]},"zoned":true}`
+)
+
+func TestZonesUpgrade(t *testing.T) {
+	awsZoneA := &gqlschema.AWSZoneInput{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"}
+	awsZoneB := &gqlschema.AWSZoneInput{Name: "eu-central-1b", WorkerCidr: "10.250.64.0/19", PublicCidr: "10.250.96.0/20", InternalCidr: "10.250.112.0/20"}
+
+	t.Run("should add GCP zones to worker", func(t *testing.T) {
+		// given
+		providerConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}))
+		require.NoError(t, err)
+		shoot := fixZonalShoot("", "europe-west3-a")
+
+		// when
+		err = providerConfig.ValidateShootConfigChange(shoot)
+		require.NoError(t, err)
+		err = providerConfig.EditShootConfig(fixGardenerConfig("gcp", providerConfig), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"europe-west3-a", "europe-west3-b", "europe-west3-c"}, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	t.Run("should add AWS zone with its subnets", func(t *testing.T) {
+		// given
+		providerConfig, err := NewAWSGardenerConfig(&gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16", AwsZones: []*gqlschema.AWSZoneInput{awsZoneA, awsZoneB}})
+		require.NoError(t, err)
+		shoot := fixZonalShoot(awsInfraOneZone, "eu-central-1a")
+
+		// when
+		err = providerConfig.ValidateShootConfigChange(shoot)
+		require.NoError(t, err)
+		err = providerConfig.EditShootConfig(fixGardenerConfig("aws", providerConfig), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"eu-central-1a", "eu-central-1b"}, shoot.Spec.Provider.Workers[0].Zones)
+		assert.JSONEq(t, `{"kind":"InfrastructureConfig","apiVersion":"aws.provider.extensions.gardener.cloud/v1alpha1","networks":{"vpc":{"cidr":"10.250.0.0/16"},"zones":[
+			{"name":"eu-central-1a","internal":"10.250.48.0/20","public":"10.250.32.0/20","workers":"10.250.0.0/19"},
+			{"name":"eu-central-1b","internal":"10.250.112.0/20","public":"10.250.96.0/20","workers":"10.250.64.0/19"}]}}`,
+			string(shoot.Spec.Provider.InfrastructureConfig.Raw))
+	})
+
+	t.Run("should add Azure zone with its subnet", func(t *testing.T) {
+		// given
+		providerConfig, err := NewAzureGardenerConfig(&gqlschema.AzureProviderConfigInput{
+			VnetCidr:   "10.250.0.0/16",
+			AzureZones: []*gqlschema.AzureZoneInput{{Name: 1, Cidr: "10.250.0.0/19"}, {Name: 2, Cidr: "10.250.32.0/19"}},
+		})
+		require.NoError(t, err)
+		shoot := fixZonalShoot(azureInfraOneZone, "1")
+
+		// when
+		err = providerConfig.ValidateShootConfigChange(shoot)
+		require.NoError(t, err)
+		err = providerConfig.EditShootConfig(fixGardenerConfig("az", providerConfig), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, shoot.Spec.Provider.Workers[0].Zones)
+		assert.JSONEq(t, `{"kind":"InfrastructureConfig","apiVersion":"azure.provider.extensions.gardener.cloud/v1alpha1","networks":{"vnet":{"cidr":"10.250.0.0/16"},"zones":[
+			{"name":1,"cidr":"10.250.0.0/19"},{"name":2,"cidr":"10.250.32.0/19"}]},"zoned":true}`,
+			string(shoot.Spec.Provider.InfrastructureConfig.Raw))
+	})
+
+	t.Run("should not add zones to shoot without zones", func(t *testing.T) {
+		// given
+		providerConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"europe-west3-a"}))
+		require.NoError(t, err)
+		shoot := fixZonalShoot("")
+
+		// when
+		err = providerConfig.EditShootConfig(fixGardenerConfig("gcp", providerConfig), shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, shoot.Spec.Provider.Workers[0].Zones)
+	})
+
+	for _, testCase := range []struct {
+		description    string
+		providerConfig func() (GardenerProviderConfig, apperrors.AppError)
+		shoot          *gardener_types.Shoot
+		message        string
+	}{
+		{
+			description: "removal of GCP zone",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewGCPGardenerConfig(fixGCPGardenerInput([]string{"europe-west3-a"}))
+			},
+			shoot:   fixZonalShoot("", "europe-west3-a", "europe-west3-b"),
+			message: "removal of zones europe-west3-b is not supported, as the nodes, volumes, and subnets of the zones cannot be moved to the remaining zones",
+		},
+		{
+			description: "zones added to shoot without zones",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewAzureGardenerConfig(fixAzureGardenerInput([]string{"1", "2"}, nil))
+			},
+			shoot:   fixZonalShoot(""),
+			message: "cannot add zones 1, 2 to shoot provisioned without zones",
+		},
+		{
+			description: "removal of AWS zone",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewAWSGardenerConfig(&gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16", AwsZones: []*gqlschema.AWSZoneInput{awsZoneB}})
+			},
+			shoot:   fixZonalShoot(awsInfraOneZone, "eu-central-1a"),
+			message: "removal of zone eu-central-1a is not supported, as the nodes, volumes, and subnets of the zone cannot be moved to the remaining zones",
+		},
+		{
+			description: "change of AWS zone subnet",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewAWSGardenerConfig(&gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16", AwsZones: []*gqlschema.AWSZoneInput{
+					{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.64.0/20"},
+				}})
+			},
+			shoot:   fixZonalShoot(awsInfraOneZone, "eu-central-1a"),
+			message: "cannot change shoot network zone internal CIDR from 10.250.48.0/20 to 10.250.64.0/20",
+		},
+		{
+			description: "removal of Azure zone",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewAzureGardenerConfig(fixAzureGardenerInput([]string{"1"}, nil))
+			},
+			shoot:   fixZonalShoot("", "1", "2"),
+			message: "removal of zones 2 is not supported, as the nodes, volumes, and subnets of the zones cannot be moved to the remaining zones",
+		},
+		{
+			description: "Azure zone with its own subnet added to shoot network with single subnet",
+			providerConfig: func() (GardenerProviderConfig, apperrors.AppError) {
+				return NewAzureGardenerConfig(&gqlschema.AzureProviderConfigInput{
+					VnetCidr:   "10.250.0.0/16",
+					AzureZones: []*gqlschema.AzureZoneInput{{Name: 1, Cidr: "10.250.0.0/19"}},
+				})
+			},
+			shoot:   fixZonalShoot(`{"networks":{"vnet":{"cidr":"10.250.0.0/16"},"workers":"10.250.0.0/19"},"zoned":true}`, "1"),
+			message: "cannot add zone 1 with its own subnet to shoot network with single subnet",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// given
+			providerConfig, err := testCase.providerConfig()
+			require.NoError(t, err)
+
+			// when
+			err = providerConfig.ValidateShootConfigChange(testCase.shoot)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
+
+func fixZonalShoot(infrastructureConfig string, zones ...string) *gardener_types.Shoot {
+	shoot := testkit.NewTestShoot("shoot").
+		WithWorkers(testkit.NewTestWorker("peon").WithZones(zones...).ToWorker()).
+		ToShoot()
+	if infrastructureConfig != "" {
+		shoot.Spec.Provider.InfrastructureConfig = &apimachineryRuntime.RawExtension{Raw: []byte(infrastructureConfig)}
+	}
+	return shoot
+}
@@ -0,0 +1,193 @@
+package network
+
+import (
+	"net/netip"
+	"slices"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	DefaultAWSWorkerPrefixLength   = 19
+	DefaultAWSPublicPrefixLength   = 20
+	DefaultAWSInternalPrefixLength = 20
+	DefaultAzureZonePrefixLength   = 19
+)
+
+// AllocateZones returns the provider config in which the zone allocation of the AWS and Azure configs is replaced with the zones whose subnets are allocated from the VPC or the VNet.
+// The subnets are allocated one after another in the order of the zones, so the same input always results in the same zones.
+// The provider config passed in is not modified.
+func AllocateZones(providerConfig *gqlschema.ProviderSpecificInput) (*gqlschema.ProviderSpecificInput, apperrors.AppError) {
+	if providerConfig == nil {
+		return nil, nil
+	}
+
+	allocated := *providerConfig
+
+	if awsConfig := providerConfig.AwsConfig; awsConfig != nil && awsConfig.AwsZoneAllocation != nil {
+		if len(awsConfig.AwsZones) > 0 {
+			return nil, apperrors.BadRequest("awsZones cannot be combined with awsZoneAllocation")
+		}
+		zones, err := allocateAWSZones(awsConfig.VpcCidr, *awsConfig.AwsZoneAllocation)
+		if err != nil {
+			return nil, err
+		}
+		allocatedAWSConfig := *awsConfig
+		allocatedAWSConfig.AwsZones = zones
+		allocatedAWSConfig.AwsZoneAllocation = nil
+		allocated.AwsConfig = &allocatedAWSConfig
+	}
+
+	if azureConfig := providerConfig.AzureConfig; azureConfig != nil && azureConfig.AzureZoneAllocation != nil {
+		if len(azureConfig.AzureZones) > 0 || len(azureConfig.Zones) > 0 {
+			return nil, apperrors.BadRequest("zones and azureZones cannot be combined with azureZoneAllocation")
+		}
+		zones, err := allocateAzureZones(azureConfig.VnetCidr, *azureConfig.AzureZoneAllocation)
+		if err != nil {
+			return nil, err
+		}
+		allocatedAzureConfig := *azureConfig
+		allocatedAzureConfig.AzureZones = zones
+		allocatedAzureConfig.AzureZoneAllocation = nil
+		allocated.AzureConfig = &allocatedAzureConfig
+	}
+
+	return &allocated, nil
+}
+
+func allocateAWSZones(vpcCidr string, allocation gqlschema.AWSZoneAllocationInput) ([]*gqlschema.AWSZoneInput, apperrors.AppError) {
+	vpc, err := parse("vpcCidr", vpcCidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allocation.Names) == 0 {
+		return nil, apperrors.BadRequest("awsZoneAllocation must contain at least one zone")
+	}
+	if duplicate, found := findDuplicate(allocation.Names); found {
+		return nil, apperrors.BadRequest("awsZoneAllocation contains zone %s more than once", duplicate)
+	}
+	if slices.Contains(allocation.Names, "") {
+		return nil, apperrors.BadRequest("awsZoneAllocation contains zone without name")
+	}
+
+	workerBits := util.UnwrapOrDefault(allocation.WorkerPrefixLength, DefaultAWSWorkerPrefixLength)
+	publicBits := util.UnwrapOrDefault(allocation.PublicPrefixLength, DefaultAWSPublicPrefixLength)
+	internalBits := util.UnwrapOrDefault(allocation.InternalPrefixLength, DefaultAWSInternalPrefixLength)
+
+	allocator, err := newAllocator(vpc)
+	if err != nil {
+		return nil, err
+	}
+	zones := make([]*gqlschema.AWSZoneInput, 0, len(allocation.Names))
+	for _, name := range allocation.Names {
+		worker, err := allocator.next("workerPrefixLength", workerBits)
+		if err != nil {
+			return nil, err
+		}
+		public, err := allocator.next("publicPrefixLength", publicBits)
+		if err != nil {
+			return nil, err
+		}
+		internal, err := allocator.next("internalPrefixLength", internalBits)
+		if err != nil {
+			return nil, err
+		}
+
+		zones = append(zones, &gqlschema.AWSZoneInput{
+			Name:         name,
+			WorkerCidr:   worker.String(),
+			PublicCidr:   public.String(),
+			InternalCidr: internal.String(),
+		})
+	}
+	return zones, nil
+}
+
+func allocateAzureZones(vnetCidr string, allocation gqlschema.AzureZoneAllocationInput) ([]*gqlschema.AzureZoneInput, apperrors.AppError) {
+	vnet, err := parse("vnetCidr", vnetCidr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(allocation.Names) == 0 {
+		return nil, apperrors.BadRequest("azureZoneAllocation must contain at least one zone")
+	}
+	if duplicate, found := findDuplicate(allocation.Names); found {
+		return nil, apperrors.BadRequest("azureZoneAllocation contains zone %d more than once", duplicate)
+	}
+
+	bits := util.UnwrapOrDefault(allocation.PrefixLength, DefaultAzureZonePrefixLength)
+
+	allocator, err := newAllocator(vnet)
+	if err != nil {
+		return nil, err
+	}
+	zones := make([]*gqlschema.AzureZoneInput, 0, len(allocation.Names))
+	for _, name := range allocation.Names {
+		subnet, err := allocator.next("prefixLength", bits)
+		if err != nil {
+			return nil, err
+		}
+		zones = append(zones, &gqlschema.AzureZoneInput{Name: name, Cidr: subnet.String()})
+	}
+	return zones, nil
+}
+
+// allocator hands out consecutive subnets of the IPv4 network, each aligned to its own size
+type allocator struct {
+	network namedRange
+	free    uint64
+	end     uint64
+}
+
+func newAllocator(network namedRange) (*allocator, apperrors.AppError) {
+	if !network.prefix.Addr().Is4() {
+		return nil, apperrors.BadRequest("%s must be an IPv4 CIDR to allocate zone subnets", network)
+	}
+
+	start := uint64(ipv4ToUint32(network.prefix.Addr()))
+	return &allocator{
+		network: network,
+		free:    start,
+		end:     start + uint64(1)<<(32-network.prefix.Bits()),
+	}, nil
+}
+
+func (a *allocator) next(name string, bits int) (netip.Prefix, apperrors.AppError) {
+	if bits < a.network.prefix.Bits() || bits > 32 {
+		return netip.Prefix{}, apperrors.BadRequest("%s %d must be between %d and 32", name, bits, a.network.prefix.Bits())
+	}
+
+	size := uint64(1) << (32 - bits)
+	start := (a.free + size - 1) / size * size
+	if start+size > a.end {
+		return netip.Prefix{}, apperrors.BadRequest("%s is too small for the subnets of all zones", a.network)
+	}
+	a.free = start + size
+
+	return netip.PrefixFrom(uint32ToIPv4(uint32(start)), bits), nil
+}
+
+func findDuplicate[T comparable](values []T) (T, bool) {
+	seen := make(map[T]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			return value, true
+		}
+		seen[value] = true
+	}
+	var zero T
+	return zero, false
+}
+
+func ipv4ToUint32(addr netip.Addr) uint32 {
+	bytes := addr.As4()
+	return uint32(bytes[0])<<24 | uint32(bytes[1])<<16 | uint32(bytes[2])<<8 | uint32(bytes[3])
+}
+
+func uint32ToIPv4(value uint32) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(value >> 24), byte(value >> 16), byte(value >> 8), byte(value)})
+}
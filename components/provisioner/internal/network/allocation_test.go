@@ -0,0 +1,176 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllocateZones(t *testing.T) {
+	t.Run("should allocate AWS zones with default prefix lengths", func(t *testing.T) {
+		// given
+		providerConfig := &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/16",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}},
+			},
+		}
+
+		// when
+		allocated, err := AllocateZones(providerConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, allocated.AwsConfig.AwsZoneAllocation)
+		assert.Equal(t, []*gqlschema.AWSZoneInput{
+			{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+			{Name: "eu-central-1b", WorkerCidr: "10.250.64.0/19", PublicCidr: "10.250.96.0/20", InternalCidr: "10.250.112.0/20"},
+			{Name: "eu-central-1c", WorkerCidr: "10.250.128.0/19", PublicCidr: "10.250.160.0/20", InternalCidr: "10.250.176.0/20"},
+		}, allocated.AwsConfig.AwsZones)
+		assert.NotNil(t, providerConfig.AwsConfig.AwsZoneAllocation)
+		assert.Empty(t, providerConfig.AwsConfig.AwsZones)
+	})
+
+	t.Run("should allocate AWS zones with aligned subnets of requested prefix lengths", func(t *testing.T) {
+		// given
+		providerConfig := &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr: "10.180.0.0/20",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{
+					Names:                []string{"us-east-1a", "us-east-1b"},
+					WorkerPrefixLength:   util.PtrTo(23),
+					PublicPrefixLength:   util.PtrTo(26),
+					InternalPrefixLength: util.PtrTo(24),
+				},
+			},
+		}
+
+		// when
+		allocated, err := AllocateZones(providerConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*gqlschema.AWSZoneInput{
+			{Name: "us-east-1a", WorkerCidr: "10.180.0.0/23", PublicCidr: "10.180.2.0/26", InternalCidr: "10.180.3.0/24"},
+			{Name: "us-east-1b", WorkerCidr: "10.180.4.0/23", PublicCidr: "10.180.6.0/26", InternalCidr: "10.180.7.0/24"},
+		}, allocated.AwsConfig.AwsZones)
+	})
+
+	t.Run("should allocate Azure zones", func(t *testing.T) {
+		// given
+		providerConfig := &gqlschema.ProviderSpecificInput{
+			AzureConfig: &gqlschema.AzureProviderConfigInput{
+				VnetCidr:            "10.250.0.0/16",
+				AzureZoneAllocation: &gqlschema.AzureZoneAllocationInput{Names: []int{1, 2, 3}},
+			},
+		}
+
+		// when
+		allocated, err := AllocateZones(providerConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, allocated.AzureConfig.AzureZoneAllocation)
+		assert.Equal(t, []*gqlschema.AzureZoneInput{
+			{Name: 1, Cidr: "10.250.0.0/19"},
+			{Name: 2, Cidr: "10.250.32.0/19"},
+			{Name: 3, Cidr: "10.250.64.0/19"},
+		}, allocated.AzureConfig.AzureZones)
+		assert.NotNil(t, providerConfig.AzureConfig.AzureZoneAllocation)
+		assert.Empty(t, providerConfig.AzureConfig.AzureZones)
+	})
+
+	t.Run("should keep zones without allocation", func(t *testing.T) {
+		// given
+		zones := []*gqlschema.AzureZoneInput{{Name: 1, Cidr: "10.250.0.0/19"}}
+		providerConfig := &gqlschema.ProviderSpecificInput{
+			AzureConfig: &gqlschema.AzureProviderConfigInput{VnetCidr: "10.250.0.0/16", AzureZones: zones},
+		}
+
+		// when
+		allocated, err := AllocateZones(providerConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, zones, allocated.AzureConfig.AzureZones)
+	})
+
+	for _, testCase := range []struct {
+		description    string
+		providerConfig *gqlschema.ProviderSpecificInput
+		message        string
+	}{
+		{
+			description: "AWS zones combined with allocation",
+			providerConfig: &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/16",
+				AwsZones:          []*gqlschema.AWSZoneInput{{Name: "eu-central-1a"}},
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a"}},
+			}},
+			message: "awsZones cannot be combined with awsZoneAllocation",
+		},
+		{
+			description: "duplicated AWS zone",
+			providerConfig: &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/16",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a", "eu-central-1a"}},
+			}},
+			message: "awsZoneAllocation contains zone eu-central-1a more than once",
+		},
+		{
+			description: "VPC too small for all zones",
+			providerConfig: &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/17",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}},
+			}},
+			message: "vpcCidr 10.250.0.0/17 is too small for the subnets of all zones",
+		},
+		{
+			description: "prefix length shorter than VPC",
+			providerConfig: &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr:           "10.250.0.0/16",
+				AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a"}, WorkerPrefixLength: util.PtrTo(15)},
+			}},
+			message: "workerPrefixLength 15 must be between 16 and 32",
+		},
+		{
+			description: "Azure zones combined with allocation",
+			providerConfig: &gqlschema.ProviderSpecificInput{AzureConfig: &gqlschema.AzureProviderConfigInput{
+				VnetCidr:            "10.250.0.0/16",
+				Zones:               []string{"1"},
+				AzureZoneAllocation: &gqlschema.AzureZoneAllocationInput{Names: []int{1}},
+			}},
+			message: "zones and azureZones cannot be combined with azureZoneAllocation",
+		},
+		{
+			description: "empty Azure allocation",
+			providerConfig: &gqlschema.ProviderSpecificInput{AzureConfig: &gqlschema.AzureProviderConfigInput{
+				VnetCidr:            "10.250.0.0/16",
+				AzureZoneAllocation: &gqlschema.AzureZoneAllocationInput{},
+			}},
+			message: "azureZoneAllocation must contain at least one zone",
+		},
+		{
+			description: "IPv6 VNet",
+			providerConfig: &gqlschema.ProviderSpecificInput{AzureConfig: &gqlschema.AzureProviderConfigInput{
+				VnetCidr:            "fd00::/48",
+				AzureZoneAllocation: &gqlschema.AzureZoneAllocationInput{Names: []int{1}},
+			}},
+			message: "vnetCidr fd00::/48 must be an IPv4 CIDR to allocate zone subnets",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			_, err := AllocateZones(testCase.providerConfig)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
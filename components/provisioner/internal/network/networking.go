@@ -0,0 +1,76 @@
+package network
+
+import (
+	"slices"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+var networkingTypes = []string{model.CalicoNetworkingType, model.CiliumNetworkingType}
+
+// ValidateNetworking checks the networking type, the IP families, and the provider config of the networking plugin.
+// Dual stack is supported only on AWS, and the IPv6 single stack is not supported, as the node, pod, and service networks are IPv4.
+func ValidateNetworking(config gqlschema.GardenerConfigInput) apperrors.AppError {
+	networking := config.Networking
+	if networking == nil {
+		return nil
+	}
+
+	if err := validateNetworkingType(networking.Type); err != nil {
+		return err
+	}
+
+	if len(networking.IPFamilies) > 0 {
+		if duplicate, found := findDuplicate(networking.IPFamilies); found {
+			return apperrors.BadRequest("ipFamilies contain %s more than once", duplicate)
+		}
+		for _, family := range networking.IPFamilies {
+			if family != model.IPv4Family && family != model.IPv6Family {
+				return apperrors.BadRequest("ipFamilies contain unknown IP family %s, must be %s or %s", family, model.IPv4Family, model.IPv6Family)
+			}
+		}
+		if networking.IPFamilies[0] != model.IPv4Family {
+			return apperrors.BadRequest("ipFamilies must start with %s, as IPv6 single stack is not supported", model.IPv4Family)
+		}
+		dualStack := slices.Contains(networking.IPFamilies, model.IPv6Family)
+		if dualStack && (config.ProviderSpecificConfig == nil || config.ProviderSpecificConfig.AwsConfig == nil) {
+			return apperrors.BadRequest("dual stack is supported only on AWS")
+		}
+	}
+
+	return validateNetworkingProviderConfig(networking)
+}
+
+// ValidateNetworkingUpgrade checks the networking of the upgrade input. Whether the type and the IP families stay the same is checked against the shoot when the upgrade starts.
+func ValidateNetworkingUpgrade(networking *gqlschema.NetworkingInput) apperrors.AppError {
+	if networking == nil {
+		return nil
+	}
+
+	if err := validateNetworkingType(networking.Type); err != nil {
+		return err
+	}
+
+	// Without the type, the provider config is validated against the type of the shoot when the upgrade starts
+	if networking.Type == nil {
+		return nil
+	}
+	return validateNetworkingProviderConfig(networking)
+}
+
+func validateNetworkingType(networkingType *string) apperrors.AppError {
+	if networkingType != nil && !slices.Contains(networkingTypes, *networkingType) {
+		return apperrors.BadRequest("networking type %s is not supported, must be %s or %s", *networkingType, model.CalicoNetworkingType, model.CiliumNetworkingType)
+	}
+	return nil
+}
+
+func validateNetworkingProviderConfig(networking *gqlschema.NetworkingInput) apperrors.AppError {
+	if networking.ProviderConfig == nil {
+		return nil
+	}
+	return model.ValidateNetworkingProviderConfig(util.UnwrapOrDefault(networking.Type, model.CalicoNetworkingType), []byte(*networking.ProviderConfig))
+}
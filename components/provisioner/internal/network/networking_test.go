@@ -0,0 +1,132 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ciliumNetworkConfig = `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`
+
+func TestValidateNetworking(t *testing.T) {
+	awsConfig := &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{}}
+	gcpConfig := &gqlschema.ProviderSpecificInput{GcpConfig: &gqlschema.GCPProviderConfigInput{}}
+
+	for _, testCase := range []struct {
+		description    string
+		providerConfig *gqlschema.ProviderSpecificInput
+		networking     *gqlschema.NetworkingInput
+	}{
+		{description: "config without networking", providerConfig: gcpConfig},
+		{
+			description:    "Calico without type",
+			providerConfig: gcpConfig,
+			networking:     &gqlschema.NetworkingInput{IPFamilies: []string{model.IPv4Family}},
+		},
+		{
+			description:    "Cilium with provider config",
+			providerConfig: gcpConfig,
+			networking:     &gqlschema.NetworkingInput{Type: util.PtrTo(model.CiliumNetworkingType), ProviderConfig: util.PtrTo(ciliumNetworkConfig)},
+		},
+		{
+			description:    "dual stack on AWS",
+			providerConfig: awsConfig,
+			networking:     &gqlschema.NetworkingInput{Type: util.PtrTo(model.CalicoNetworkingType), IPFamilies: []string{model.IPv4Family, model.IPv6Family}},
+		},
+	} {
+		t.Run("should accept "+testCase.description, func(t *testing.T) {
+			// when
+			err := ValidateNetworking(gqlschema.GardenerConfigInput{ProviderSpecificConfig: testCase.providerConfig, Networking: testCase.networking})
+
+			// then
+			require.NoError(t, err)
+		})
+	}
+
+	for _, testCase := range []struct {
+		description    string
+		providerConfig *gqlschema.ProviderSpecificInput
+		networking     *gqlschema.NetworkingInput
+		message        string
+	}{
+		{
+			description:    "unsupported type",
+			providerConfig: awsConfig,
+			networking:     &gqlschema.NetworkingInput{Type: util.PtrTo("flannel")},
+			message:        "networking type flannel is not supported, must be calico or cilium",
+		},
+		{
+			description:    "duplicated IP family",
+			providerConfig: awsConfig,
+			networking:     &gqlschema.NetworkingInput{IPFamilies: []string{model.IPv4Family, model.IPv4Family}},
+			message:        "ipFamilies contain IPv4 more than once",
+		},
+		{
+			description:    "unknown IP family",
+			providerConfig: awsConfig,
+			networking:     &gqlschema.NetworkingInput{IPFamilies: []string{model.IPv4Family, "IPv5"}},
+			message:        "ipFamilies contain unknown IP family IPv5, must be IPv4 or IPv6",
+		},
+		{
+			description:    "IPv6 single stack",
+			providerConfig: awsConfig,
+			networking:     &gqlschema.NetworkingInput{IPFamilies: []string{model.IPv6Family}},
+			message:        "ipFamilies must start with IPv4, as IPv6 single stack is not supported",
+		},
+		{
+			description:    "dual stack outside of AWS",
+			providerConfig: gcpConfig,
+			networking:     &gqlschema.NetworkingInput{IPFamilies: []string{model.IPv4Family, model.IPv6Family}},
+			message:        "dual stack is supported only on AWS",
+		},
+		{
+			description:    "provider config of another type",
+			providerConfig: gcpConfig,
+			networking:     &gqlschema.NetworkingInput{ProviderConfig: util.PtrTo(ciliumNetworkConfig)},
+			message:        "networking provider config of type calico must have apiVersion calico.networking.extensions.gardener.cloud/v1alpha1 and kind NetworkConfig",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			err := ValidateNetworking(gqlschema.GardenerConfigInput{ProviderSpecificConfig: testCase.providerConfig, Networking: testCase.networking})
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
+
+func TestValidateNetworkingUpgrade(t *testing.T) {
+	t.Run("should accept provider config without type", func(t *testing.T) {
+		// when
+		err := ValidateNetworkingUpgrade(&gqlschema.NetworkingInput{ProviderConfig: util.PtrTo(ciliumNetworkConfig)})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject unsupported type", func(t *testing.T) {
+		// when
+		err := ValidateNetworkingUpgrade(&gqlschema.NetworkingInput{Type: util.PtrTo("flannel")})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("should reject provider config of another type", func(t *testing.T) {
+		// when
+		err := ValidateNetworkingUpgrade(&gqlschema.NetworkingInput{Type: util.PtrTo(model.CalicoNetworkingType), ProviderConfig: util.PtrTo(ciliumNetworkConfig)})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
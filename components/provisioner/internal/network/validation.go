@@ -0,0 +1,209 @@
+package network
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// ParseReservedRanges parses the comma separated CIDRs which cannot be used by the shoots, for example the ranges of the seeds
+func ParseReservedRanges(ranges string) ([]netip.Prefix, error) {
+	var reserved []netip.Prefix
+	for _, value := range strings.Split(ranges, ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid reserved range %s: %s", value, err.Error())
+		}
+		reserved = append(reserved, prefix.Masked())
+	}
+	return reserved, nil
+}
+
+type namedRange struct {
+	name   string
+	prefix netip.Prefix
+}
+
+func (r namedRange) String() string {
+	return fmt.Sprintf("%s %s", r.name, r.prefix)
+}
+
+// ValidateGardenerConfig checks that the CIDRs of the Gardener config are well formed, that the provider networks are nested as required by the infrastructure config of the provider,
+// and that the node, pod, and service networks do not overlap each other or the reserved ranges
+func ValidateGardenerConfig(config gqlschema.GardenerConfigInput, reserved []netip.Prefix) apperrors.AppError {
+	workers, err := parse("workerCidr", config.WorkerCidr)
+	if err != nil {
+		return err
+	}
+
+	nodes := workers
+	if providerConfig := config.ProviderSpecificConfig; providerConfig != nil {
+		switch {
+		case providerConfig.AwsConfig != nil:
+			nodes, err = validateAWSNetworks(*providerConfig.AwsConfig)
+		case providerConfig.AzureConfig != nil:
+			nodes, err = validateAzureNetworks(*providerConfig.AzureConfig, workers)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	clusterRanges := []namedRange{nodes}
+	for _, optional := range []struct {
+		name  string
+		value *string
+	}{
+		{"podsCidr", config.PodsCidr},
+		{"servicesCidr", config.ServicesCidr},
+	} {
+		if optional.value == nil {
+			continue
+		}
+		clusterRange, err := parse(optional.name, *optional.value)
+		if err != nil {
+			return err
+		}
+		clusterRanges = append(clusterRanges, clusterRange)
+	}
+
+	if err := validateDisjoint(clusterRanges); err != nil {
+		return err
+	}
+
+	for _, clusterRange := range clusterRanges {
+		for _, reservedRange := range reserved {
+			if clusterRange.prefix.Overlaps(reservedRange) {
+				return apperrors.BadRequest("%s overlaps with reserved range %s", clusterRange, reservedRange)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateZones checks that the zone subnets of the AWS and Azure configs are nested in the VPC or the VNet and do not overlap each other
+func ValidateZones(providerConfig *gqlschema.ProviderSpecificInput) apperrors.AppError {
+	if providerConfig == nil {
+		return nil
+	}
+
+	switch {
+	case providerConfig.AwsConfig != nil:
+		_, err := validateAWSNetworks(*providerConfig.AwsConfig)
+		return err
+	case providerConfig.AzureConfig != nil && len(providerConfig.AzureConfig.AzureZones) > 0:
+		_, err := validateAzureNetworks(*providerConfig.AzureConfig, namedRange{})
+		return err
+	default:
+		return nil
+	}
+}
+
+// The zone subnets are created in the VPC, and the VPC is the node network of the shoot
+func validateAWSNetworks(config gqlschema.AWSProviderConfigInput) (namedRange, apperrors.AppError) {
+	vpc, err := parse("vpcCidr", config.VpcCidr)
+	if err != nil {
+		return namedRange{}, err
+	}
+
+	var subnets []namedRange
+	for _, zone := range config.AwsZones {
+		if zone == nil {
+			continue
+		}
+		for _, subnet := range []struct{ name, value string }{
+			{"publicCidr", zone.PublicCidr},
+			{"internalCidr", zone.InternalCidr},
+			{"workerCidr", zone.WorkerCidr},
+		} {
+			subnetRange, err := parse(fmt.Sprintf("zone %s %s", zone.Name, subnet.name), subnet.value)
+			if err != nil {
+				return namedRange{}, err
+			}
+			subnets = append(subnets, subnetRange)
+		}
+	}
+
+	// The shoot cannot be created without workers, which are placed in the zones
+	if len(subnets) == 0 {
+		return namedRange{}, apperrors.BadRequest("AWS config requires at least one zone in awsZones or awsZoneAllocation")
+	}
+
+	if err := validateNested(vpc, subnets); err != nil {
+		return namedRange{}, err
+	}
+	return vpc, nil
+}
+
+// The workers subnet, or the zone subnets if they are configured, are created in the VNet, and the VNet is the node network of the shoot
+func validateAzureNetworks(config gqlschema.AzureProviderConfigInput, workers namedRange) (namedRange, apperrors.AppError) {
+	vnet, err := parse("vnetCidr", config.VnetCidr)
+	if err != nil {
+		return namedRange{}, err
+	}
+
+	subnets := []namedRange{workers}
+	if len(config.AzureZones) > 0 {
+		subnets = nil
+		for _, zone := range config.AzureZones {
+			if zone == nil {
+				continue
+			}
+			subnetRange, err := parse(fmt.Sprintf("zone %d cidr", zone.Name), zone.Cidr)
+			if err != nil {
+				return namedRange{}, err
+			}
+			subnets = append(subnets, subnetRange)
+		}
+	}
+
+	if err := validateNested(vnet, subnets); err != nil {
+		return namedRange{}, err
+	}
+	return vnet, nil
+}
+
+func validateNested(network namedRange, subnets []namedRange) apperrors.AppError {
+	for _, subnet := range subnets {
+		if !contains(network.prefix, subnet.prefix) {
+			return apperrors.BadRequest("%s is not within %s", subnet, network)
+		}
+	}
+	return validateDisjoint(subnets)
+}
+
+func validateDisjoint(ranges []namedRange) apperrors.AppError {
+	for i := range ranges {
+		for j := i + 1; j < len(ranges); j++ {
+			if ranges[i].prefix.Overlaps(ranges[j].prefix) {
+				return apperrors.BadRequest("%s overlaps with %s", ranges[i], ranges[j])
+			}
+		}
+	}
+	return nil
+}
+
+func contains(network, subnet netip.Prefix) bool {
+	return network.Bits() <= subnet.Bits() && network.Contains(subnet.Addr())
+}
+
+// parse accepts only CIDRs without host bits, as Gardener and the infrastructure providers reject the others
+func parse(name, value string) (namedRange, apperrors.AppError) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return namedRange{}, apperrors.BadRequest("%s %s is not a valid CIDR", name, value)
+	}
+	if prefix.Masked() != prefix {
+		return namedRange{}, apperrors.BadRequest("%s %s has host bits set, use %s", name, value, prefix.Masked())
+	}
+	return namedRange{name: name, prefix: prefix}, nil
+}
@@ -0,0 +1,209 @@
+package network
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReservedRanges(t *testing.T) {
+	t.Run("should parse comma separated ranges", func(t *testing.T) {
+		// when
+		reserved, err := ParseReservedRanges("10.242.0.0/16, 10.243.0.0/16,")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.242.0.0/16"), netip.MustParsePrefix("10.243.0.0/16")}, reserved)
+	})
+
+	t.Run("should return no ranges for empty value", func(t *testing.T) {
+		// when
+		reserved, err := ParseReservedRanges("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, reserved)
+	})
+
+	t.Run("should return error for invalid range", func(t *testing.T) {
+		// when
+		_, err := ParseReservedRanges("10.242.0.0/16,seed")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestValidateGardenerConfig(t *testing.T) {
+	reserved := []netip.Prefix{netip.MustParsePrefix("10.242.0.0/16")}
+
+	for _, testCase := range []struct {
+		description string
+		config      gqlschema.GardenerConfigInput
+	}{
+		{
+			description: "GCP networks",
+			config:      gcpConfig("10.250.0.0/16", util.PtrTo("100.64.0.0/12"), util.PtrTo("100.104.0.0/13")),
+		},
+		{
+			description: "AWS networks",
+			config: awsConfig("10.250.0.0/16",
+				&gqlschema.AWSZoneInput{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+				&gqlschema.AWSZoneInput{Name: "eu-central-1b", WorkerCidr: "10.250.64.0/19", PublicCidr: "10.250.96.0/20", InternalCidr: "10.250.112.0/20"},
+			),
+		},
+		{
+			description: "Azure networks with workers subnet",
+			config:      azureConfig("10.250.0.0/16", "10.250.0.0/19"),
+		},
+		{
+			description: "Azure networks with zone subnets",
+			config: azureConfig("10.250.0.0/16", "10.250.0.0/16",
+				&gqlschema.AzureZoneInput{Name: 1, Cidr: "10.250.0.0/19"},
+				&gqlschema.AzureZoneInput{Name: 2, Cidr: "10.250.32.0/19"},
+			),
+		},
+	} {
+		t.Run("should accept "+testCase.description, func(t *testing.T) {
+			// when
+			err := ValidateGardenerConfig(testCase.config, reserved)
+
+			// then
+			require.NoError(t, err)
+		})
+	}
+
+	for _, testCase := range []struct {
+		description string
+		config      gqlschema.GardenerConfigInput
+		message     string
+	}{
+		{
+			description: "malformed worker CIDR",
+			config:      gcpConfig("10.250.0.0/33", nil, nil),
+			message:     "workerCidr 10.250.0.0/33 is not a valid CIDR",
+		},
+		{
+			description: "CIDR with host bits",
+			config:      gcpConfig("10.250.0.1/16", nil, nil),
+			message:     "workerCidr 10.250.0.1/16 has host bits set, use 10.250.0.0/16",
+		},
+		{
+			description: "pods overlapping nodes",
+			config:      gcpConfig("10.250.0.0/16", util.PtrTo("10.250.128.0/17"), nil),
+			message:     "workerCidr 10.250.0.0/16 overlaps with podsCidr 10.250.128.0/17",
+		},
+		{
+			description: "services overlapping pods",
+			config:      gcpConfig("10.250.0.0/16", util.PtrTo("100.64.0.0/12"), util.PtrTo("100.64.0.0/13")),
+			message:     "podsCidr 100.64.0.0/12 overlaps with servicesCidr 100.64.0.0/13",
+		},
+		{
+			description: "nodes overlapping reserved range",
+			config:      gcpConfig("10.240.0.0/12", nil, nil),
+			message:     "workerCidr 10.240.0.0/12 overlaps with reserved range 10.242.0.0/16",
+		},
+		{
+			description: "AWS zone outside VPC",
+			config: awsConfig("10.250.0.0/16",
+				&gqlschema.AWSZoneInput{Name: "eu-central-1a", WorkerCidr: "10.251.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+			),
+			message: "zone eu-central-1a workerCidr 10.251.0.0/19 is not within vpcCidr 10.250.0.0/16",
+		},
+		{
+			description: "overlapping AWS zone subnets",
+			config: awsConfig("10.250.0.0/16",
+				&gqlschema.AWSZoneInput{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+				&gqlschema.AWSZoneInput{Name: "eu-central-1b", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.96.0/20", InternalCidr: "10.250.112.0/20"},
+			),
+			message: "zone eu-central-1a workerCidr 10.250.0.0/19 overlaps with zone eu-central-1b workerCidr 10.250.0.0/19",
+		},
+		{
+			description: "malformed AWS VPC",
+			config:      awsConfig("vpc"),
+			message:     "vpcCidr vpc is not a valid CIDR",
+		},
+		{
+			description: "AWS config without zones",
+			config:      awsConfig("10.250.0.0/16"),
+			message:     "AWS config requires at least one zone in awsZones or awsZoneAllocation",
+		},
+		{
+			description: "Azure workers outside VNet",
+			config:      azureConfig("10.250.0.0/16", "10.0.0.0/8"),
+			message:     "workerCidr 10.0.0.0/8 is not within vnetCidr 10.250.0.0/16",
+		},
+		{
+			description: "overlapping Azure zone subnets",
+			config: azureConfig("10.250.0.0/16", "10.250.0.0/16",
+				&gqlschema.AzureZoneInput{Name: 1, Cidr: "10.250.0.0/19"},
+				&gqlschema.AzureZoneInput{Name: 2, Cidr: "10.250.0.0/20"},
+			),
+			message: "zone 1 cidr 10.250.0.0/19 overlaps with zone 2 cidr 10.250.0.0/20",
+		},
+	} {
+		t.Run("should reject "+testCase.description, func(t *testing.T) {
+			// when
+			err := ValidateGardenerConfig(testCase.config, reserved)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			assert.Equal(t, testCase.message, err.Error())
+		})
+	}
+}
+
+func TestValidateZones(t *testing.T) {
+	t.Run("should accept provider config without zone subnets", func(t *testing.T) {
+		// when
+		err := ValidateZones(&gqlschema.ProviderSpecificInput{AzureConfig: &gqlschema.AzureProviderConfigInput{VnetCidr: "10.250.0.0/16"}})
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject Azure zone outside VNet", func(t *testing.T) {
+		// when
+		err := ValidateZones(azureConfig("10.250.0.0/16", "", &gqlschema.AzureZoneInput{Name: 1, Cidr: "10.251.0.0/19"}).ProviderSpecificConfig)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Equal(t, "zone 1 cidr 10.251.0.0/19 is not within vnetCidr 10.250.0.0/16", err.Error())
+	})
+}
+
+func gcpConfig(workerCidr string, podsCidr, servicesCidr *string) gqlschema.GardenerConfigInput {
+	return gqlschema.GardenerConfigInput{
+		WorkerCidr:   workerCidr,
+		PodsCidr:     podsCidr,
+		ServicesCidr: servicesCidr,
+		ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+			GcpConfig: &gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west3-a"}},
+		},
+	}
+}
+
+func awsConfig(vpcCidr string, zones ...*gqlschema.AWSZoneInput) gqlschema.GardenerConfigInput {
+	return gqlschema.GardenerConfigInput{
+		WorkerCidr: "10.250.0.0/16",
+		ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{VpcCidr: vpcCidr, AwsZones: zones},
+		},
+	}
+}
+
+func azureConfig(vnetCidr, workerCidr string, zones ...*gqlschema.AzureZoneInput) gqlschema.GardenerConfigInput {
+	return gqlschema.GardenerConfigInput{
+		WorkerCidr: workerCidr,
+		ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+			AzureConfig: &gqlschema.AzureProviderConfigInput{VnetCidr: vnetCidr, AzureZones: zones},
+		},
+	}
+}
@@ -0,0 +1,72 @@
+package queue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultWeight = 1
+
+type planClassifier struct {
+	readSession dbsession.ReadSession
+	planWeights map[string]int
+}
+
+// NewPlanClassifier creates the Classifier which weights operations by the licence type or, if it is not set, by the purpose of the Runtime
+func NewPlanClassifier(readSession dbsession.ReadSession, planWeights map[string]int) Classifier {
+	return &planClassifier{
+		readSession: readSession,
+		planWeights: planWeights,
+	}
+}
+
+func (c *planClassifier) Classify(operationID string) (string, int) {
+	owner, err := c.readSession.GetOperationOwner(operationID)
+	if err != nil {
+		logrus.Warnf("Failed to get owner of operation %s, scheduling it with default weight: %s", operationID, err.Error())
+		return "", defaultWeight
+	}
+
+	return owner.Tenant, c.weight(owner)
+}
+
+func (c *planClassifier) weight(owner model.OperationOwner) int {
+	for _, plan := range []*string{owner.LicenceType, owner.Purpose} {
+		if plan == nil {
+			continue
+		}
+		if weight, ok := c.planWeights[*plan]; ok {
+			return weight
+		}
+	}
+
+	return defaultWeight
+}
+
+// ParsePlanWeights parses weights in the format "production=3,evaluation=1"
+func ParsePlanWeights(weights string) (map[string]int, error) {
+	planWeights := map[string]int{}
+	if weights == "" {
+		return planWeights, nil
+	}
+
+	for _, entry := range strings.Split(weights, ",") {
+		plan, value, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || plan == "" {
+			return nil, fmt.Errorf("invalid plan weight %q, expected plan=weight", entry)
+		}
+
+		weight, err := strconv.Atoi(value)
+		if err != nil || weight < 1 {
+			return nil, fmt.Errorf("invalid weight of plan %s: %q must be a positive integer", plan, value)
+		}
+		planWeights[plan] = weight
+	}
+
+	return planWeights, nil
+}
@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const operationID = "operation"
+
+func TestPlanClassifier_Classify(t *testing.T) {
+	planWeights := map[string]int{"production": 3, "PAYG": 2}
+
+	for _, testCase := range []struct {
+		description    string
+		owner          model.OperationOwner
+		expectedWeight int
+	}{
+		{
+			description:    "should weight by licence type",
+			owner:          model.OperationOwner{Tenant: "tenant", Purpose: util.PtrTo("production"), LicenceType: util.PtrTo("PAYG")},
+			expectedWeight: 2,
+		},
+		{
+			description:    "should weight by purpose if licence type has no weight",
+			owner:          model.OperationOwner{Tenant: "tenant", Purpose: util.PtrTo("production"), LicenceType: util.PtrTo("Trial")},
+			expectedWeight: 3,
+		},
+		{
+			description:    "should use default weight for unknown plan",
+			owner:          model.OperationOwner{Tenant: "tenant", Purpose: util.PtrTo("evaluation")},
+			expectedWeight: defaultWeight,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			readSession := &sessionMocks.ReadSession{}
+			readSession.On("GetOperationOwner", operationID).Return(testCase.owner, nil)
+
+			classifier := NewPlanClassifier(readSession, planWeights)
+
+			// when
+			tenant, weight := classifier.Classify(operationID)
+
+			// then
+			assert.Equal(t, "tenant", tenant)
+			assert.Equal(t, testCase.expectedWeight, weight)
+		})
+	}
+
+	t.Run("should use default weight when failed to get owner", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("GetOperationOwner", operationID).Return(model.OperationOwner{}, dberrors.Internal("error"))
+
+		classifier := NewPlanClassifier(readSession, planWeights)
+
+		// when
+		tenant, weight := classifier.Classify(operationID)
+
+		// then
+		assert.Empty(t, tenant)
+		assert.Equal(t, defaultWeight, weight)
+	})
+}
+
+func TestParsePlanWeights(t *testing.T) {
+	t.Run("should parse weights", func(t *testing.T) {
+		// when
+		weights, err := ParsePlanWeights("production=3, evaluation=1")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int{"production": 3, "evaluation": 1}, weights)
+	})
+
+	t.Run("should return empty weights", func(t *testing.T) {
+		// when
+		weights, err := ParsePlanWeights("")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, weights)
+	})
+
+	for _, weights := range []string{"production", "production=0", "=2", "production=high"} {
+		t.Run("should return error for "+weights, func(t *testing.T) {
+			// when
+			_, err := ParsePlanWeights(weights)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
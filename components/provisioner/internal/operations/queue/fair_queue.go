@@ -0,0 +1,216 @@
+package queue
+
+import (
+	"sync"
+	"time"
+)
+
+//go:generate mockery --name=Classifier
+type Classifier interface {
+	// Classify returns the tenant owning the operation and the number of consecutive operations the tenant can take in a single round
+	Classify(operationID string) (tenant string, weight int)
+}
+
+type FairQueue struct {
+	queue    *fairWorkQueue
+	executor Executor
+	workers  int
+}
+
+// NewFairQueue creates the queue which serves tenants in a round-robin manner, so that a burst of operations of one tenant does not delay operations of others
+func NewFairQueue(executor Executor, classifier Classifier, workers int) *FairQueue {
+	return &FairQueue{
+		queue:    newFairWorkQueue(classifier),
+		executor: executor,
+		workers:  workers,
+	}
+}
+
+func (q *FairQueue) Add(operationId string) {
+	q.queue.Add(operationId)
+}
+
+func (q *FairQueue) Run(stop <-chan struct{}) {
+	var waitGroup sync.WaitGroup
+
+	for i := 0; i < q.workers; i++ {
+		createWorker(q.queue, q.executor.Execute, stop, &waitGroup)
+	}
+
+	go func() {
+		<-stop
+		q.queue.ShutDown()
+	}()
+}
+
+type operationClass struct {
+	tenant string
+	weight int
+}
+
+// fairWorkQueue keeps the guarantees of the client-go workqueue: an operation is queued at most once and is never processed by two workers at the same time
+type fairWorkQueue struct {
+	classifier Classifier
+
+	lock sync.Mutex
+	cond *sync.Cond
+
+	// tenants with queued operations in the round-robin order
+	tenants []string
+	pending map[string][]string
+	classes map[string]operationClass
+
+	dirty      map[string]bool
+	processing map[string]bool
+
+	next         int
+	served       int
+	shuttingDown bool
+}
+
+func newFairWorkQueue(classifier Classifier) *fairWorkQueue {
+	q := &fairWorkQueue{
+		classifier: classifier,
+		pending:    map[string][]string{},
+		classes:    map[string]operationClass{},
+		dirty:      map[string]bool{},
+		processing: map[string]bool{},
+	}
+	q.cond = sync.NewCond(&q.lock)
+
+	return q
+}
+
+func (q *fairWorkQueue) Add(item interface{}) {
+	key := item.(string)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if _, ok := q.classes[key]; !ok {
+		// Classification may query the database, so it is done without holding the lock
+		q.lock.Unlock()
+		class := q.classify(key)
+		q.lock.Lock()
+
+		if _, ok := q.classes[key]; !ok {
+			q.classes[key] = class
+		}
+	}
+
+	if q.shuttingDown || q.dirty[key] {
+		return
+	}
+
+	q.dirty[key] = true
+	if q.processing[key] {
+		return
+	}
+
+	q.push(key)
+	q.cond.Signal()
+}
+
+func (q *fairWorkQueue) AddAfter(item interface{}, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+
+	time.AfterFunc(duration, func() {
+		q.Add(item)
+	})
+}
+
+func (q *fairWorkQueue) Get() (interface{}, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	for len(q.tenants) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if len(q.tenants) == 0 {
+		return nil, true
+	}
+
+	key := q.pop()
+
+	q.processing[key] = true
+	delete(q.dirty, key)
+
+	return key, false
+}
+
+func (q *fairWorkQueue) Done(item interface{}) {
+	key := item.(string)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	delete(q.processing, key)
+	if q.dirty[key] {
+		q.push(key)
+		q.cond.Signal()
+	}
+}
+
+func (q *fairWorkQueue) Forget(item interface{}) {
+	key := item.(string)
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if !q.dirty[key] {
+		delete(q.classes, key)
+	}
+}
+
+func (q *fairWorkQueue) ShutDown() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+func (q *fairWorkQueue) classify(key string) operationClass {
+	tenant, weight := q.classifier.Classify(key)
+	if weight < 1 {
+		weight = 1
+	}
+
+	return operationClass{tenant: tenant, weight: weight}
+}
+
+func (q *fairWorkQueue) push(key string) {
+	tenant := q.classes[key].tenant
+
+	if len(q.pending[tenant]) == 0 {
+		q.tenants = append(q.tenants, tenant)
+	}
+	q.pending[tenant] = append(q.pending[tenant], key)
+}
+
+func (q *fairWorkQueue) pop() string {
+	if q.next >= len(q.tenants) {
+		q.next = 0
+		q.served = 0
+	}
+
+	tenant := q.tenants[q.next]
+	key := q.pending[tenant][0]
+	q.pending[tenant] = q.pending[tenant][1:]
+	q.served++
+
+	if len(q.pending[tenant]) == 0 {
+		// Removing the tenant moves the next one to the current position
+		delete(q.pending, tenant)
+		q.tenants = append(q.tenants[:q.next], q.tenants[q.next+1:]...)
+		q.served = 0
+	} else if q.served >= q.classes[key].weight {
+		q.next++
+		q.served = 0
+	}
+
+	return key
+}
@@ -0,0 +1,223 @@
+package queue
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tenantPrefixClassifier takes the tenant from the operation ID in the format "tenant/operation"
+type tenantPrefixClassifier struct {
+	weights map[string]int
+}
+
+func (c tenantPrefixClassifier) Classify(operationID string) (string, int) {
+	tenant := strings.Split(operationID, "/")[0]
+	return tenant, c.weights[tenant]
+}
+
+func TestFairWorkQueue(t *testing.T) {
+	t.Run("should not make tenant wait behind burst of another tenant", func(t *testing.T) {
+		// given
+		queue := newFairWorkQueue(tenantPrefixClassifier{})
+
+		for i := 0; i < 200; i++ {
+			queue.Add(fmt.Sprintf("noisy/%d", i))
+		}
+		queue.Add("quiet/0")
+		queue.Add("other/0")
+
+		// when
+		processed := getAll(queue, 4)
+
+		// then
+		assert.Equal(t, []string{"noisy/0", "quiet/0", "other/0", "noisy/1"}, processed)
+	})
+
+	t.Run("should take as many operations in a round as tenant weight", func(t *testing.T) {
+		// given
+		queue := newFairWorkQueue(tenantPrefixClassifier{weights: map[string]int{"production": 3}})
+
+		for i := 0; i < 5; i++ {
+			queue.Add(fmt.Sprintf("production/%d", i))
+			queue.Add(fmt.Sprintf("trial/%d", i))
+		}
+
+		// when
+		processed := getAll(queue, 10)
+
+		// then
+		assert.Equal(t, []string{
+			"production/0", "production/1", "production/2", "trial/0",
+			"production/3", "production/4", "trial/1",
+			"trial/2", "trial/3", "trial/4",
+		}, processed)
+	})
+
+	t.Run("should not queue the same operation twice", func(t *testing.T) {
+		// given
+		queue := newFairWorkQueue(tenantPrefixClassifier{})
+
+		queue.Add("tenant/0")
+		queue.Add("tenant/0")
+		queue.Add("tenant/1")
+
+		// when
+		processed := getAll(queue, 2)
+
+		// then
+		assert.Equal(t, []string{"tenant/0", "tenant/1"}, processed)
+		assert.Empty(t, queue.tenants)
+	})
+
+	t.Run("should queue operation added while processing after it is done", func(t *testing.T) {
+		// given
+		queue := newFairWorkQueue(tenantPrefixClassifier{})
+
+		queue.Add("tenant/0")
+		item, _ := queue.Get()
+
+		// when
+		queue.Add("tenant/0")
+
+		// then
+		assert.Empty(t, queue.tenants)
+
+		queue.Done(item)
+		assert.Equal(t, []string{"tenant/0"}, getAll(queue, 1))
+	})
+
+	t.Run("should return shutdown after queue is shut down", func(t *testing.T) {
+		// given
+		queue := newFairWorkQueue(tenantPrefixClassifier{})
+
+		// when
+		queue.ShutDown()
+		queue.Add("tenant/0")
+		_, shutdown := queue.Get()
+
+		// then
+		assert.True(t, shutdown)
+	})
+}
+
+func TestFairQueue(t *testing.T) {
+	t.Run("should process operation of another tenant before burst is finished", func(t *testing.T) {
+		// given
+		executor := &recordingExecutor{}
+		queue := NewFairQueue(executor, tenantPrefixClassifier{}, 1)
+
+		for i := 0; i < 50; i++ {
+			queue.Add(fmt.Sprintf("noisy/%d", i))
+		}
+		queue.Add("quiet/0")
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Run(stop)
+
+		// then
+		require.Eventually(t, func() bool {
+			return executor.count() == 51
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Contains(t, executor.executed()[:2], "quiet/0")
+	})
+
+	t.Run("should requeue operation", func(t *testing.T) {
+		// given
+		executor := &recordingExecutor{requeue: map[string]bool{"tenant/0": true}}
+		queue := NewFairQueue(executor, tenantPrefixClassifier{}, 2)
+
+		queue.Add("tenant/0")
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		// when
+		queue.Run(stop)
+
+		// then
+		require.Eventually(t, func() bool {
+			return executor.count() == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, []string{"tenant/0", "tenant/0"}, executor.executed())
+	})
+}
+
+func getAll(queue *fairWorkQueue, count int) []string {
+	var processed []string
+
+	for i := 0; i < count; i++ {
+		item, _ := queue.Get()
+		processed = append(processed, item.(string))
+		queue.Forget(item)
+		queue.Done(item)
+	}
+
+	return processed
+}
+
+type recordingExecutor struct {
+	lock       sync.Mutex
+	operations []string
+	requeue    map[string]bool
+}
+
+func (e *recordingExecutor) Execute(operationID string) operations.ProcessingResult {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.operations = append(e.operations, operationID)
+
+	// Requeue only once so that the test finishes
+	if e.requeue[operationID] {
+		delete(e.requeue, operationID)
+		return operations.ProcessingResult{Requeue: true, Delay: 10 * time.Millisecond}
+	}
+
+	return operations.ProcessingResult{}
+}
+
+func (e *recordingExecutor) count() int {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return len(e.operations)
+}
+
+func (e *recordingExecutor) executed() []string {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return append([]string{}, e.operations...)
+}
+
+func TestSchedulingConfig_Validate(t *testing.T) {
+	t.Run("should accept at least one worker of each queue", func(t *testing.T) {
+		// given
+		config := SchedulingConfig{ProvisioningWorkers: 1, DeprovisioningWorkers: 1, ShootUpgradeWorkers: 1}
+
+		// then
+		require.NoError(t, config.Validate())
+	})
+
+	for _, config := range []SchedulingConfig{
+		{ProvisioningWorkers: 0, DeprovisioningWorkers: 5, ShootUpgradeWorkers: 5},
+		{ProvisioningWorkers: 5, DeprovisioningWorkers: -1, ShootUpgradeWorkers: 5},
+		{ProvisioningWorkers: 5, DeprovisioningWorkers: 5, ShootUpgradeWorkers: 0},
+	} {
+		t.Run(fmt.Sprintf("should reject workers %d/%d/%d", config.ProvisioningWorkers, config.DeprovisioningWorkers, config.ShootUpgradeWorkers), func(t *testing.T) {
+			require.Error(t, config.Validate())
+		})
+	}
+}
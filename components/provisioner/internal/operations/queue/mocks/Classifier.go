@@ -0,0 +1,48 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// Classifier is an autogenerated mock type for the Classifier type
+type Classifier struct {
+	mock.Mock
+}
+
+// Classify provides a mock function with given fields: operationID
+func (_m *Classifier) Classify(operationID string) (string, int) {
+	ret := _m.Called(operationID)
+
+	var r0 string
+	var r1 int
+	if rf, ok := ret.Get(0).(func(string) (string, int)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) int); ok {
+		r1 = rf(operationID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	return r0, r1
+}
+
+// NewClassifier creates a new instance of Classifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClassifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Classifier {
+	mock := &Classifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -16,23 +16,28 @@ type OperationQueue interface {
 	Run(stop <-chan struct{})
 }
 
-const (
-	workersAmount = 5
-)
-
 type Executor interface {
 	Execute(operationID string) operations.ProcessingResult
 }
 
+type workQueue interface {
+	Get() (interface{}, bool)
+	Done(item interface{})
+	Forget(item interface{})
+	AddAfter(item interface{}, duration time.Duration)
+}
+
 type Queue struct {
 	queue    workqueue.RateLimitingInterface
 	executor Executor
+	workers  int
 }
 
-func NewQueue(executor Executor) *Queue {
+func NewQueue(executor Executor, workers int) *Queue {
 	return &Queue{
 		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "operations"),
 		executor: executor,
+		workers:  workers,
 	}
 }
 
@@ -43,12 +48,12 @@ func (q *Queue) Add(operationId string) {
 func (q *Queue) Run(stop <-chan struct{}) {
 	var waitGroup sync.WaitGroup
 
-	for i := 0; i < workersAmount; i++ {
+	for i := 0; i < q.workers; i++ {
 		createWorker(q.queue, q.executor.Execute, stop, &waitGroup)
 	}
 }
 
-func createWorker(queue workqueue.RateLimitingInterface, process func(id string) operations.ProcessingResult, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
+func createWorker(queue workQueue, process func(id string) operations.ProcessingResult, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
 	waitGroup.Add(1)
 	go func() {
 		wait.Until(worker(queue, process), time.Second, stopCh)
@@ -56,7 +61,7 @@ func createWorker(queue workqueue.RateLimitingInterface, process func(id string)
 	}()
 }
 
-func worker(queue workqueue.RateLimitingInterface, process func(key string) operations.ProcessingResult) func() {
+func worker(queue workQueue, process func(key string) operations.ProcessingResult) func() {
 	return func() {
 		exit := false
 		for !exit {
@@ -1,6 +1,7 @@
 package queue
 
 import (
+	"fmt"
 	"time"
 
 	gardener_apis "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
@@ -35,6 +36,28 @@ type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
 }
 
+type SchedulingConfig struct {
+	ProvisioningWorkers   int    `envconfig:"default=5"`
+	DeprovisioningWorkers int    `envconfig:"default=5"`
+	ShootUpgradeWorkers   int    `envconfig:"default=5"`
+	PlanWeights           string `envconfig:"optional"`
+}
+
+// Validate rejects worker counts below 1, as the queue without workers would never process its operations
+func (c SchedulingConfig) Validate() error {
+	if c.ProvisioningWorkers < 1 {
+		return fmt.Errorf("provisioning workers must be at least 1, got %d", c.ProvisioningWorkers)
+	}
+	if c.DeprovisioningWorkers < 1 {
+		return fmt.Errorf("deprovisioning workers must be at least 1, got %d", c.DeprovisioningWorkers)
+	}
+	if c.ShootUpgradeWorkers < 1 {
+		return fmt.Errorf("shoot upgrade workers must be at least 1, got %d", c.ShootUpgradeWorkers)
+	}
+
+	return nil
+}
+
 //go:generate mockery --name=KubeconfigProvider
 type KubeconfigProvider interface {
 	FetchFromRequest(shootName string) ([]byte, error)
@@ -46,7 +69,9 @@ func CreateProvisioningQueue(
 	shootClient gardener_apis.ShootInterface,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
-	kubeconfigProvider KubeconfigProvider) OperationQueue {
+	kubeconfigProvider KubeconfigProvider,
+	workers int,
+	classifier Classifier) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation)
 	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
@@ -65,13 +90,15 @@ func CreateProvisioningQueue(
 		failure.NewNoopFailureHandler(),
 	)
 
-	return NewQueue(provisioningExecutor)
+	return NewFairQueue(provisioningExecutor, classifier, workers)
 }
 
 func CreateDeprovisioningQueue(
 	timeouts DeprovisioningTimeouts,
 	factory dbsession.Factory,
 	shootClient gardener_apis.ShootInterface,
+	workers int,
+	classifier Classifier,
 ) OperationQueue {
 
 	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, model.FinishedStage, timeouts.WaitingForClusterDeletion)
@@ -89,7 +116,7 @@ func CreateDeprovisioningQueue(
 		failure.NewNoopFailureHandler(),
 	)
 
-	return NewQueue(deprovisioningExecutor)
+	return NewFairQueue(deprovisioningExecutor, classifier, workers)
 }
 
 func CreateShootUpgradeQueue(
@@ -99,6 +126,8 @@ func CreateShootUpgradeQueue(
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	kubeconfigProvider KubeconfigProvider,
+	workers int,
+	classifier Classifier,
 ) OperationQueue {
 
 	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation)
@@ -118,5 +147,5 @@ func CreateShootUpgradeQueue(
 		failure.NewNoopFailureHandler(),
 	)
 
-	return NewQueue(upgradeClusterExecutor)
+	return NewFairQueue(upgradeClusterExecutor, classifier, workers)
 }
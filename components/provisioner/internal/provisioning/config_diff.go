@@ -0,0 +1,104 @@
+package provisioning
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/pkg/errors"
+)
+
+// resourceVersion changes with every update of the config, so it is not reported as a change
+var ignoredConfigFields = map[string]bool{
+	"resourceVersion": true,
+}
+
+// diffGardenerConfigs returns changed fields of the config sorted by their path. Nested objects are compared field by field, lists are compared as a whole.
+func diffGardenerConfigs(from, to *gqlschema.GardenerConfig) ([]*gqlschema.ConfigChange, error) {
+	fromFields, err := flattenConfig(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "while flattening source config")
+	}
+	toFields, err := flattenConfig(to)
+	if err != nil {
+		return nil, errors.Wrap(err, "while flattening target config")
+	}
+
+	paths := make([]string, 0, len(fromFields)+len(toFields))
+	for path := range fromFields {
+		paths = append(paths, path)
+	}
+	for path := range toFields {
+		if _, found := fromFields[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	changes := make([]*gqlschema.ConfigChange, 0)
+	for _, path := range paths {
+		if ignoredConfigFields[path] {
+			continue
+		}
+
+		fromValue, fromFound := fromFields[path]
+		toValue, toFound := toFields[path]
+		if fromFound && toFound && fromValue == toValue {
+			continue
+		}
+
+		change := &gqlschema.ConfigChange{Field: path}
+		if fromFound {
+			change.From = &fromValue
+		}
+		if toFound {
+			change.To = &toValue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func flattenConfig(config *gqlschema.GardenerConfig) (map[string]string, error) {
+	raw, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	flattened := map[string]string{}
+	return flattened, flattenFields("", fields, flattened)
+}
+
+func flattenFields(prefix string, fields map[string]interface{}, flattened map[string]string) error {
+	for name, value := range fields {
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		if value == nil {
+			continue
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok {
+			if err := flattenFields(path, nested, flattened); err != nil {
+				return err
+			}
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return errors.Wrapf(err, "while encoding %s", path)
+		}
+		flattened[path] = string(encoded)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package provisioning
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffGardenerConfigs(t *testing.T) {
+	from := &gqlschema.GardenerConfig{
+		KubernetesVersion: util.PtrTo("1.19"),
+		MachineType:       util.PtrTo("m5.xlarge"),
+		ProviderSpecificConfig: gqlschema.AWSProviderConfig{
+			VpcCidr: util.PtrTo("10.250.0.0/16"),
+		},
+		OidcConfig: &gqlschema.OIDCConfig{
+			ClientID:      "client",
+			SigningAlgs:   []string{"RS256"},
+			UsernameClaim: "sub",
+		},
+		ResourceVersion: util.PtrTo(1),
+	}
+
+	for _, testCase := range []struct {
+		description string
+		to          *gqlschema.GardenerConfig
+		expected    []*gqlschema.ConfigChange
+	}{
+		{
+			description: "should return no changes for equal configs except resource version",
+			to: &gqlschema.GardenerConfig{
+				KubernetesVersion:      from.KubernetesVersion,
+				MachineType:            from.MachineType,
+				ProviderSpecificConfig: from.ProviderSpecificConfig,
+				OidcConfig:             from.OidcConfig,
+				ResourceVersion:        util.PtrTo(2),
+			},
+			expected: []*gqlschema.ConfigChange{},
+		},
+		{
+			description: "should return changed, added and removed fields of nested objects",
+			to: &gqlschema.GardenerConfig{
+				KubernetesVersion: util.PtrTo("1.20"),
+				DiskType:          util.PtrTo("gp3"),
+				ProviderSpecificConfig: gqlschema.AWSProviderConfig{
+					VpcCidr: util.PtrTo("10.250.0.0/16"),
+				},
+				OidcConfig: &gqlschema.OIDCConfig{
+					ClientID:      "client",
+					SigningAlgs:   []string{"RS256", "ES256"},
+					UsernameClaim: "sub",
+				},
+			},
+			expected: []*gqlschema.ConfigChange{
+				{Field: "diskType", To: util.PtrTo(`"gp3"`)},
+				{Field: "kubernetesVersion", From: util.PtrTo(`"1.19"`), To: util.PtrTo(`"1.20"`)},
+				{Field: "machineType", From: util.PtrTo(`"m5.xlarge"`)},
+				{Field: "oidcConfig.signingAlgs", From: util.PtrTo(`["RS256"]`), To: util.PtrTo(`["RS256","ES256"]`)},
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			changes, err := diffGardenerConfigs(from, testCase.to)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, changes)
+		})
+	}
+}
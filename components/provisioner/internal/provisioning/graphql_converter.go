@@ -1,13 +1,20 @@
 package provisioning
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type GraphQLConverter interface {
 	RuntimeStatusToGraphQLStatus(status model.RuntimeStatus) *gqlschema.RuntimeStatus
 	OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus
+	AuditEventToGraphQLAuditEvent(event model.AuditEvent) *gqlschema.AuditEvent
+	TenantQuotaToGraphQLTenantQuota(quota model.TenantQuota) *gqlschema.TenantQuota
+	GardenerConfigRevisionToGraphQLRevision(revision model.GardenerConfigRevision) *gqlschema.RuntimeConfigRevision
 }
 
 func NewGraphQLConverter() GraphQLConverter {
@@ -21,9 +28,29 @@ func (c graphQLConverter) RuntimeStatusToGraphQLStatus(status model.RuntimeStatu
 		LastOperationStatus:     c.OperationStatusToGQLOperationStatus(status.LastOperationStatus),
 		RuntimeConnectionStatus: c.runtimeConnectionStatusToGraphQLStatus(status.RuntimeConnectionStatus),
 		RuntimeConfiguration:    c.clusterToToGraphQLRuntimeConfiguration(status.RuntimeConfiguration),
+		Drift:                   c.driftItemsToGraphQLDriftItems(status.Drift),
+		Description:             status.RuntimeConfiguration.Description,
+		Labels:                  status.RuntimeConfiguration.Labels,
 	}
 }
 
+func (c graphQLConverter) driftItemsToGraphQLDriftItems(items []model.DriftItem) []*gqlschema.RuntimeDriftItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	drift := make([]*gqlschema.RuntimeDriftItem, 0, len(items))
+	for _, item := range items {
+		drift = append(drift, &gqlschema.RuntimeDriftItem{
+			Field:       item.Field,
+			StoredValue: item.StoredValue,
+			ActualValue: item.ActualValue,
+			DetectedAt:  item.DetectedAt,
+		})
+	}
+	return drift
+}
+
 func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Operation) *gqlschema.OperationStatus {
 
 	return &gqlschema.OperationStatus{
@@ -37,6 +64,49 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 			Reason:     operation.Reason,
 			Component:  operation.Component,
 		},
+		SeedPlacement: operation.SeedPlacement,
+	}
+}
+
+func (c graphQLConverter) AuditEventToGraphQLAuditEvent(event model.AuditEvent) *gqlschema.AuditEvent {
+	return &gqlschema.AuditEvent{
+		ID:           event.ID,
+		Timestamp:    event.Timestamp,
+		Caller:       event.Caller,
+		UserAgent:    event.UserAgent,
+		Tenant:       event.Tenant,
+		SubAccountID: event.SubAccountID,
+		Mutation:     event.Mutation,
+		RuntimeID:    event.RuntimeID,
+		Input:        event.Input,
+		OperationID:  event.OperationID,
+		Outcome:      c.auditEventOutcomeToGraphQLOutcome(event.Outcome),
+		ErrMessage:   event.ErrMessage,
+	}
+}
+
+func (c graphQLConverter) TenantQuotaToGraphQLTenantQuota(quota model.TenantQuota) *gqlschema.TenantQuota {
+	return &gqlschema.TenantQuota{
+		Tenant: quota.Tenant,
+		Limits: &gqlschema.QuotaLimits{
+			Runtimes:             quota.Limits.Runtimes,
+			TotalMaxNodes:        quota.Limits.TotalMaxNodes,
+			ConcurrentOperations: quota.Limits.ConcurrentOperations,
+		},
+		Usage: &gqlschema.QuotaUsage{
+			Runtimes:             quota.Usage.Runtimes,
+			TotalMaxNodes:        quota.Usage.TotalMaxNodes,
+			ConcurrentOperations: quota.Usage.ConcurrentOperations,
+		},
+	}
+}
+
+func (c graphQLConverter) GardenerConfigRevisionToGraphQLRevision(revision model.GardenerConfigRevision) *gqlschema.RuntimeConfigRevision {
+	return &gqlschema.RuntimeConfigRevision{
+		Revision:          revision.Revision,
+		OperationID:       revision.OperationID,
+		CreationTimestamp: revision.CreationTimestamp,
+		ClusterConfig:     c.gardenerConfigToGraphQLConfig(revision.Config),
 	}
 }
 
@@ -91,6 +161,7 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		WorkerCidr:                          &config.WorkerCidr,
 		PodsCidr:                            config.PodsCIDR,
 		ServicesCidr:                        config.ServicesCIDR,
+		Networking:                          c.networkingToGraphQLConfig(config.Networking),
 		Region:                              &config.Region,
 		AutoScalerMin:                       &config.AutoScalerMin,
 		AutoScalerMax:                       &config.AutoScalerMax,
@@ -100,11 +171,15 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		EnableMachineImageVersionAutoUpdate: &config.EnableMachineImageVersionAutoUpdate,
 		ProviderSpecificConfig:              providerSpecificConfig,
 		OidcConfig:                          c.oidcConfigToGraphQLConfig(config.OIDCConfig),
+		KubeAPIServer:                       c.kubeAPIServerToGraphQLConfig(config.KubeAPIServer),
 		DNSConfig:                           c.dnsConfigToGraphQLConfig(config.DNSConfig),
 		ExposureClassName:                   config.ExposureClassName,
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
+		Extensions:                          c.extensionsToGraphQLConfig(config.Extensions),
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
 		EuAccess:                            &config.EuAccess,
+		ShootAndSeedSameRegion:              &config.ShootAndSeedSameRegion,
+		ResourceVersion:                     &config.ResourceVersion,
 	}
 }
 
@@ -122,6 +197,80 @@ func (c graphQLConverter) oidcConfigToGraphQLConfig(config *model.OIDCConfig) *g
 	}
 }
 
+// networkingToGraphQLConfig returns nil for the configs with the default networking, which is Calico with IPv4
+func (c graphQLConverter) networkingToGraphQLConfig(networking *model.NetworkingConfig) *gqlschema.Networking {
+	if networking == nil {
+		return nil
+	}
+	result := &gqlschema.Networking{
+		Type:       networking.NetworkingType(),
+		IPFamilies: networking.Families(),
+	}
+	if networking.ProviderConfig != nil {
+		result.ProviderConfig = util.PtrTo(string(networking.ProviderConfig))
+	}
+	return result
+}
+
+func (c graphQLConverter) kubeAPIServerToGraphQLConfig(kubeAPIServer *model.KubeAPIServerConfig) *gqlschema.KubeAPIServer {
+	if kubeAPIServer == nil {
+		return nil
+	}
+
+	result := &gqlschema.KubeAPIServer{
+		AuditPolicyConfigMap: kubeAPIServer.AuditPolicyConfigMap,
+	}
+	// The maps are returned in the order of the keys, so that the response is stable
+	for _, name := range slices.Sorted(maps.Keys(kubeAPIServer.FeatureGates)) {
+		result.FeatureGates = append(result.FeatureGates, &gqlschema.FeatureGate{Name: name, Enabled: kubeAPIServer.FeatureGates[name]})
+	}
+	for _, apiGroupVersion := range slices.Sorted(maps.Keys(kubeAPIServer.RuntimeConfig)) {
+		result.RuntimeConfig = append(result.RuntimeConfig, &gqlschema.RuntimeConfigEntry{APIGroupVersion: apiGroupVersion, Enabled: kubeAPIServer.RuntimeConfig[apiGroupVersion]})
+	}
+	if requests := kubeAPIServer.Requests; requests != nil {
+		result.Requests = &gqlschema.KubeAPIServerRequests{
+			MaxNonMutatingInflight: requests.MaxNonMutatingInflight,
+			MaxMutatingInflight:    requests.MaxMutatingInflight,
+		}
+	}
+	if serviceAccount := kubeAPIServer.ServiceAccount; serviceAccount != nil {
+		result.ServiceAccount = &gqlschema.ServiceAccount{
+			Issuer:                serviceAccount.Issuer,
+			ExtendTokenExpiration: serviceAccount.ExtendTokenExpiration,
+		}
+	}
+	if watchCacheSizes := kubeAPIServer.WatchCacheSizes; watchCacheSizes != nil {
+		result.WatchCacheSizes = &gqlschema.WatchCacheSizes{DefaultSize: watchCacheSizes.Default}
+		for _, resource := range watchCacheSizes.Resources {
+			result.WatchCacheSizes.Resources = append(result.WatchCacheSizes.Resources, &gqlschema.ResourceWatchCacheSize{
+				APIGroup: resource.APIGroup,
+				Resource: resource.Resource,
+				Size:     resource.Size,
+			})
+		}
+	}
+	return result
+}
+
+func (c graphQLConverter) extensionsToGraphQLConfig(extensions []model.Extension) []*gqlschema.Extension {
+	if extensions == nil {
+		return nil
+	}
+	result := make([]*gqlschema.Extension, 0, len(extensions))
+	for _, extension := range extensions {
+		var providerConfig *string
+		if extension.ProviderConfig != nil {
+			providerConfig = util.PtrTo(string(extension.ProviderConfig))
+		}
+		result = append(result, &gqlschema.Extension{
+			Type:           extension.Type,
+			Disabled:       extension.Disabled,
+			ProviderConfig: providerConfig,
+		})
+	}
+	return result
+}
+
 func (c graphQLConverter) kymaConfigToGraphQLConfig(config model.KymaConfig) *gqlschema.KymaConfig {
 	var components []*gqlschema.ComponentConfiguration
 	for _, cmp := range config.Components {
@@ -195,6 +344,17 @@ func (c graphQLConverter) operationStateToGraphQLState(state model.OperationStat
 	}
 }
 
+func (c graphQLConverter) auditEventOutcomeToGraphQLOutcome(outcome model.AuditEventOutcome) gqlschema.AuditEventOutcome {
+	switch outcome {
+	case model.AuditEventSucceeded:
+		return gqlschema.AuditEventOutcomeSucceeded
+	case model.AuditEventFailed:
+		return gqlschema.AuditEventOutcomeFailed
+	default:
+		return ""
+	}
+}
+
 func (c graphQLConverter) profileToGraphQLProfile(profile *model.KymaProfile) *gqlschema.KymaProfile {
 
 	if profile == nil {
@@ -141,9 +141,12 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:        &controlPlaneFailureTolerance,
 					EuAccess:                            euAccess,
+					ResourceVersion:                     1,
 				},
-				Kubeconfig: &kubeconfig,
-				KymaConfig: fixKymaConfig(nil),
+				Kubeconfig:  &kubeconfig,
+				KymaConfig:  fixKymaConfig(nil),
+				Labels:      map[string]interface{}{"team": "core"},
+				Description: util.PtrTo("Runtime of the core team"),
 			},
 		}
 
@@ -213,10 +216,14 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled: &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:  &controlPlaneFailureTolerance,
 					EuAccess:                      &euAccess,
+					ShootAndSeedSameRegion:        util.PtrTo(false),
+					ResourceVersion:               util.PtrTo(1),
 				},
 				KymaConfig: fixKymaGraphQLConfig(nil),
 				Kubeconfig: &kubeconfig,
 			},
+			Labels:      gqlschema.Labels{"team": "core"},
+			Description: util.PtrTo("Runtime of the core team"),
 		}
 
 		//when
@@ -302,6 +309,7 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:        &controlPlaneFailureTolerance,
 					EuAccess:                            euAccess,
+					ResourceVersion:                     1,
 				},
 				Kubeconfig: &kubeconfig,
 			},
@@ -362,6 +370,8 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled: &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:  &controlPlaneFailureTolerance,
 					EuAccess:                      &euAccess,
+					ShootAndSeedSameRegion:        util.PtrTo(false),
+					ResourceVersion:               util.PtrTo(1),
 				},
 				Kubeconfig: &kubeconfig,
 			},
@@ -449,6 +459,7 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:        &controlPlaneFailureTolerance,
 					EuAccess:                            euAccess,
+					ResourceVersion:                     1,
 				},
 				Kubeconfig: &kubeconfig,
 				KymaConfig: fixKymaConfig(&modelProductionProfile),
@@ -498,6 +509,8 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 					ShootNetworkingFilterDisabled:       &shootNetworkingFilterDisabled,
 					ControlPlaneFailureTolerance:        &controlPlaneFailureTolerance,
 					EuAccess:                            &euAccess,
+					ShootAndSeedSameRegion:              util.PtrTo(false),
+					ResourceVersion:                     util.PtrTo(1),
 					ProviderSpecificConfig: gqlschema.AzureProviderConfig{
 						VnetCidr: util.PtrTo("10.10.11.11/255"),
 						Zones:    nil, // Expected empty when no zones specified in input.
@@ -631,3 +644,31 @@ func fixKymaComponents() []model.KymaComponentConfig {
 		},
 	}
 }
+
+func TestKubeAPIServerToGraphQLConfig(t *testing.T) {
+	// given
+	converter := graphQLConverter{}
+	kubeAPIServer := &model.KubeAPIServerConfig{
+		FeatureGates:         map[string]bool{"ValidatingAdmissionPolicy": true, "InPlacePodVerticalScaling": false},
+		RuntimeConfig:        map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+		ServiceAccount:       &model.ServiceAccountConfig{ExtendTokenExpiration: util.PtrTo(false)},
+		WatchCacheSizes:      &model.WatchCacheSizes{Resources: []model.ResourceWatchCacheSize{{Resource: "secrets", Size: 500}}},
+		AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+	}
+
+	// when
+	result := converter.kubeAPIServerToGraphQLConfig(kubeAPIServer)
+
+	// then
+	assert.Equal(t, &gqlschema.KubeAPIServer{
+		FeatureGates: []*gqlschema.FeatureGate{
+			{Name: "InPlacePodVerticalScaling", Enabled: false},
+			{Name: "ValidatingAdmissionPolicy", Enabled: true},
+		},
+		RuntimeConfig:        []*gqlschema.RuntimeConfigEntry{{APIGroupVersion: "admissionregistration.k8s.io/v1beta1", Enabled: true}},
+		ServiceAccount:       &gqlschema.ServiceAccount{ExtendTokenExpiration: util.PtrTo(false)},
+		WatchCacheSizes:      &gqlschema.WatchCacheSizes{Resources: []*gqlschema.ResourceWatchCacheSize{{Resource: "secrets", Size: 500}}},
+		AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+	}, result)
+	assert.Nil(t, converter.kubeAPIServerToGraphQLConfig(nil))
+}
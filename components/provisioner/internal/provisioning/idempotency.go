@@ -0,0 +1,118 @@
+package provisioning
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	provisionRuntimeMutation = "provisionRuntime"
+	upgradeShootMutation     = "upgradeShoot"
+)
+
+// newIdempotencyKey returns nil if the key was not provided with the request
+func newIdempotencyKey(tenant string, key *string, mutation string, request interface{}) (*model.IdempotencyKey, apperrors.AppError) {
+	if key == nil || *key == "" {
+		return nil, nil
+	}
+
+	requestJSON, err := json.Marshal(request)
+	if err != nil {
+		return nil, apperrors.Internal("Failed to calculate hash of the %s request: %s", mutation, err.Error())
+	}
+	requestHash := sha256.Sum256(requestJSON)
+
+	return &model.IdempotencyKey{
+		Tenant:      tenant,
+		Key:         *key,
+		Mutation:    mutation,
+		RequestHash: hex.EncodeToString(requestHash[:]),
+		CreatedAt:   time.Now(),
+	}, nil
+}
+
+func upgradeShootIdempotencyKey(session dbsession.ReadSession, runtimeID string, input gqlschema.UpgradeShootInput, key *string) (*model.IdempotencyKey, apperrors.AppError) {
+	if key == nil || *key == "" {
+		return nil, nil
+	}
+
+	tenant, dberr := session.GetTenant(runtimeID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get tenant for idempotency key")
+	}
+
+	return newIdempotencyKey(tenant, key, upgradeShootMutation, struct {
+		RuntimeID string
+		Input     gqlschema.UpgradeShootInput
+	}{runtimeID, input})
+}
+
+// findIdempotentOperation returns status of the operation started by the request with the same idempotency key, or nil if there was no such request
+func (r *service) findIdempotentOperation(idempotencyKey *model.IdempotencyKey) (*gqlschema.OperationStatus, apperrors.AppError) {
+	if idempotencyKey == nil {
+		return nil, nil
+	}
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	storedKey, dberr := session.GetIdempotencyKey(idempotencyKey.Tenant, idempotencyKey.Key)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return nil, nil
+		}
+		return nil, dberr.Append("failed to get idempotency key")
+	}
+
+	if storedKey.Mutation != idempotencyKey.Mutation || storedKey.RequestHash != idempotencyKey.RequestHash {
+		return nil, apperrors.BadRequest("idempotency key %s was already used with a different %s request", idempotencyKey.Key, storedKey.Mutation)
+	}
+
+	operation, dberr := session.GetOperation(storedKey.OperationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation for idempotency key %s", idempotencyKey.Key)
+	}
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+// findIdempotentOperationAfterLock looks the key up again once the lock taken by the original request is released,
+// so that a concurrent retry returns status of the original operation instead of failing on the quota or operation in progress
+func (r *service) findIdempotentOperationAfterLock(idempotencyKey *model.IdempotencyKey, lock func() dberrors.Error) (*gqlschema.OperationStatus, apperrors.AppError) {
+	if idempotencyKey == nil {
+		return nil, nil
+	}
+
+	dberr := lock()
+	if dberr != nil {
+		return nil, dberr.Append("failed to lock before checking idempotency key")
+	}
+
+	return r.findIdempotentOperation(idempotencyKey)
+}
+
+// storeIdempotencyKey returns status of the operation started by a concurrent request with the same idempotency key if it was stored first
+func (r *service) storeIdempotencyKey(session dbsession.WriteSession, idempotencyKey *model.IdempotencyKey, operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	if idempotencyKey == nil {
+		return nil, nil
+	}
+
+	idempotencyKey.OperationID = operationID
+
+	dberr := session.InsertIdempotencyKey(*idempotencyKey)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeAlreadyExists {
+			return r.findIdempotentOperation(idempotencyKey)
+		}
+		return nil, dberr.Append("failed to store idempotency key")
+	}
+
+	return nil, nil
+}
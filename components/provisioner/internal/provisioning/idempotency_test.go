@@ -0,0 +1,492 @@
+package provisioning
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	cloudProfileMocks "github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
+	mocks2 "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/inmemory"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/quota"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
+	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	idempotencyKey = "c6d2a5b4-3c7e-4b8e-a0f7-5d1d3f0a9e21"
+
+	schemaFilePath = "../../assets/database/provisioner.sql"
+	secretKey      = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+)
+
+func TestService_ProvisionRuntime_IdempotencyKey(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	storedKey, err := newIdempotencyKey(tenant, util.PtrTo(idempotencyKey), provisionRuntimeMutation, struct {
+		Config     gqlschema.ProvisionRuntimeInput
+		SubAccount string
+	}{fixIdempotentProvisionRuntimeInput(), subAccountId})
+	require.NoError(t, err)
+	storedKey.OperationID = operationID
+
+	originalOperation := model.Operation{
+		ID:        operationID,
+		Type:      model.Provision,
+		State:     model.InProgress,
+		ClusterID: runtimeID,
+	}
+
+	t.Run("Should store idempotency key with operation ID", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(model.IdempotencyKey{}, dberrors.NotFound("not found"))
+		uuidGeneratorMock.On("New").Return(runtimeID).Once()
+		uuidGeneratorMock.On("New").Return(operationID)
+		writeSessionWithinTransactionMock.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.AnythingOfType("model.Cluster")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertIdempotencyKey", mock.MatchedBy(func(key model.IdempotencyKey) bool {
+			return key.Tenant == tenant && key.Key == idempotencyKey && key.RequestHash == storedKey.RequestHash && key.OperationID == operationID
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.AnythingOfType("model.Cluster"), operationID).Return(nil)
+		provisioningQueue.On("Add", operationID).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should compute key from input with zone allocation and provision allocated zones", func(t *testing.T) {
+		// given
+		newInput := func() gqlschema.ProvisionRuntimeInput {
+			input := fixIdempotentProvisionRuntimeInput()
+			input.ClusterConfig.GardenerConfig.ProviderSpecificConfig = &gqlschema.ProviderSpecificInput{
+				AwsConfig: &gqlschema.AWSProviderConfigInput{
+					VpcCidr:           "10.250.0.0/16",
+					AwsZoneAllocation: &gqlschema.AWSZoneAllocationInput{Names: []string{"eu-central-1a"}},
+				},
+			}
+			return input
+		}
+		input := newInput()
+
+		allocationKey, err := newIdempotencyKey(tenant, util.PtrTo(idempotencyKey), provisionRuntimeMutation, struct {
+			Config     gqlschema.ProvisionRuntimeInput
+			SubAccount string
+		}{newInput(), subAccountId})
+		require.NoError(t, err)
+
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(model.IdempotencyKey{}, dberrors.NotFound("not found"))
+		uuidGeneratorMock.On("New").Return(runtimeID).Once()
+		uuidGeneratorMock.On("New").Return(operationID)
+		writeSessionWithinTransactionMock.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.AnythingOfType("model.Cluster")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertIdempotencyKey", mock.MatchedBy(func(key model.IdempotencyKey) bool {
+			return key.RequestHash == allocationKey.RequestHash
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.MatchedBy(func(cluster model.Cluster) bool {
+			return strings.Contains(cluster.ClusterConfig.GardenerProviderConfig.RawJSON(), `"workerCidr":"10.250.0.0/19"`)
+		}), operationID).Return(nil)
+		provisioningQueue.On("Add", operationID).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.ProvisionRuntime(input, tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		assert.Equal(t, newInput().ClusterConfig.GardenerConfig.ProviderSpecificConfig, input.ClusterConfig.GardenerConfig.ProviderSpecificConfig)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should compute key from input with omitted version and provision completed version", func(t *testing.T) {
+		// given
+		input := fixIdempotentProvisionRuntimeInput()
+
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+		cloudProfiles := &cloudProfileMocks.Provider{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(model.IdempotencyKey{}, dberrors.NotFound("not found"))
+		uuidGeneratorMock.On("New").Return(runtimeID).Once()
+		uuidGeneratorMock.On("New").Return(operationID)
+		cloudProfiles.On("CloudProfile", "gcp").Return(&gardener_types.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+			Spec: gardener_types.CloudProfileSpec{
+				Kubernetes: gardener_types.KubernetesSettings{
+					Versions: []gardener_types.ExpirableVersion{{Version: "1.16.7"}},
+				},
+			},
+		}, nil)
+		writeSessionWithinTransactionMock.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.AnythingOfType("model.Cluster")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertIdempotencyKey", mock.MatchedBy(func(key model.IdempotencyKey) bool {
+			return key.RequestHash == storedKey.RequestHash
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.MatchedBy(func(cluster model.Cluster) bool {
+			return cluster.ClusterConfig.KubernetesVersion == "1.16.7"
+		}), operationID).Return(nil)
+		provisioningQueue.On("Add", operationID).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, cloudProfiles)
+
+		// when
+		status, err := service.ProvisionRuntime(input, tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		assert.Equal(t, "1.16", input.ClusterConfig.GardenerConfig.KubernetesVersion)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should return original operation status when request is repeated", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil)
+		readSession.On("GetOperation", operationID).Return(originalOperation, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		assert.Equal(t, runtimeID, *status.RuntimeID)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return bad request when key is reused with different input", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), tenant, "other-sub-account", util.PtrTo(idempotencyKey))
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return operation of concurrent request which committed the key while waiting for tenant lock", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(model.IdempotencyKey{}, dberrors.NotFound("not found")).Once()
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil).Once()
+		readSession.On("GetOperation", operationID).Return(originalOperation, nil)
+		uuidGeneratorMock.On("New").Return("4f4c8b39-8b5e-4d5c-9d9a-4f0a6c8d2e11")
+		writeSessionWithinTransactionMock.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGeneratorMock, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+	})
+
+	t.Run("Should return operation of concurrent request which stored the key first", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(model.IdempotencyKey{}, dberrors.NotFound("not found")).Twice()
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil).Once()
+		readSession.On("GetOperation", operationID).Return(originalOperation, nil)
+		uuidGeneratorMock.On("New").Return("4f4c8b39-8b5e-4d5c-9d9a-4f0a6c8d2e11")
+		writeSessionWithinTransactionMock.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.AnythingOfType("model.Cluster")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertIdempotencyKey", mock.AnythingOfType("model.IdempotencyKey")).Return(dberrors.AlreadyExists("already exists"))
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGeneratorMock, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), tenant, subAccountId, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+	})
+}
+
+func TestService_ProvisionRuntime_ConcurrentIdempotencyKey(t *testing.T) {
+	t.Run("in memory", func(t *testing.T) {
+		testConcurrentIdempotentProvisioning(t, inmemory.NewFactory())
+	})
+
+	t.Run("postgres", func(t *testing.T) {
+		ctx := context.Background()
+
+		containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		connection, err := database.InitializeDatabaseConnection(connString, 5)
+		require.NoError(t, err)
+		defer testutils.CloseDatabase(t, connection)
+
+		err = database.SetupSchema(connection, schemaFilePath)
+		require.NoError(t, err)
+
+		keyProvider, err := dbsession.NewStaticKeyProvider(secretKey, nil)
+		require.NoError(t, err)
+
+		factory, err := dbsession.NewFactory(connection, keyProvider)
+		require.NoError(t, err)
+
+		testConcurrentIdempotentProvisioning(t, factory)
+	})
+}
+
+// testConcurrentIdempotentProvisioning sends a retry while the original request holds the tenant lock,
+// the quota allows a single Runtime, so the retry succeeds only if it returns the operation of the original request
+func testConcurrentIdempotentProvisioning(t *testing.T, factory dbsession.Factory) {
+	// given
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	quotaChecker := quota.NewChecker(model.QuotaLimits{Runtimes: util.PtrTo(1)}, factory)
+	provisioner := &mocks2.Provisioner{}
+	provisioningQueue := &mocks.OperationQueue{}
+
+	quotaTenant := "tenant-" + uuid.NewUUIDGenerator().New()
+	provisioningStarted := make(chan struct{})
+	releaseProvisioning := make(chan struct{})
+
+	provisioner.On("ProvisionCluster", mock.AnythingOfType("model.Cluster"), mock.AnythingOfType("string")).Run(func(mock.Arguments) {
+		close(provisioningStarted)
+		<-releaseProvisioning
+	}).Return(nil).Once()
+	provisioningQueue.On("Add", mock.AnythingOfType("string")).Return().Once()
+
+	service := NewProvisioningService(inputConverter, NewGraphQLConverter(), factory, provisioner, uuid.NewUUIDGenerator(), nil, provisioningQueue, nil, nil, nil, quotaChecker, nil, nil, nil)
+
+	provision := func(result chan<- *gqlschema.OperationStatus) {
+		status, err := service.ProvisionRuntime(fixIdempotentProvisionRuntimeInput(), quotaTenant, subAccountId, util.PtrTo(idempotencyKey))
+		assert.NoError(t, err)
+		result <- status
+	}
+
+	// when
+	original := make(chan *gqlschema.OperationStatus, 1)
+	go provision(original)
+	<-provisioningStarted
+
+	retry := make(chan *gqlschema.OperationStatus, 1)
+	go provision(retry)
+
+	// Give the retry time to block on the tenant lock held by the original request
+	time.Sleep(100 * time.Millisecond)
+	close(releaseProvisioning)
+
+	originalStatus := <-original
+	retryStatus := <-retry
+
+	// then
+	require.NotNil(t, originalStatus)
+	require.NotNil(t, retryStatus)
+	assert.Equal(t, *originalStatus.ID, *retryStatus.ID)
+	assert.Equal(t, *originalStatus.RuntimeID, *retryStatus.RuntimeID)
+	provisioner.AssertExpectations(t)
+	provisioningQueue.AssertExpectations(t)
+}
+
+func TestService_UpgradeGardenerShoot_IdempotencyKey(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	upgradeShootInput := gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			KubernetesVersion: util.PtrTo("1.20.7"),
+		},
+	}
+
+	storedKey, err := newIdempotencyKey(tenant, util.PtrTo(idempotencyKey), upgradeShootMutation, struct {
+		RuntimeID string
+		Input     gqlschema.UpgradeShootInput
+	}{runtimeID, upgradeShootInput})
+	require.NoError(t, err)
+	storedKey.OperationID = operationID
+
+	t.Run("Should return original operation status while upgrade is in progress", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenant", runtimeID).Return(tenant, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil)
+		readSession.On("GetOperation", operationID).Return(model.Operation{
+			ID:        operationID,
+			Type:      model.UpgradeShoot,
+			State:     model.InProgress,
+			ClusterID: runtimeID,
+		}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput, util.PtrTo(idempotencyKey))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationID, *status.ID)
+		assert.Equal(t, gqlschema.OperationTypeUpgradeShoot, status.Operation)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return bad request when key is reused with different input", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenant", runtimeID).Return(tenant, nil)
+		readSession.On("GetIdempotencyKey", tenant, idempotencyKey).Return(*storedKey, nil)
+
+		differentInput := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				KubernetesVersion: util.PtrTo("1.21.0"),
+			},
+		}
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.UpgradeGardenerShoot(runtimeID, differentInput, util.PtrTo(idempotencyKey))
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
+// fixIdempotentProvisionRuntimeInput returns new input on every call, because input is converted in place
+func fixIdempotentProvisionRuntimeInput() gqlschema.ProvisionRuntimeInput {
+	return gqlschema.ProvisionRuntimeInput{
+		RuntimeInput: &gqlschema.RuntimeInput{
+			Name:        runtimeName,
+			Description: new(string),
+			Labels:      gqlschema.Labels{},
+		},
+		ClusterConfig: &gqlschema.ClusterConfigInput{
+			GardenerConfig: &gqlschema.GardenerConfigInput{
+				KubernetesVersion: "1.16",
+				ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+					GcpConfig: &gqlschema.GCPProviderConfigInput{},
+				},
+				OidcConfig: oidcInput(),
+				DNSConfig:  dnsInput(),
+			},
+		},
+	}
+}
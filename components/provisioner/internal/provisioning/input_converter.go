@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
@@ -29,7 +30,8 @@ func NewInputConverter(
 	gardenerProject string,
 	defaultEnableKubernetesVersionAutoUpdate,
 	defaultEnableMachineImageVersionAutoUpdate bool,
-	defaultEnableIMDSv2 bool) InputConverter {
+	defaultEnableIMDSv2 bool,
+	extensionsConfig extensions.Config) InputConverter {
 
 	return &converter{
 		uuidGenerator:                                    uuidGenerator,
@@ -39,6 +41,7 @@ func NewInputConverter(
 		defaultProvisioningShootNetworkingFilterDisabled: true,
 		defaultEuAccess:                                  false,
 		defaultEnableIMDSv2:                              defaultEnableIMDSv2,
+		extensionsConfig:                                 extensionsConfig,
 	}
 }
 
@@ -50,6 +53,7 @@ type converter struct {
 	defaultProvisioningShootNetworkingFilterDisabled bool
 	defaultEuAccess                                  bool
 	defaultEnableIMDSv2                              bool
+	extensionsConfig                                 extensions.Config
 }
 
 func (c converter) ProvisioningInputToCluster(runtimeID string, input gqlschema.ProvisionRuntimeInput, tenant, subAccountId string) (model.Cluster, apperrors.AppError) {
@@ -70,7 +74,9 @@ func (c converter) ProvisioningInputToCluster(runtimeID string, input gqlschema.
 	}
 
 	if input.ClusterConfig.GardenerConfig.ShootNetworkingFilterDisabled == nil {
-		input.ClusterConfig.GardenerConfig.ShootNetworkingFilterDisabled = util.PtrTo(c.defaultProvisioningShootNetworkingFilterDisabled)
+		input.ClusterConfig.GardenerConfig.ShootNetworkingFilterDisabled = util.OkOrDefault(
+			networkingFilterDisabledFromInput(input.ClusterConfig.GardenerConfig.Extensions),
+			util.PtrTo(c.defaultProvisioningShootNetworkingFilterDisabled))
 	}
 
 	if strings.ToLower(input.ClusterConfig.GardenerConfig.Provider) == "openstack" {
@@ -91,6 +97,8 @@ func (c converter) ProvisioningInputToCluster(runtimeID string, input gqlschema.
 		Tenant:         tenant,
 		SubAccountId:   &subAccountId,
 		Administrators: input.ClusterConfig.Administrators,
+		Description:    runtimeDescription(input.RuntimeInput),
+		Labels:         runtimeLabels(input.RuntimeInput),
 	}, nil
 }
 
@@ -100,6 +108,11 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		return model.GardenerConfig{}, err
 	}
 
+	resolvedExtensions, err := c.extensionsConfig.Resolve(c.extensionsConfig.Defaults, extensionsFromInput(input.Extensions))
+	if err != nil {
+		return model.GardenerConfig{}, err
+	}
+
 	id := c.uuidGenerator.New()
 	return model.GardenerConfig{
 		ID:                                  id,
@@ -118,6 +131,7 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		WorkerCidr:                          input.WorkerCidr,
 		PodsCIDR:                            input.PodsCidr,
 		ServicesCIDR:                        input.ServicesCidr,
+		Networking:                          networkingFromInput(input.Networking),
 		AutoScalerMin:                       input.AutoScalerMin,
 		AutoScalerMax:                       input.AutoScalerMax,
 		MaxSurge:                            input.MaxSurge,
@@ -129,11 +143,14 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ClusterID:                           runtimeID,
 		GardenerProviderConfig:              providerSpecificConfig,
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
+		KubeAPIServer:                       kubeAPIServerFromInput(input.KubeAPIServer),
 		DNSConfig:                           dnsConfigFromInput(input.DNSConfig),
 		ExposureClassName:                   input.ExposureClassName,
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
+		Extensions:                          resolvedExtensions,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
 		EuAccess:                            util.UnwrapOrDefault(input.EuAccess, c.defaultEuAccess),
+		ShootAndSeedSameRegion:              util.UnwrapOrZero(input.ShootAndSeedSameRegion),
 	}, nil
 }
 
@@ -175,6 +192,141 @@ func dnsConfigFromInput(input *gqlschema.DNSConfigInput) *model.DNSConfig {
 	return nil
 }
 
+func networkingFromInput(input *gqlschema.NetworkingInput) *model.NetworkingConfig {
+	if input == nil {
+		return nil
+	}
+	networking := &model.NetworkingConfig{
+		Type:       util.UnwrapOrZero(input.Type),
+		IPFamilies: input.IPFamilies,
+	}
+	if input.ProviderConfig != nil {
+		networking.ProviderConfig = []byte(*input.ProviderConfig)
+	}
+	return networking
+}
+
+// upgradedNetworking overrides the networking of the config with the fields set in the input. The change of the type and the IP families is rejected when the upgrade is validated against the shoot.
+func upgradedNetworking(input *gqlschema.NetworkingInput, config *model.NetworkingConfig) *model.NetworkingConfig {
+	if input == nil {
+		return config
+	}
+
+	networking := model.NetworkingConfig{}
+	if config != nil {
+		networking = *config
+	}
+	if input.Type != nil {
+		networking.Type = *input.Type
+	}
+	if input.IPFamilies != nil {
+		networking.IPFamilies = input.IPFamilies
+	}
+	if input.ProviderConfig != nil {
+		networking.ProviderConfig = []byte(*input.ProviderConfig)
+	}
+	return &networking
+}
+
+func kubeAPIServerFromInput(input *gqlschema.KubeAPIServerInput) *model.KubeAPIServerConfig {
+	if input == nil {
+		return nil
+	}
+	return upgradedKubeAPIServer(input, nil)
+}
+
+// upgradedKubeAPIServer overrides the settings of the config with the settings set in the input. The feature gates, the runtime config, and the watch cache sizes are replaced as a whole.
+func upgradedKubeAPIServer(input *gqlschema.KubeAPIServerInput, config *model.KubeAPIServerConfig) *model.KubeAPIServerConfig {
+	if input == nil {
+		return config
+	}
+
+	kubeAPIServer := model.KubeAPIServerConfig{}
+	if config != nil {
+		kubeAPIServer = *config
+	}
+	if input.FeatureGates != nil {
+		kubeAPIServer.FeatureGates = make(map[string]bool, len(input.FeatureGates))
+		for _, featureGate := range input.FeatureGates {
+			kubeAPIServer.FeatureGates[featureGate.Name] = featureGate.Enabled
+		}
+	}
+	if input.RuntimeConfig != nil {
+		kubeAPIServer.RuntimeConfig = make(map[string]bool, len(input.RuntimeConfig))
+		for _, entry := range input.RuntimeConfig {
+			kubeAPIServer.RuntimeConfig[entry.APIGroupVersion] = entry.Enabled
+		}
+	}
+	if input.Requests != nil {
+		kubeAPIServer.Requests = &model.KubeAPIServerRequests{
+			MaxNonMutatingInflight: input.Requests.MaxNonMutatingInflight,
+			MaxMutatingInflight:    input.Requests.MaxMutatingInflight,
+		}
+	}
+	if input.ServiceAccount != nil {
+		kubeAPIServer.ServiceAccount = &model.ServiceAccountConfig{
+			Issuer:                input.ServiceAccount.Issuer,
+			ExtendTokenExpiration: input.ServiceAccount.ExtendTokenExpiration,
+		}
+	}
+	if input.WatchCacheSizes != nil {
+		kubeAPIServer.WatchCacheSizes = &model.WatchCacheSizes{Default: input.WatchCacheSizes.DefaultSize}
+		for _, resource := range input.WatchCacheSizes.Resources {
+			kubeAPIServer.WatchCacheSizes.Resources = append(kubeAPIServer.WatchCacheSizes.Resources, model.ResourceWatchCacheSize{
+				APIGroup: resource.APIGroup,
+				Resource: resource.Resource,
+				Size:     resource.Size,
+			})
+		}
+	}
+	if input.AuditPolicyConfigMap != nil {
+		kubeAPIServer.AuditPolicyConfigMap = input.AuditPolicyConfigMap
+	}
+	return &kubeAPIServer
+}
+
+func extensionsFromInput(input []*gqlschema.ExtensionInput) []model.Extension {
+	var result []model.Extension
+	for _, extension := range input {
+		if extension == nil {
+			continue
+		}
+		var providerConfig []byte
+		if extension.ProviderConfig != nil {
+			providerConfig = []byte(*extension.ProviderConfig)
+		}
+		result = append(result, model.Extension{
+			Type:           extension.Type,
+			Disabled:       extension.Disabled,
+			ProviderConfig: providerConfig,
+		})
+	}
+	return result
+}
+
+// networkingFilterDisabledFromInput returns the state of the Shoot Networking Filter extension set in the extensions input, as the shootNetworkingFilterDisabled field overrides the extension
+func networkingFilterDisabledFromInput(input []*gqlschema.ExtensionInput) *bool {
+	extension, found := model.FindExtension(extensionsFromInput(input), model.ShootNetworkingFilterExtensionType)
+	if !found {
+		return nil
+	}
+	return extension.Disabled
+}
+
+func runtimeDescription(input *gqlschema.RuntimeInput) *string {
+	if input == nil || util.IsNilOrEmpty(input.Description) {
+		return nil
+	}
+	return input.Description
+}
+
+func runtimeLabels(input *gqlschema.RuntimeInput) map[string]interface{} {
+	if input == nil || len(input.Labels) == 0 {
+		return nil
+	}
+	return input.Labels
+}
+
 func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpgradeInput, config model.GardenerConfig) (model.GardenerConfig, apperrors.AppError) {
 	var providerSpecificConfig model.GardenerProviderConfig
 	var err apperrors.AppError
@@ -188,6 +340,11 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		providerSpecificConfig = config.GardenerProviderConfig
 	}
 
+	resolvedExtensions, err := c.extensionsConfig.Resolve(config.Extensions, extensionsFromInput(input.Extensions))
+	if err != nil {
+		return model.GardenerConfig{}, err
+	}
+
 	return model.GardenerConfig{
 		ID:           config.ID,
 		ClusterID:    config.ClusterID,
@@ -201,6 +358,7 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		WorkerCidr:   config.WorkerCidr,
 
 		Purpose:                             util.OkOrDefault(input.Purpose, config.Purpose),
+		Networking:                          upgradedNetworking(input.Networking, config.Networking),
 		KubernetesVersion:                   util.UnwrapOrDefault(input.KubernetesVersion, config.KubernetesVersion),
 		MachineType:                         util.UnwrapOrDefault(input.MachineType, config.MachineType),
 		DiskType:                            util.OkOrDefault(input.DiskType, config.DiskType),
@@ -215,11 +373,50 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		EnableMachineImageVersionAutoUpdate: util.UnwrapOrDefault(input.EnableMachineImageVersionAutoUpdate, config.EnableMachineImageVersionAutoUpdate),
 		GardenerProviderConfig:              providerSpecificConfig,
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
+		KubeAPIServer:                       upgradedKubeAPIServer(input.KubeAPIServer, config.KubeAPIServer),
 		ExposureClassName:                   util.OkOrDefault(input.ExposureClassName, config.ExposureClassName),
-		ShootNetworkingFilterDisabled:       util.OkOrDefault(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		ShootNetworkingFilterDisabled:       util.OkOrDefault(util.OkOrDefault(input.ShootNetworkingFilterDisabled, networkingFilterDisabledFromInput(input.Extensions)), config.ShootNetworkingFilterDisabled),
+		Extensions:                          resolvedExtensions,
 	}, nil
 }
 
+// rollbackGardenerConfig takes the fields which can be upgraded from the revision and keeps the remaining ones from the current config
+func rollbackGardenerConfig(revision model.GardenerConfig, config model.GardenerConfig) model.GardenerConfig {
+	return model.GardenerConfig{
+		ID:           config.ID,
+		ClusterID:    config.ClusterID,
+		Name:         config.Name,
+		ProjectName:  config.ProjectName,
+		Provider:     config.Provider,
+		Seed:         config.Seed,
+		TargetSecret: config.TargetSecret,
+		Region:       config.Region,
+		LicenceType:  config.LicenceType,
+		WorkerCidr:   config.WorkerCidr,
+
+		Purpose:                             revision.Purpose,
+		Networking:                          revision.Networking,
+		KubernetesVersion:                   revision.KubernetesVersion,
+		MachineType:                         revision.MachineType,
+		DiskType:                            revision.DiskType,
+		VolumeSizeGB:                        revision.VolumeSizeGB,
+		MachineImage:                        revision.MachineImage,
+		MachineImageVersion:                 revision.MachineImageVersion,
+		AutoScalerMin:                       revision.AutoScalerMin,
+		AutoScalerMax:                       revision.AutoScalerMax,
+		MaxSurge:                            revision.MaxSurge,
+		MaxUnavailable:                      revision.MaxUnavailable,
+		EnableKubernetesVersionAutoUpdate:   revision.EnableKubernetesVersionAutoUpdate,
+		EnableMachineImageVersionAutoUpdate: revision.EnableMachineImageVersionAutoUpdate,
+		GardenerProviderConfig:              revision.GardenerProviderConfig,
+		OIDCConfig:                          revision.OIDCConfig,
+		KubeAPIServer:                       revision.KubeAPIServer,
+		ExposureClassName:                   revision.ExposureClassName,
+		ShootNetworkingFilterDisabled:       revision.ShootNetworkingFilterDisabled,
+		Extensions:                          revision.Extensions,
+	}
+}
+
 func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
 	if input == nil {
 		return nil, apperrors.Internal("provider config not specified")
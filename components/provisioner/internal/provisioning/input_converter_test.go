@@ -6,6 +6,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
@@ -37,8 +39,8 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 	gardenerGCPGQLInput := gqlschema.ProvisionRuntimeInput{
 		RuntimeInput: &gqlschema.RuntimeInput{
 			Name:        "runtimeName",
-			Description: nil,
-			Labels:      gqlschema.Labels{},
+			Description: util.PtrTo("Runtime of the core team"),
+			Labels:      gqlschema.Labels{"team": "core"},
 		},
 		ClusterConfig: &gqlschema.ClusterConfigInput{
 			GardenerConfig: &gqlschema.GardenerConfigInput{
@@ -71,6 +73,7 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.PtrTo(true),
 				ControlPlaneFailureTolerance:  util.PtrTo("zone"),
 				EuAccess:                      util.PtrTo(true),
+				ShootAndSeedSameRegion:        util.PtrTo(true),
 			},
 			Administrators: []string{administrator},
 		},
@@ -114,12 +117,15 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 			ShootNetworkingFilterDisabled:       util.PtrTo(true),
 			ControlPlaneFailureTolerance:        util.PtrTo("zone"),
 			EuAccess:                            true,
+			ShootAndSeedSameRegion:              true,
 		},
 		Kubeconfig:     nil,
 		KymaConfig:     fixKymaConfig(&modelProductionProfile),
 		Tenant:         tenant,
 		SubAccountId:   util.PtrTo(subAccountId),
 		Administrators: []string{administrator},
+		Description:    util.PtrTo("Runtime of the core team"),
+		Labels:         map[string]interface{}{"team": "core"},
 	}
 
 	createGQLRuntimeInputAzure := func(zones []string) gqlschema.ProvisionRuntimeInput {
@@ -452,7 +458,8 @@ func Test_ProvisioningInputToCluster(t *testing.T) {
 				gardenerProject,
 				defaultEnableKubernetesVersionAutoUpdate,
 				defaultEnableMachineImageVersionAutoUpdate,
-				defaultEnableIMDSv2)
+				defaultEnableIMDSv2,
+				extensions.Config{})
 
 			// when
 			runtimeConfig, err := inputConverter.ProvisioningInputToCluster("runtimeID", testCase.input, tenant, subAccountId)
@@ -555,7 +562,8 @@ func TestConverter_ParseInput(t *testing.T) {
 			gardenerProject,
 			defaultEnableKubernetesVersionAutoUpdate,
 			defaultEnableMachineImageVersionAutoUpdate,
-			defaultEnableIMDSv2)
+			defaultEnableIMDSv2,
+			extensions.Config{})
 
 		// when
 		output, err := inputConverter.KymaConfigFromInput("runtimeID", input)
@@ -580,6 +588,7 @@ func TestConverter_ProvisioningInputToCluster_Error(t *testing.T) {
 			defaultEnableKubernetesVersionAutoUpdate,
 			defaultEnableMachineImageVersionAutoUpdate,
 			defaultEnableIMDSv2,
+			extensions.Config{},
 		)
 
 		// when
@@ -604,7 +613,8 @@ func TestConverter_ProvisioningInputToCluster_Error(t *testing.T) {
 			gardenerProject,
 			defaultEnableKubernetesVersionAutoUpdate,
 			defaultEnableMachineImageVersionAutoUpdate,
-			defaultEnableIMDSv2)
+			defaultEnableIMDSv2,
+			extensions.Config{})
 
 		// when
 		_, err := inputConverter.ProvisioningInputToCluster("runtimeID", input, tenant, subAccountId)
@@ -631,7 +641,8 @@ func TestConverter_ProvisioningInputToCluster_Error(t *testing.T) {
 			gardenerProject,
 			defaultEnableKubernetesVersionAutoUpdate,
 			defaultEnableMachineImageVersionAutoUpdate,
-			defaultEnableIMDSv2)
+			defaultEnableIMDSv2,
+			extensions.Config{})
 
 		// when
 		_, err := inputConverter.ProvisioningInputToCluster("runtimeID", input, tenant, subAccountId)
@@ -875,6 +886,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				defaultEnableKubernetesVersionAutoUpdate,
 				defaultEnableMachineImageVersionAutoUpdate,
 				defaultEnableIMDSv2,
+				extensions.Config{},
 			)
 
 			// when
@@ -897,6 +909,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				defaultEnableKubernetesVersionAutoUpdate,
 				defaultEnableMachineImageVersionAutoUpdate,
 				defaultEnableIMDSv2,
+				extensions.Config{},
 			)
 
 			// when
@@ -909,6 +922,222 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 	}
 }
 
+func TestConverter_Extensions(t *testing.T) {
+	extensionsConfig := extensions.Config{
+		Allowed: []string{"shoot-lakom-service"},
+		Defaults: []model.Extension{
+			{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(true)},
+		},
+	}
+
+	t.Run("should merge input extensions into default extensions on provisioning", func(t *testing.T) {
+		// given
+		uuidGeneratorMock := &mocks.UUIDGenerator{}
+		uuidGeneratorMock.On("New").Return("id")
+		input := gqlschema.ProvisionRuntimeInput{
+			ClusterConfig: &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{
+					Name:                   "verylon",
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{GcpConfig: &gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}}},
+					Extensions: []*gqlschema.ExtensionInput{
+						{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(false)},
+						{Type: "shoot-lakom-service", ProviderConfig: util.PtrTo(`{"kind":"LakomConfig"}`)},
+					},
+				},
+			},
+		}
+		inputConverter := NewInputConverter(uuidGeneratorMock, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensionsConfig)
+
+		// when
+		cluster, err := inputConverter.ProvisioningInputToCluster("runtimeID", input, tenant, subAccountId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.Extension{
+			{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(false)},
+			{Type: "shoot-lakom-service", ProviderConfig: []byte(`{"kind":"LakomConfig"}`)},
+		}, cluster.ClusterConfig.Extensions)
+		assert.Equal(t, util.PtrTo(false), cluster.ClusterConfig.ShootNetworkingFilterDisabled)
+	})
+
+	t.Run("should merge input extensions into stored extensions on upgrade", func(t *testing.T) {
+		// given
+		config := model.GardenerConfig{
+			ShootNetworkingFilterDisabled: util.PtrTo(true),
+			Extensions:                    extensionsConfig.Defaults,
+		}
+		input := gqlschema.GardenerUpgradeInput{
+			Extensions: []*gqlschema.ExtensionInput{{Type: "shoot-dns-service", Disabled: util.PtrTo(true)}},
+		}
+		inputConverter := NewInputConverter(nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensionsConfig)
+
+		// when
+		upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(input, config)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.Extension{
+			{Type: "shoot-dns-service", Disabled: util.PtrTo(true), ProviderConfig: []byte(`{"kind":"DNSConfig"}`)},
+			{Type: model.ShootNetworkingFilterExtensionType, Disabled: util.PtrTo(true)},
+		}, upgradedConfig.Extensions)
+		assert.Equal(t, util.PtrTo(true), upgradedConfig.ShootNetworkingFilterDisabled)
+	})
+
+	t.Run("should reject extension which is not allowed on upgrade", func(t *testing.T) {
+		// given
+		input := gqlschema.GardenerUpgradeInput{
+			Extensions: []*gqlschema.ExtensionInput{{Type: "shoot-falco-service"}},
+		}
+		inputConverter := NewInputConverter(nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensionsConfig)
+
+		// when
+		_, err := inputConverter.UpgradeShootInputToGardenerConfig(input, model.GardenerConfig{})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Equal(t, "extension shoot-falco-service is not allowed", err.Error())
+	})
+}
+
+func TestConverter_Networking(t *testing.T) {
+	ciliumConfig := `{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`
+
+	t.Run("should convert networking on provisioning", func(t *testing.T) {
+		// given
+		uuidGeneratorMock := &mocks.UUIDGenerator{}
+		uuidGeneratorMock.On("New").Return("id")
+		input := gqlschema.ProvisionRuntimeInput{
+			ClusterConfig: &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{
+					Name:                   "verylon",
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{AwsConfig: &gqlschema.AWSProviderConfigInput{}},
+					Networking: &gqlschema.NetworkingInput{
+						Type:           util.PtrTo(model.CiliumNetworkingType),
+						IPFamilies:     []string{model.IPv4Family, model.IPv6Family},
+						ProviderConfig: util.PtrTo(ciliumConfig),
+					},
+				},
+			},
+		}
+		inputConverter := NewInputConverter(uuidGeneratorMock, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+
+		// when
+		cluster, err := inputConverter.ProvisioningInputToCluster("runtimeID", input, tenant, subAccountId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.NetworkingConfig{
+			Type:           model.CiliumNetworkingType,
+			IPFamilies:     []string{model.IPv4Family, model.IPv6Family},
+			ProviderConfig: []byte(ciliumConfig),
+		}, cluster.ClusterConfig.Networking)
+	})
+
+	t.Run("should override stored networking with fields set on upgrade", func(t *testing.T) {
+		// given
+		config := model.GardenerConfig{
+			Networking: &model.NetworkingConfig{Type: model.CiliumNetworkingType, IPFamilies: []string{model.IPv4Family}},
+		}
+		input := gqlschema.GardenerUpgradeInput{
+			Networking: &gqlschema.NetworkingInput{ProviderConfig: util.PtrTo(ciliumConfig)},
+		}
+		inputConverter := NewInputConverter(nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+
+		// when
+		upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(input, config)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.NetworkingConfig{
+			Type:           model.CiliumNetworkingType,
+			IPFamilies:     []string{model.IPv4Family},
+			ProviderConfig: []byte(ciliumConfig),
+		}, upgradedConfig.Networking)
+		assert.Nil(t, config.Networking.ProviderConfig)
+	})
+
+	t.Run("should keep stored networking without input on upgrade", func(t *testing.T) {
+		// given
+		inputConverter := NewInputConverter(nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+
+		// when
+		upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(gqlschema.GardenerUpgradeInput{}, model.GardenerConfig{})
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, upgradedConfig.Networking)
+	})
+}
+
+func TestConverter_KubeAPIServer(t *testing.T) {
+	t.Run("should convert kube-apiserver settings on provisioning", func(t *testing.T) {
+		// given
+		uuidGeneratorMock := &mocks.UUIDGenerator{}
+		uuidGeneratorMock.On("New").Return("id")
+		input := gqlschema.ProvisionRuntimeInput{
+			ClusterConfig: &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{
+					Name:                   "verylon",
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{GcpConfig: &gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}}},
+					KubeAPIServer: &gqlschema.KubeAPIServerInput{
+						FeatureGates:         []*gqlschema.FeatureGateInput{{Name: "ValidatingAdmissionPolicy", Enabled: true}},
+						RuntimeConfig:        []*gqlschema.RuntimeConfigEntryInput{{APIGroupVersion: "admissionregistration.k8s.io/v1beta1", Enabled: true}},
+						Requests:             &gqlschema.KubeAPIServerRequestsInput{MaxMutatingInflight: util.PtrTo(400)},
+						WatchCacheSizes:      &gqlschema.WatchCacheSizesInput{DefaultSize: util.PtrTo(100), Resources: []*gqlschema.ResourceWatchCacheSizeInput{{Resource: "secrets", Size: 500}}},
+						AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+					},
+				},
+			},
+		}
+		inputConverter := NewInputConverter(uuidGeneratorMock, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+
+		// when
+		cluster, err := inputConverter.ProvisioningInputToCluster("runtimeID", input, tenant, subAccountId)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.KubeAPIServerConfig{
+			FeatureGates:         map[string]bool{"ValidatingAdmissionPolicy": true},
+			RuntimeConfig:        map[string]bool{"admissionregistration.k8s.io/v1beta1": true},
+			Requests:             &model.KubeAPIServerRequests{MaxMutatingInflight: util.PtrTo(400)},
+			WatchCacheSizes:      &model.WatchCacheSizes{Default: util.PtrTo(100), Resources: []model.ResourceWatchCacheSize{{Resource: "secrets", Size: 500}}},
+			AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+		}, cluster.ClusterConfig.KubeAPIServer)
+	})
+
+	t.Run("should override stored kube-apiserver settings with settings set on upgrade", func(t *testing.T) {
+		// given
+		config := model.GardenerConfig{
+			KubeAPIServer: &model.KubeAPIServerConfig{
+				FeatureGates:         map[string]bool{"ValidatingAdmissionPolicy": true},
+				AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+			},
+		}
+		input := gqlschema.GardenerUpgradeInput{
+			KubeAPIServer: &gqlschema.KubeAPIServerInput{
+				FeatureGates:   []*gqlschema.FeatureGateInput{{Name: "InPlacePodVerticalScaling", Enabled: true}},
+				ServiceAccount: &gqlschema.ServiceAccountInput{ExtendTokenExpiration: util.PtrTo(false)},
+			},
+		}
+		inputConverter := NewInputConverter(nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+
+		// when
+		upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(input, config)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &model.KubeAPIServerConfig{
+			FeatureGates:         map[string]bool{"InPlacePodVerticalScaling": true},
+			ServiceAccount:       &model.ServiceAccountConfig{ExtendTokenExpiration: util.PtrTo(false)},
+			AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+		}, upgradedConfig.KubeAPIServer)
+		assert.Equal(t, map[string]bool{"ValidatingAdmissionPolicy": true}, config.KubeAPIServer.FeatureGates)
+	})
+}
+
 func newUpgradeShootInputAwsAzureGCP(newPurpose string) gqlschema.UpgradeShootInput {
 	return gqlschema.UpgradeShootInput{
 		GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // Provisioner is an autogenerated mock type for the Provisioner type
@@ -14,6 +16,22 @@ type Provisioner struct {
 	mock.Mock
 }
 
+// AdoptCluster provides a mock function with given fields: cluster, operationId
+func (_m *Provisioner) AdoptCluster(cluster model.Cluster, operationId string) apperrors.AppError {
+	ret := _m.Called(cluster, operationId)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.Cluster, string) apperrors.AppError); ok {
+		r0 = rf(cluster, operationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // DeprovisionCluster provides a mock function with given fields: cluster, operationId
 func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(cluster, operationId)
@@ -40,6 +58,32 @@ func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId str
 	return r0, r1
 }
 
+// GetShoot provides a mock function with given fields: shootName
+func (_m *Provisioner) GetShoot(shootName string) (v1beta1.Shoot, apperrors.AppError) {
+	ret := _m.Called(shootName)
+
+	var r0 v1beta1.Shoot
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (v1beta1.Shoot, apperrors.AppError)); ok {
+		return rf(shootName)
+	}
+	if rf, ok := ret.Get(0).(func(string) v1beta1.Shoot); ok {
+		r0 = rf(shootName)
+	} else {
+		r0 = ret.Get(0).(v1beta1.Shoot)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(shootName)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ProvisionCluster provides a mock function with given fields: cluster, operationId
 func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
 	ret := _m.Called(cluster, operationId)
@@ -56,6 +100,22 @@ func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId strin
 	return r0
 }
 
+// UpdateRuntimeMetadata provides a mock function with given fields: cluster
+func (_m *Provisioner) UpdateRuntimeMetadata(cluster model.Cluster) apperrors.AppError {
+	ret := _m.Called(cluster)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.Cluster) apperrors.AppError); ok {
+		r0 = rf(cluster)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpgradeCluster provides a mock function with given fields: clusterID, upgradeConfig
 func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
 	ret := _m.Called(clusterID, upgradeConfig)
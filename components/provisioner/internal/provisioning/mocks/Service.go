@@ -7,6 +7,8 @@ import (
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -14,6 +16,34 @@ type Service struct {
 	mock.Mock
 }
 
+// AuditEvents provides a mock function with given fields: runtimeID, from, to
+func (_m *Service) AuditEvents(runtimeID string, from *time.Time, to *time.Time) ([]*gqlschema.AuditEvent, apperrors.AppError) {
+	ret := _m.Called(runtimeID, from, to)
+
+	var r0 []*gqlschema.AuditEvent
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) ([]*gqlschema.AuditEvent, apperrors.AppError)); ok {
+		return rf(runtimeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) []*gqlschema.AuditEvent); ok {
+		r0 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gqlschema.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time) apperrors.AppError); ok {
+		r1 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeprovisionRuntime provides a mock function with given fields: id
 func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	ret := _m.Called(id)
@@ -40,25 +70,53 @@ func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return r0, r1
 }
 
-// ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
-func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(config, tenant, subAccount)
+// ImportRuntime provides a mock function with given fields: shootName, tenant, subAccount
+func (_m *Service) ImportRuntime(shootName string, tenant string, subAccount *string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(shootName, tenant, subAccount)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(0).(func(string, string, *string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(shootName, tenant, subAccount)
 	}
-	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string) *gqlschema.OperationStatus); ok {
-		r0 = rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(0).(func(string, string, *string) *gqlschema.OperationStatus); ok {
+		r0 = rf(shootName, tenant, subAccount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(gqlschema.ProvisionRuntimeInput, string, string) apperrors.AppError); ok {
-		r1 = rf(config, tenant, subAccount)
+	if rf, ok := ret.Get(1).(func(string, string, *string) apperrors.AppError); ok {
+		r1 = rf(shootName, tenant, subAccount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount, idempotencyKey
+func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(config, tenant, subAccount, idempotencyKey)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string, *string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(config, tenant, subAccount, idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(gqlschema.ProvisionRuntimeInput, string, string, *string) *gqlschema.OperationStatus); ok {
+		r0 = rf(config, tenant, subAccount, idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(gqlschema.ProvisionRuntimeInput, string, string, *string) apperrors.AppError); ok {
+		r1 = rf(config, tenant, subAccount, idempotencyKey)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -94,6 +152,90 @@ func (_m *Service) ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	return r0, r1
 }
 
+// RuntimeConfigRevisionDiff provides a mock function with given fields: runtimeID, from, to
+func (_m *Service) RuntimeConfigRevisionDiff(runtimeID string, from int, to int) ([]*gqlschema.ConfigChange, apperrors.AppError) {
+	ret := _m.Called(runtimeID, from, to)
+
+	var r0 []*gqlschema.ConfigChange
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*gqlschema.ConfigChange, apperrors.AppError)); ok {
+		return rf(runtimeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*gqlschema.ConfigChange); ok {
+		r0 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gqlschema.ConfigChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) apperrors.AppError); ok {
+		r1 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RuntimeConfigRevisions provides a mock function with given fields: runtimeID
+func (_m *Service) RuntimeConfigRevisions(runtimeID string) ([]*gqlschema.RuntimeConfigRevision, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []*gqlschema.RuntimeConfigRevision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]*gqlschema.RuntimeConfigRevision, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*gqlschema.RuntimeConfigRevision); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gqlschema.RuntimeConfigRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RuntimeIDs provides a mock function with given fields: tenant, labels
+func (_m *Service) RuntimeIDs(tenant string, labels gqlschema.Labels) ([]string, apperrors.AppError) {
+	ret := _m.Called(tenant, labels)
+
+	var r0 []string
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, gqlschema.Labels) ([]string, apperrors.AppError)); ok {
+		return rf(tenant, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, gqlschema.Labels) []string); ok {
+		r0 = rf(tenant, labels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, gqlschema.Labels) apperrors.AppError); ok {
+		r1 = rf(tenant, labels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // RuntimeOperationStatus provides a mock function with given fields: id
 func (_m *Service) RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(id)
@@ -150,25 +292,81 @@ func (_m *Service) RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors
 	return r0, r1
 }
 
-// UpgradeGardenerShoot provides a mock function with given fields: id, input
-func (_m *Service) UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(id, input)
+// TenantQuota provides a mock function with given fields: tenant
+func (_m *Service) TenantQuota(tenant string) (*gqlschema.TenantQuota, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 *gqlschema.TenantQuota
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (*gqlschema.TenantQuota, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.TenantQuota); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.TenantQuota)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateRuntimeMetadata provides a mock function with given fields: id, labels, description
+func (_m *Service) UpdateRuntimeMetadata(id string, labels gqlschema.Labels, description *string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
+	ret := _m.Called(id, labels, description)
+
+	var r0 *gqlschema.RuntimeStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, gqlschema.Labels, *string) (*gqlschema.RuntimeStatus, apperrors.AppError)); ok {
+		return rf(id, labels, description)
+	}
+	if rf, ok := ret.Get(0).(func(string, gqlschema.Labels, *string) *gqlschema.RuntimeStatus); ok {
+		r0 = rf(id, labels, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.RuntimeStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, gqlschema.Labels, *string) apperrors.AppError); ok {
+		r1 = rf(id, labels, description)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpgradeGardenerShoot provides a mock function with given fields: id, input, idempotencyKey
+func (_m *Service) UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(id, input, idempotencyKey)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput, *string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(id, input, idempotencyKey)
 	}
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput, *string) *gqlschema.OperationStatus); ok {
+		r0 = rf(id, input, idempotencyKey)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(string, gqlschema.UpgradeShootInput, *string) apperrors.AppError); ok {
+		r1 = rf(id, input, idempotencyKey)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -7,17 +7,190 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
+// gcmPrefix marks data encrypted with AES-GCM, stored in the format gcm:<key ID>:<base64 encoded nonce and cipher text>.
+// Data without the prefix is encrypted with AES-CFB using the legacy key.
+const gcmPrefix = "gcm:"
+
+const defaultKeyID = "default"
+
 type encryptFunc func([]byte) ([]byte, error)
 type decryptFunc func([]byte) ([]byte, error)
 
-func newEncryptFunc(key []byte) encryptFunc {
-	return func(obj []byte) ([]byte, error) { return encrypt(key, obj) }
+type Key struct {
+	ID    string
+	Value []byte
+}
+
+// Keyring holds all keys which can decrypt the stored data, the newest of them is used for encryption
+type Keyring struct {
+	keys         map[string][]byte
+	primaryKeyID string
+	legacyKey    []byte
+	// legacyWrites keeps encrypting with AES-CFB, so that versions which cannot read AES-GCM can still run against the database
+	legacyWrites bool
+}
+
+// NewKeyring creates the keyring from keys ordered from the oldest to the newest. If no keys are provided, the legacy key is used for encryption as well.
+func NewKeyring(legacyKey string, keys []Key) (*Keyring, error) {
+	if len(keys) == 0 {
+		if len(legacyKey) == 0 {
+			return nil, errors.New("empty encryption key provided")
+		}
+		keys = []Key{{ID: defaultKeyID, Value: []byte(legacyKey)}}
+	}
+
+	keyring := &Keyring{
+		keys:         map[string][]byte{},
+		primaryKeyID: keys[len(keys)-1].ID,
+	}
+	if len(legacyKey) > 0 {
+		keyring.legacyKey = []byte(legacyKey)
+	}
+
+	for _, key := range keys {
+		if key.ID == "" || strings.Contains(key.ID, ":") {
+			return nil, fmt.Errorf("invalid encryption key ID %q", key.ID)
+		}
+		if _, err := aes.NewCipher(key.Value); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %s: %w", key.ID, err)
+		}
+		if _, exists := keyring.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicated encryption key ID %s", key.ID)
+		}
+		keyring.keys[key.ID] = key.Value
+	}
+
+	return keyring, nil
+}
+
+// ParseKeys parses keys in the format "id=key,id=key", the keys can be separated with new lines as well
+func ParseKeys(keys string) ([]Key, error) {
+	var parsed []Key
+	for _, entry := range strings.FieldsFunc(keys, isKeySeparator) {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, value, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, errors.New("invalid encryption key, expected id=key")
+		}
+		parsed = append(parsed, Key{ID: id, Value: []byte(value)})
+	}
+
+	return parsed, nil
+}
+
+func isKeySeparator(r rune) bool {
+	return r == ',' || r == '\n'
+}
+
+// withLegacyWrites returns the keyring which encrypts with the legacy key and decrypts with all keys
+func (k *Keyring) withLegacyWrites() *Keyring {
+	keyring := *k
+	keyring.legacyWrites = true
+	return &keyring
+}
+
+// needsReEncryption returns true if the data is not encrypted in the format the keyring writes
+func (k *Keyring) needsReEncryption(obj []byte) bool {
+	if k.legacyWrites {
+		return strings.HasPrefix(string(obj), gcmPrefix)
+	}
+	return !strings.HasPrefix(string(obj), gcmPrefix+k.primaryKeyID+":")
+}
+
+func (k *Keyring) encrypt(obj []byte) ([]byte, error) {
+	if k.legacyWrites {
+		return encrypt(k.legacyKey, obj)
+	}
+	return encryptGCM(k.primaryKeyID, k.keys[k.primaryKeyID], obj)
+}
+
+func (k *Keyring) decrypt(obj []byte) ([]byte, error) {
+	if !strings.HasPrefix(string(obj), gcmPrefix) {
+		if k.legacyKey == nil {
+			return nil, errors.New("legacy encryption key not provided")
+		}
+		return decrypt(k.legacyKey, obj)
+	}
+
+	keyID, data, found := strings.Cut(strings.TrimPrefix(string(obj), gcmPrefix), ":")
+	if !found {
+		return nil, errors.New("encryption key ID not found")
+	}
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("encryption key %s not found", keyID)
+	}
+
+	return decryptGCM(keyID, key, []byte(data))
+}
+
+func newEncryptFunc(keyProvider KeyProvider) encryptFunc {
+	return func(obj []byte) ([]byte, error) {
+		keyring, err := keyProvider.Keyring()
+		if err != nil {
+			return nil, errors.Wrap(err, "while getting encryption keys")
+		}
+		return keyring.encrypt(obj)
+	}
+}
+
+func newDecryptFunc(keyProvider KeyProvider) decryptFunc {
+	return func(obj []byte) ([]byte, error) {
+		keyring, err := keyProvider.Keyring()
+		if err != nil {
+			return nil, errors.Wrap(err, "while getting encryption keys")
+		}
+		return keyring.decrypt(obj)
+	}
+}
+
+func encryptGCM(keyID string, key, obj []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	// Key ID is authenticated, so that the cipher text cannot be moved under another key
+	sealed := aead.Seal(nonce, nonce, obj, []byte(keyID))
+
+	return []byte(gcmPrefix + keyID + ":" + base64.StdEncoding.EncodeToString(sealed)), nil
 }
 
-func newDecryptFunc(key []byte) decryptFunc {
-	return func(obj []byte) ([]byte, error) { return decrypt(key, obj) }
+func decryptGCM(keyID string, key, obj []byte) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(string(obj))
+	if err != nil {
+		return nil, fmt.Errorf("while decoding object: %w", err)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("cipher text is too short")
+	}
+
+	return aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(keyID))
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
 }
 
 func encrypt(key, obj []byte) ([]byte, error) {
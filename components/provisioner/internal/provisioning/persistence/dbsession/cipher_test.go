@@ -1,57 +1,190 @@
 package dbsession
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	legacySecretKey = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+	newSecretKey    = "x7k2m9p4q8r1t6v3w5y0z2a4c6e8g1j3"
+)
+
 func TestCipher(t *testing.T) {
+	text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
+
 	t.Run("should encrypt and decrypt the text correctly", func(t *testing.T) {
 		// given
-		text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
-		secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-		e := newEncryptFunc([]byte(secretKey))
-		d := newDecryptFunc([]byte(secretKey))
+		keyring, err := NewKeyring(legacySecretKey, nil)
+		require.NoError(t, err)
+		e := keyring.encrypt
+		d := keyring.decrypt
 
 		// when
 		encryptedText, err := e([]byte(text))
 		require.NoError(t, err)
 
 		// then
+		assert.True(t, strings.HasPrefix(string(encryptedText), "gcm:default:"))
+
 		decryptedText, err := d(encryptedText)
 		require.NoError(t, err)
 
 		assert.Equal(t, text, string(decryptedText))
 	})
 
+	t.Run("should encrypt with legacy key when legacy writes are enabled", func(t *testing.T) {
+		// given
+		keyring, err := NewKeyring(legacySecretKey, []Key{{ID: "2024", Value: []byte(newSecretKey)}})
+		require.NoError(t, err)
+		legacyKeyring := keyring.withLegacyWrites()
+
+		// when
+		encryptedText, err := legacyKeyring.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		// then
+		assert.False(t, strings.HasPrefix(string(encryptedText), gcmPrefix))
+		assert.False(t, legacyKeyring.needsReEncryption(encryptedText))
+		assert.True(t, keyring.needsReEncryption(encryptedText))
+
+		decryptedText, err := decrypt([]byte(legacySecretKey), encryptedText)
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+
+		gcmText, err := keyring.encrypt([]byte(text))
+		require.NoError(t, err)
+		assert.True(t, legacyKeyring.needsReEncryption(gcmText))
+
+		decryptedText, err = legacyKeyring.decrypt(gcmText)
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+	})
+
 	t.Run("should not fail to encrypt when the text is empty", func(t *testing.T) {
 		// given
-		text := ""
-		secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-		e := newEncryptFunc([]byte(secretKey))
-		d := newDecryptFunc([]byte(secretKey))
+		keyring, err := NewKeyring(legacySecretKey, nil)
+		require.NoError(t, err)
+		e := keyring.encrypt
+		d := keyring.decrypt
 
 		// when
-		encryptedText, err := e([]byte(text))
+		encryptedText, err := e([]byte(""))
 		require.NoError(t, err)
 
 		// then
 		decryptedText, err := d(encryptedText)
 		require.NoError(t, err)
 
+		assert.Equal(t, "", string(decryptedText))
+	})
+
+	t.Run("should fail to create keyring when the secretKey is empty", func(t *testing.T) {
+		// when
+		_, err := NewKeyring("", nil)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should decrypt legacy text encrypted with AES-CFB", func(t *testing.T) {
+		// given
+		legacyText, err := encrypt([]byte(legacySecretKey), []byte(text))
+		require.NoError(t, err)
+
+		keyring, err := NewKeyring(legacySecretKey, []Key{{ID: "2024", Value: []byte(newSecretKey)}})
+		require.NoError(t, err)
+
+		// when
+		decryptedText, err := keyring.decrypt(legacyText)
+
+		// then
+		require.NoError(t, err)
 		assert.Equal(t, text, string(decryptedText))
+		assert.True(t, keyring.needsReEncryption(legacyText))
 	})
 
-	t.Run("should fail to encrypt when the secretKey is empty", func(t *testing.T) {
+	t.Run("should encrypt with the newest key and decrypt with all keys", func(t *testing.T) {
 		// given
-		text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
-		secretKey := ""
-		e := newEncryptFunc([]byte(secretKey))
+		oldKeyring, err := NewKeyring("", []Key{{ID: "2023", Value: []byte(legacySecretKey)}})
+		require.NoError(t, err)
+		oldText, err := oldKeyring.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		keyring, err := NewKeyring("", []Key{{ID: "2023", Value: []byte(legacySecretKey)}, {ID: "2024", Value: []byte(newSecretKey)}})
+		require.NoError(t, err)
+
+		// when
+		newText, err := keyring.encrypt([]byte(text))
+		require.NoError(t, err)
+		decryptedOldText, err := keyring.decrypt(oldText)
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, strings.HasPrefix(string(newText), "gcm:2024:"))
+		assert.Equal(t, text, string(decryptedOldText))
+		assert.True(t, keyring.needsReEncryption(oldText))
+		assert.False(t, keyring.needsReEncryption(newText))
+	})
+
+	t.Run("should fail to decrypt modified text", func(t *testing.T) {
+		// given
+		keyring, err := NewKeyring(legacySecretKey, nil)
+		require.NoError(t, err)
+		encryptedText, err := keyring.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		modified := []byte(strings.TrimSuffix(string(encryptedText), string(encryptedText[len(encryptedText)-4:])) + "AAAA")
+
+		// when
+		_, err = keyring.decrypt(modified)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to decrypt text encrypted with unknown key", func(t *testing.T) {
+		// given
+		oldKeyring, err := NewKeyring("", []Key{{ID: "2023", Value: []byte(legacySecretKey)}})
+		require.NoError(t, err)
+		encryptedText, err := oldKeyring.encrypt([]byte(text))
+		require.NoError(t, err)
+
+		keyring, err := NewKeyring("", []Key{{ID: "2024", Value: []byte(newSecretKey)}})
+		require.NoError(t, err)
+
+		// when
+		_, err = keyring.decrypt(encryptedText)
+
+		// then
+		assert.ErrorContains(t, err, "encryption key 2023 not found")
+	})
+
+	t.Run("should fail to create keyring with invalid key", func(t *testing.T) {
+		// when
+		_, err := NewKeyring("", []Key{{ID: "2024", Value: []byte("too-short")}})
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Run("should parse keys", func(t *testing.T) {
+		// when
+		keys, err := ParseKeys("2023=" + legacySecretKey + ",2024=" + newSecretKey)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []Key{{ID: "2023", Value: []byte(legacySecretKey)}, {ID: "2024", Value: []byte(newSecretKey)}}, keys)
+	})
 
+	t.Run("should return error for key without ID", func(t *testing.T) {
 		// when
-		_, err := e([]byte(text))
+		_, err := ParseKeys(legacySecretKey)
 
 		// then
 		assert.Error(t, err)
@@ -0,0 +1,960 @@
+package dbsession_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/inmemory"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	schemaFilePath = "../../../../assets/database/provisioner.sql"
+	secretKey      = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+)
+
+func TestInMemoryFactory(t *testing.T) {
+	testFactoryConformance(t, func(t *testing.T) dbsession.Factory {
+		return inmemory.NewFactory()
+	})
+}
+
+func TestPostgresFactory(t *testing.T) {
+	ctx := context.Background()
+
+	containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+	require.NoError(t, err)
+	defer containerCleanupFunc()
+
+	connection, err := database.InitializeDatabaseConnection(connString, 5)
+	require.NoError(t, err)
+	require.NotNil(t, connection)
+	defer testutils.CloseDatabase(t, connection)
+
+	err = database.SetupSchema(connection, schemaFilePath)
+	require.NoError(t, err)
+
+	keyProvider, err := dbsession.NewStaticKeyProvider(secretKey, nil)
+	require.NoError(t, err)
+
+	testFactoryConformance(t, func(t *testing.T) dbsession.Factory {
+		factory, err := dbsession.NewFactory(connection, keyProvider)
+		require.NoError(t, err)
+		return factory
+	})
+}
+
+// testFactoryConformance verifies the behaviour which all implementations of the Factory must share.
+// Implementations may share the data between the factories, so every test uses unique IDs.
+func testFactoryConformance(t *testing.T, newFactory func(t *testing.T) dbsession.Factory) {
+	t.Run("should insert and get cluster", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		cluster := newCluster(t, "tenant")
+
+		// when
+		err := insertCluster(factory.NewWriteSession(), cluster)
+		require.NoError(t, err)
+
+		// then
+		readSession := factory.NewReadSession()
+
+		stored, err := readSession.GetCluster(cluster.ID)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.ID, stored.ID)
+		assert.Equal(t, cluster.Tenant, stored.Tenant)
+		assert.Equal(t, cluster.SubAccountId, stored.SubAccountId)
+		assert.True(t, cluster.CreationTimestamp.Equal(stored.CreationTimestamp))
+		assert.False(t, stored.Deleted)
+		assert.Nil(t, stored.Kubeconfig)
+		assert.ElementsMatch(t, cluster.Administrators, stored.Administrators)
+		assert.Equal(t, cluster.ClusterConfig.Name, stored.ClusterConfig.Name)
+		assert.Equal(t, cluster.ClusterConfig.KubernetesVersion, stored.ClusterConfig.KubernetesVersion)
+		assert.Equal(t, cluster.ClusterConfig.AutoScalerMax, stored.ClusterConfig.AutoScalerMax)
+		assert.Equal(t, cluster.ClusterConfig.PodsCIDR, stored.ClusterConfig.PodsCIDR)
+		assert.Equal(t, cluster.ClusterConfig.ServicesCIDR, stored.ClusterConfig.ServicesCIDR)
+		assert.Equal(t, 1, stored.ClusterConfig.ResourceVersion)
+		assert.Equal(t, cluster.ClusterConfig.Extensions, stored.ClusterConfig.Extensions)
+		assert.Equal(t, cluster.ClusterConfig.Networking, stored.ClusterConfig.Networking)
+		assert.Equal(t, cluster.ClusterConfig.KubeAPIServer, stored.ClusterConfig.KubeAPIServer)
+		require.NotNil(t, stored.ClusterConfig.OIDCConfig)
+		assert.Equal(t, cluster.ClusterConfig.OIDCConfig.ClientID, stored.ClusterConfig.OIDCConfig.ClientID)
+		assert.ElementsMatch(t, cluster.ClusterConfig.OIDCConfig.SigningAlgs, stored.ClusterConfig.OIDCConfig.SigningAlgs)
+
+		tenant, err := readSession.GetTenant(cluster.ID)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.Tenant, tenant)
+
+		byName, err := readSession.GetGardenerClusterByName(cluster.ClusterConfig.Name)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.Tenant, byName.Tenant)
+		assert.Equal(t, cluster.ClusterConfig.Name, byName.ClusterConfig.Name)
+		assert.Equal(t, cluster.ClusterConfig.PodsCIDR, byName.ClusterConfig.PodsCIDR)
+		assert.Equal(t, cluster.ClusterConfig.ServicesCIDR, byName.ClusterConfig.ServicesCIDR)
+		assert.Equal(t, cluster.ClusterConfig.Extensions, byName.ClusterConfig.Extensions)
+		assert.Equal(t, cluster.ClusterConfig.Networking, byName.ClusterConfig.Networking)
+		assert.Equal(t, cluster.ClusterConfig.KubeAPIServer, byName.ClusterConfig.KubeAPIServer)
+	})
+
+	t.Run("should return not found errors", func(t *testing.T) {
+		// given
+		readSession := newFactory(t).NewReadSession()
+		id := uuid.New().String()
+
+		// when
+		_, getClusterErr := readSession.GetCluster(id)
+		_, getTenantErr := readSession.GetTenant(id)
+		_, getByNameErr := readSession.GetGardenerClusterByName("c-" + id[:7])
+		_, getOperationErr := readSession.GetOperation(id)
+		_, getLastOperationErr := readSession.GetLastOperation(id)
+		_, getTenantForOperationErr := readSession.GetTenantForOperation(id)
+		_, getIdempotencyKeyErr := readSession.GetIdempotencyKey("tenant", id)
+		_, getQuotaOverrideErr := readSession.GetTenantQuotaOverride(id)
+
+		// then
+		for _, err := range []dberrors.Error{
+			getClusterErr, getTenantErr, getByNameErr, getOperationErr, getLastOperationErr,
+			getTenantForOperationErr, getIdempotencyKeyErr, getQuotaOverrideErr,
+		} {
+			require.Error(t, err)
+			assert.Equal(t, dberrors.CodeNotFound, err.Code())
+		}
+	})
+
+	t.Run("should return not found when updating missing records", func(t *testing.T) {
+		// given
+		writeSession := newFactory(t).NewWriteSession()
+		id := uuid.New().String()
+
+		// when
+		updateOperationErr := writeSession.UpdateOperationState(id, "message", model.Succeeded, time.Now())
+		transitionOperationErr := writeSession.TransitionOperation(id, "message", model.WaitingForClusterCreation, time.Now())
+		updateKubeconfigErr := writeSession.UpdateKubeconfig(id, "kubeconfig")
+		updateTenantErr := writeSession.UpdateTenant(id, "tenant")
+		updateVersionErr := writeSession.UpdateKubernetesVersion(id, "1.30")
+		markAsDeletedErr := writeSession.MarkClusterAsDeleted(id)
+		deleteErr := writeSession.DeleteCluster(id)
+
+		// then
+		for _, err := range []dberrors.Error{
+			updateOperationErr, transitionOperationErr, updateKubeconfigErr, updateTenantErr,
+			updateVersionErr, markAsDeletedErr, deleteErr,
+		} {
+			require.Error(t, err)
+			assert.Equal(t, dberrors.CodeNotFound, err.Code())
+		}
+	})
+
+	t.Run("should enforce unique constraints", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+
+		// when
+		duplicatedClusterErr := writeSession.InsertCluster(cluster)
+
+		secondConfig := cluster.ClusterConfig
+		secondConfig.ID = uuid.New().String()
+		secondConfig.Name = newClusterName()
+		secondConfigErr := writeSession.InsertGardenerConfig(secondConfig)
+
+		// then
+		for _, err := range []dberrors.Error{duplicatedClusterErr, secondConfigErr} {
+			require.Error(t, err)
+			assert.Equal(t, dberrors.CodeAlreadyExists, err.Code())
+		}
+	})
+
+	t.Run("should not get deleted cluster by name", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+
+		err = writeSession.MarkClusterAsDeleted(cluster.ID)
+		require.NoError(t, err)
+
+		// when
+		_, err = factory.NewReadSession().GetGardenerClusterByName(cluster.ClusterConfig.Name)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+	})
+
+	// The schema does not constrain Runtime names, so both implementations store clusters with the same name
+	t.Run("should allow clusters with the same name", func(t *testing.T) {
+		// given
+		writeSession := newFactory(t).NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+
+		otherCluster := newCluster(t, "tenant")
+		otherCluster.ClusterConfig.Name = cluster.ClusterConfig.Name
+
+		// when
+		err = insertCluster(writeSession, otherCluster)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not store changes before transaction is committed", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		readSession := factory.NewReadSession()
+		cluster := newCluster(t, "tenant")
+
+		txSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer txSession.RollbackUnlessCommitted()
+
+		// when
+		err = insertCluster(txSession, cluster)
+		require.NoError(t, err)
+
+		// then
+		_, err = readSession.GetCluster(cluster.ID)
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+
+		// when
+		err = txSession.Commit()
+		require.NoError(t, err)
+
+		// then
+		_, err = readSession.GetCluster(cluster.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should discard changes when transaction is rolled back", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		cluster := newCluster(t, "tenant")
+
+		txSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+
+		err = insertCluster(txSession, cluster)
+		require.NoError(t, err)
+
+		// when
+		txSession.RollbackUnlessCommitted()
+
+		// then
+		_, err = factory.NewReadSession().GetCluster(cluster.ID)
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should lock Gardener config and return its resource version", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(factory.NewWriteSession(), cluster)
+		require.NoError(t, err)
+
+		// when
+		_, err = factory.NewWriteSession().LockGardenerConfig(cluster.ID)
+
+		// then
+		require.Error(t, err)
+
+		// given
+		txSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer txSession.RollbackUnlessCommitted()
+
+		// when
+		resourceVersion, err := txSession.LockGardenerConfig(cluster.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, resourceVersion)
+
+		cluster.ClusterConfig.KubernetesVersion = "1.31"
+		err = txSession.UpdateGardenerClusterConfig(cluster.ClusterConfig)
+		require.NoError(t, err)
+
+		err = txSession.Commit()
+		require.NoError(t, err)
+
+		// then
+		stored, err := factory.NewReadSession().GetCluster(cluster.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, stored.ClusterConfig.ResourceVersion)
+		assert.Equal(t, "1.31", stored.ClusterConfig.KubernetesVersion)
+	})
+
+	t.Run("should block concurrent lock of Gardener config until transaction ends", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(factory.NewWriteSession(), cluster)
+		require.NoError(t, err)
+
+		txSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer txSession.RollbackUnlessCommitted()
+
+		_, err = txSession.LockGardenerConfig(cluster.ID)
+		require.NoError(t, err)
+
+		concurrentSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer concurrentSession.RollbackUnlessCommitted()
+
+		// when
+		locked := make(chan int)
+		go func() {
+			resourceVersion, _ := concurrentSession.LockGardenerConfig(cluster.ID)
+			locked <- resourceVersion
+		}()
+
+		// then
+		select {
+		case <-locked:
+			t.Fatal("Gardener config locked by two transactions")
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		err = txSession.UpdateGardenerClusterConfig(cluster.ClusterConfig)
+		require.NoError(t, err)
+		err = txSession.Commit()
+		require.NoError(t, err)
+
+		select {
+		case resourceVersion := <-locked:
+			assert.Equal(t, 2, resourceVersion)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Gardener config not locked after transaction ended")
+		}
+	})
+
+	t.Run("should manage operations", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		readSession := factory.NewReadSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+
+		start := time.Now().UTC().Truncate(time.Millisecond)
+		provisioning := newOperation(cluster.ID, model.Provision, start)
+		provisioning.SeedPlacement = util.PtrTo("seed aws-seed chosen with 0 shoots among 1 candidates")
+		upgrade := newOperation(cluster.ID, model.UpgradeShoot, start.Add(time.Minute))
+
+		// when
+		err = writeSession.InsertOperation(provisioning)
+		require.NoError(t, err)
+		err = writeSession.UpdateOperationState(provisioning.ID, "Provisioning finished", model.Succeeded, start.Add(time.Second))
+		require.NoError(t, err)
+		err = writeSession.InsertOperation(upgrade)
+		require.NoError(t, err)
+		err = writeSession.TransitionOperation(upgrade.ID, "Waiting", model.WaitingForClusterCreation, start.Add(2*time.Minute))
+		require.NoError(t, err)
+		err = writeSession.UpdateOperationLastError(upgrade.ID, "error", "reason", "component")
+		require.NoError(t, err)
+
+		// then
+		storedProvisioning, err := readSession.GetOperation(provisioning.ID)
+		require.NoError(t, err)
+		assert.Equal(t, model.Succeeded, storedProvisioning.State)
+		assert.Equal(t, "Provisioning finished", storedProvisioning.Message)
+		require.NotNil(t, storedProvisioning.EndTimestamp)
+		assert.True(t, start.Add(time.Second).Equal(*storedProvisioning.EndTimestamp))
+		assert.Equal(t, provisioning.SeedPlacement, storedProvisioning.SeedPlacement)
+
+		lastOperation, err := readSession.GetLastOperation(cluster.ID)
+		require.NoError(t, err)
+		assert.Equal(t, upgrade.ID, lastOperation.ID)
+		assert.Equal(t, model.WaitingForClusterCreation, lastOperation.Stage)
+		assert.Equal(t, model.LastError{ErrMessage: "error", Reason: "reason", Component: "component"}, lastOperation.LastError)
+
+		inProgress, err := readSession.ListInProgressOperations()
+		require.NoError(t, err)
+		assert.Contains(t, operationIDs(inProgress), upgrade.ID)
+		assert.NotContains(t, operationIDs(inProgress), provisioning.ID)
+
+		count, err := readSession.InProgressOperationsCount()
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, count.Count[model.UpgradeShoot], 1)
+
+		hasInProgress, err := writeSession.HasInProgressOperation(cluster.ID)
+		require.NoError(t, err)
+		assert.True(t, hasInProgress)
+
+		tenant, err := readSession.GetTenantForOperation(upgrade.ID)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.Tenant, tenant)
+
+		owner, err := readSession.GetOperationOwner(upgrade.ID)
+		require.NoError(t, err)
+		assert.Equal(t, cluster.Tenant, owner.Tenant)
+		assert.Equal(t, cluster.ClusterConfig.Purpose, owner.Purpose)
+	})
+
+	t.Run("should not insert operation for missing cluster", func(t *testing.T) {
+		// given
+		writeSession := newFactory(t).NewWriteSession()
+
+		// when
+		err := writeSession.InsertOperation(newOperation(uuid.New().String(), model.Provision, time.Now().UTC()))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
+
+	t.Run("should update cluster", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+
+		// when
+		cluster.ClusterConfig.Extensions = append(cluster.ClusterConfig.Extensions, model.Extension{Type: "shoot-oidc-service", Disabled: util.PtrTo(true)})
+		cluster.ClusterConfig.KubeAPIServer.ServiceAccount = &model.ServiceAccountConfig{ExtendTokenExpiration: util.PtrTo(false)}
+		cluster.ClusterConfig.Networking.ProviderConfig = []byte(`{"apiVersion":"cilium.networking.extensions.gardener.cloud/v1alpha1","kind":"NetworkConfig"}`)
+		err = writeSession.UpdateGardenerClusterConfig(cluster.ClusterConfig)
+		require.NoError(t, err)
+		err = writeSession.UpdateKubeconfig(cluster.ID, "kubeconfig")
+		require.NoError(t, err)
+		err = writeSession.UpdateTenant(cluster.ID, "other-tenant")
+		require.NoError(t, err)
+		err = writeSession.UpdateKubernetesVersion(cluster.ID, "1.32")
+		require.NoError(t, err)
+		err = writeSession.UpdateShootNetworkingFilterDisabled(cluster.ID, util.PtrTo(true))
+		require.NoError(t, err)
+		err = writeSession.InsertAdministrators(cluster.ID, []string{"other-admin@example.com"})
+		require.NoError(t, err)
+
+		// then
+		stored, err := factory.NewReadSession().GetCluster(cluster.ID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.Kubeconfig)
+		assert.Equal(t, "kubeconfig", *stored.Kubeconfig)
+		assert.Equal(t, "other-tenant", stored.Tenant)
+		assert.Equal(t, "1.32", stored.ClusterConfig.KubernetesVersion)
+		assert.Equal(t, util.PtrTo(true), stored.ClusterConfig.ShootNetworkingFilterDisabled)
+		assert.Equal(t, cluster.ClusterConfig.Extensions, stored.ClusterConfig.Extensions)
+		assert.Equal(t, cluster.ClusterConfig.Networking, stored.ClusterConfig.Networking)
+		assert.Equal(t, cluster.ClusterConfig.KubeAPIServer, stored.ClusterConfig.KubeAPIServer)
+		assert.Equal(t, []string{"other-admin@example.com"}, stored.Administrators)
+	})
+
+	t.Run("should delete cluster with operations", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+		operation := newOperation(cluster.ID, model.Provision, time.Now().UTC())
+		err = writeSession.InsertOperation(operation)
+		require.NoError(t, err)
+
+		// when
+		err = writeSession.DeleteCluster(cluster.ID)
+		require.NoError(t, err)
+
+		// then
+		_, err = factory.NewReadSession().GetCluster(cluster.ID)
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+
+		_, err = factory.NewReadSession().GetOperation(operation.ID)
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should count quota usage of tenant", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		tenant := uuid.New().String()
+
+		cluster := newCluster(t, tenant)
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+		err = writeSession.InsertOperation(newOperation(cluster.ID, model.Provision, time.Now().UTC()))
+		require.NoError(t, err)
+
+		deletedCluster := newCluster(t, tenant)
+		err = insertCluster(writeSession, deletedCluster)
+		require.NoError(t, err)
+		err = writeSession.MarkClusterAsDeleted(deletedCluster.ID)
+		require.NoError(t, err)
+
+		err = insertCluster(writeSession, newCluster(t, "other-tenant"))
+		require.NoError(t, err)
+
+		// when
+		usage, err := factory.NewReadSession().GetTenantQuotaUsage(tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.QuotaValues{Runtimes: 1, TotalMaxNodes: cluster.ClusterConfig.AutoScalerMax, ConcurrentOperations: 1}, usage)
+	})
+
+	t.Run("should lock quota of tenant until transaction ends", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		tenant := uuid.New().String()
+
+		txSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer txSession.RollbackUnlessCommitted()
+
+		override, usage, err := txSession.LockTenantQuota(tenant)
+		require.NoError(t, err)
+		assert.Equal(t, model.TenantQuotaOverride{}, override)
+		assert.Equal(t, model.QuotaValues{}, usage)
+
+		cluster := newCluster(t, tenant)
+		err = insertCluster(txSession, cluster)
+		require.NoError(t, err)
+
+		concurrentSession, err := factory.NewSessionWithinTransaction()
+		require.NoError(t, err)
+		defer concurrentSession.RollbackUnlessCommitted()
+
+		// when
+		locked := make(chan model.QuotaValues)
+		go func() {
+			_, usage, _ := concurrentSession.LockTenantQuota(tenant)
+			locked <- usage
+		}()
+
+		// then
+		select {
+		case <-locked:
+			t.Fatal("Quota of tenant locked by two transactions")
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		err = txSession.Commit()
+		require.NoError(t, err)
+
+		select {
+		case usage := <-locked:
+			assert.Equal(t, 1, usage.Runtimes)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Quota of tenant not locked after transaction ended")
+		}
+	})
+
+	t.Run("should store idempotency keys", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+
+		cluster := newCluster(t, "tenant")
+		err := insertCluster(writeSession, cluster)
+		require.NoError(t, err)
+		operation := newOperation(cluster.ID, model.Provision, time.Now().UTC())
+		err = writeSession.InsertOperation(operation)
+		require.NoError(t, err)
+
+		key := model.IdempotencyKey{
+			Tenant:      "tenant",
+			Key:         uuid.New().String(),
+			Mutation:    "provisionRuntime",
+			RequestHash: "hash",
+			OperationID: operation.ID,
+			CreatedAt:   time.Now().UTC().Truncate(time.Millisecond),
+		}
+
+		// when
+		err = writeSession.InsertIdempotencyKey(key)
+		require.NoError(t, err)
+		duplicatedErr := writeSession.InsertIdempotencyKey(key)
+
+		// then
+		require.Error(t, duplicatedErr)
+		assert.Equal(t, dberrors.CodeAlreadyExists, duplicatedErr.Code())
+
+		stored, err := factory.NewReadSession().GetIdempotencyKey(key.Tenant, key.Key)
+		require.NoError(t, err)
+		assert.Equal(t, key.OperationID, stored.OperationID)
+		assert.Equal(t, key.RequestHash, stored.RequestHash)
+	})
+
+	t.Run("should list audit events of Runtime", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		runtimeID := uuid.New().String()
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		for _, timestamp := range []time.Time{now.Add(time.Minute), now.Add(-time.Hour), now} {
+			err := writeSession.InsertAuditEvent(model.AuditEvent{
+				ID:        uuid.New().String(),
+				Timestamp: timestamp,
+				Caller:    "caller",
+				UserAgent: util.PtrTo("Go-http-client/1.1"),
+				Tenant:    "tenant",
+				Mutation:  "upgradeShoot",
+				RuntimeID: &runtimeID,
+				Outcome:   model.AuditEventSucceeded,
+			})
+			require.NoError(t, err)
+		}
+
+		// when
+		from := now.Add(-time.Minute)
+		events, err := factory.NewReadSession().ListAuditEvents(runtimeID, &from, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.True(t, now.Equal(events[0].Timestamp))
+		assert.True(t, now.Add(time.Minute).Equal(events[1].Timestamp))
+	})
+
+	t.Run("should store Gardener config revisions", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		cluster := newCluster(t, "tenant")
+		require.NoError(t, insertCluster(writeSession, cluster))
+
+		operation := newOperation(cluster.ID, model.Provision, time.Now())
+		require.NoError(t, writeSession.InsertOperation(operation))
+
+		upgradedConfig := cluster.ClusterConfig
+		upgradedConfig.MachineType = "n2-standard-8"
+		upgradedConfig.AutoScalerMax = 20
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		// when
+		err := writeSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+			RuntimeID:         cluster.ID,
+			OperationID:       &operation.ID,
+			CreationTimestamp: now,
+			Config:            cluster.ClusterConfig,
+		})
+		require.NoError(t, err)
+
+		err = writeSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+			RuntimeID:         cluster.ID,
+			Revision:          7,
+			CreationTimestamp: now.Add(time.Minute),
+			Config:            upgradedConfig,
+		})
+		require.NoError(t, err)
+
+		// then
+		readSession := factory.NewReadSession()
+
+		revisions, err := readSession.ListGardenerConfigRevisions(cluster.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 1, revisions[0].Revision)
+		assert.Equal(t, &operation.ID, revisions[0].OperationID)
+		assert.True(t, now.Equal(revisions[0].CreationTimestamp))
+		assert.Equal(t, "n2-standard-4", revisions[0].Config.MachineType)
+		assert.Equal(t, 2, revisions[1].Revision)
+		assert.Nil(t, revisions[1].OperationID)
+
+		revision, err := readSession.GetGardenerConfigRevision(cluster.ID, 2)
+		require.NoError(t, err)
+		assert.Equal(t, upgradedConfig.MachineType, revision.Config.MachineType)
+		assert.Equal(t, upgradedConfig.AutoScalerMax, revision.Config.AutoScalerMax)
+		assert.Equal(t, upgradedConfig.PodsCIDR, revision.Config.PodsCIDR)
+		assert.Equal(t, upgradedConfig.OIDCConfig, revision.Config.OIDCConfig)
+		require.NotNil(t, revision.Config.GardenerProviderConfig)
+		assert.Equal(t, upgradedConfig.GardenerProviderConfig.RawJSON(), revision.Config.GardenerProviderConfig.RawJSON())
+
+		_, err = readSession.GetGardenerConfigRevision(cluster.ID, 3)
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+
+		empty, err := readSession.ListGardenerConfigRevisions(uuid.New().String())
+		require.NoError(t, err)
+		assert.Empty(t, empty)
+	})
+
+	t.Run("should not insert Gardener config revision for missing cluster", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		cluster := newCluster(t, "tenant")
+
+		// when
+		err := factory.NewWriteSession().InsertGardenerConfigRevision(model.GardenerConfigRevision{
+			RuntimeID:         cluster.ID,
+			CreationTimestamp: time.Now(),
+			Config:            cluster.ClusterConfig,
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
+
+	t.Run("should replace drift of Runtime", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		cluster := newCluster(t, "tenant")
+		require.NoError(t, insertCluster(writeSession, cluster))
+		deletedCluster := newCluster(t, "tenant")
+		require.NoError(t, insertCluster(writeSession, deletedCluster))
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+
+		// when
+		err := writeSession.ReplaceDriftItems(cluster.ID, []model.DriftItem{
+			{Field: "machineType", StoredValue: util.PtrTo("n2-standard-4"), ActualValue: util.PtrTo("n2-standard-8"), DetectedAt: now},
+			{Field: "autoScalerMax", StoredValue: util.PtrTo("3"), ActualValue: util.PtrTo("5"), DetectedAt: now},
+		})
+		require.NoError(t, err)
+		err = writeSession.ReplaceDriftItems(deletedCluster.ID, []model.DriftItem{
+			{Field: "machineType", StoredValue: util.PtrTo("n2-standard-4"), DetectedAt: now},
+		})
+		require.NoError(t, err)
+		require.NoError(t, writeSession.MarkClusterAsDeleted(deletedCluster.ID))
+
+		// then
+		readSession := factory.NewReadSession()
+
+		items, err := readSession.ListDriftItems(cluster.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, cluster.ID, items[0].RuntimeID)
+		assert.Equal(t, "autoScalerMax", items[0].Field)
+		assert.Equal(t, util.PtrTo("3"), items[0].StoredValue)
+		assert.Equal(t, util.PtrTo("5"), items[0].ActualValue)
+		assert.True(t, now.Equal(items[0].DetectedAt))
+		assert.Equal(t, "machineType", items[1].Field)
+
+		deletedItems, err := readSession.ListDriftItems(deletedCluster.ID)
+		require.NoError(t, err)
+		require.Len(t, deletedItems, 1)
+		assert.Nil(t, deletedItems[0].ActualValue)
+
+		allItems, err := readSession.ListAllDriftItems()
+		require.NoError(t, err)
+		var runtimeIDs []string
+		for _, item := range allItems {
+			runtimeIDs = append(runtimeIDs, item.RuntimeID)
+		}
+		assert.Contains(t, runtimeIDs, cluster.ID)
+		assert.NotContains(t, runtimeIDs, deletedCluster.ID)
+
+		// when
+		err = writeSession.ReplaceDriftItems(cluster.ID, []model.DriftItem{
+			{Field: "kubernetesVersion", StoredValue: util.PtrTo("1.30"), ActualValue: util.PtrTo("1.31"), DetectedAt: now},
+		})
+		require.NoError(t, err)
+
+		// then
+		items, err = readSession.ListDriftItems(cluster.ID)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, "kubernetesVersion", items[0].Field)
+
+		// when
+		err = writeSession.ReplaceDriftItems(cluster.ID, nil)
+		require.NoError(t, err)
+
+		// then
+		items, err = readSession.ListDriftItems(cluster.ID)
+		require.NoError(t, err)
+		assert.Empty(t, items)
+	})
+
+	t.Run("should store and filter Runtime metadata", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+		writeSession := factory.NewWriteSession()
+		tenant := uuid.New().String()
+
+		labeled := newCluster(t, tenant)
+		labeled.Labels = map[string]interface{}{"team": "core", "tier": 1, "zones": []interface{}{"a", "b"}}
+		labeled.Description = util.PtrTo("Runtime of the core team")
+		require.NoError(t, insertCluster(writeSession, labeled))
+
+		other := newCluster(t, tenant)
+		other.CreationTimestamp = labeled.CreationTimestamp.Add(time.Minute)
+		other.Labels = map[string]interface{}{"team": "edge"}
+		require.NoError(t, insertCluster(writeSession, other))
+
+		unlabeled := newCluster(t, tenant)
+		unlabeled.CreationTimestamp = labeled.CreationTimestamp.Add(2 * time.Minute)
+		require.NoError(t, insertCluster(writeSession, unlabeled))
+
+		readSession := factory.NewReadSession()
+
+		// then
+		stored, err := readSession.GetCluster(labeled.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"team": "core", "tier": float64(1), "zones": []interface{}{"a", "b"}}, stored.Labels)
+		assert.Equal(t, labeled.Description, stored.Description)
+
+		stored, err = readSession.GetCluster(unlabeled.ID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Labels)
+		assert.Nil(t, stored.Description)
+
+		runtimeIDs, err := readSession.ListRuntimeIDsByLabels(tenant, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{labeled.ID, other.ID, unlabeled.ID}, runtimeIDs)
+
+		runtimeIDs, err = readSession.ListRuntimeIDsByLabels(tenant, map[string]interface{}{"team": "core", "zones": []interface{}{"b"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{labeled.ID}, runtimeIDs)
+
+		runtimeIDs, err = readSession.ListRuntimeIDsByLabels(tenant, map[string]interface{}{"tier": 2})
+		require.NoError(t, err)
+		assert.Empty(t, runtimeIDs)
+
+		// when
+		err = writeSession.UpdateRuntimeMetadata(other.ID, map[string]interface{}{"team": "core"}, util.PtrTo("Moved"))
+		require.NoError(t, err)
+		require.NoError(t, writeSession.MarkClusterAsDeleted(labeled.ID))
+
+		// then
+		stored, err = readSession.GetCluster(other.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"team": "core"}, stored.Labels)
+		assert.Equal(t, util.PtrTo("Moved"), stored.Description)
+
+		runtimeIDs, err = readSession.ListRuntimeIDsByLabels(tenant, map[string]interface{}{"team": "core"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{other.ID}, runtimeIDs)
+
+		// when
+		err = writeSession.UpdateRuntimeMetadata(other.ID, nil, nil)
+		require.NoError(t, err)
+
+		// then
+		stored, err = readSession.GetCluster(other.ID)
+		require.NoError(t, err)
+		assert.Nil(t, stored.Labels)
+		assert.Nil(t, stored.Description)
+
+		// when
+		err = writeSession.UpdateRuntimeMetadata(uuid.New().String(), nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeNotFound, err.Code())
+	})
+
+	t.Run("should not insert drift for missing cluster", func(t *testing.T) {
+		// given
+		factory := newFactory(t)
+
+		// when
+		err := factory.NewWriteSession().ReplaceDriftItems(uuid.New().String(), []model.DriftItem{
+			{Field: "machineType", DetectedAt: time.Now()},
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, dberrors.CodeInternal, err.Code())
+	})
+}
+
+func insertCluster(session dbsession.WriteSession, cluster model.Cluster) dberrors.Error {
+	if err := session.InsertCluster(cluster); err != nil {
+		return err
+	}
+
+	return session.InsertGardenerConfig(cluster.ClusterConfig)
+}
+
+func newCluster(t *testing.T, tenant string) model.Cluster {
+	id := uuid.New().String()
+
+	providerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-b"}})
+	require.NoError(t, err)
+
+	return model.Cluster{
+		ID:                id,
+		Tenant:            tenant,
+		SubAccountId:      util.PtrTo("sub-account"),
+		CreationTimestamp: time.Now().UTC().Truncate(time.Millisecond),
+		Administrators:    []string{"admin@example.com"},
+		ClusterConfig: model.GardenerConfig{
+			ID:                     uuid.New().String(),
+			ClusterID:              id,
+			Name:                   newClusterName(),
+			ProjectName:            "project",
+			KubernetesVersion:      "1.30",
+			MachineType:            "n2-standard-4",
+			Region:                 "europe-west1",
+			Provider:               "gcp",
+			Purpose:                util.PtrTo("production"),
+			Seed:                   "gcp-eu1",
+			TargetSecret:           "secret",
+			WorkerCidr:             "10.250.0.0/16",
+			PodsCIDR:               util.PtrTo("100.64.0.0/12"),
+			ServicesCIDR:           util.PtrTo("100.104.0.0/13"),
+			AutoScalerMin:          3,
+			AutoScalerMax:          10,
+			MaxSurge:               1,
+			GardenerProviderConfig: providerConfig,
+			Extensions:             []model.Extension{{Type: "shoot-dns-service", ProviderConfig: []byte(`{"kind":"DNSConfig"}`)}},
+			Networking:             &model.NetworkingConfig{Type: model.CiliumNetworkingType, IPFamilies: []string{model.IPv4Family}},
+			KubeAPIServer: &model.KubeAPIServerConfig{
+				FeatureGates:         map[string]bool{"ValidatingAdmissionPolicy": true},
+				Requests:             &model.KubeAPIServerRequests{MaxMutatingInflight: util.PtrTo(400)},
+				AuditPolicyConfigMap: util.PtrTo("audit-policy"),
+			},
+			OIDCConfig: &model.OIDCConfig{
+				ClientID:    "client",
+				IssuerURL:   "https://issuer.example.com",
+				SigningAlgs: []string{"RS256"},
+			},
+		},
+	}
+}
+
+func newClusterName() string {
+	return "c-" + uuid.New().String()[:7]
+}
+
+func newOperation(runtimeID string, operationType model.OperationType, start time.Time) model.Operation {
+	return model.Operation{
+		ID:             uuid.New().String(),
+		Type:           operationType,
+		StartTimestamp: start,
+		State:          model.InProgress,
+		Message:        "started",
+		ClusterID:      runtimeID,
+		Stage:          model.WaitingForClusterDomain,
+	}
+}
+
+func operationIDs(operations []model.Operation) []string {
+	ids := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		ids = append(ids, operation.ID)
+	}
+	return ids
+}
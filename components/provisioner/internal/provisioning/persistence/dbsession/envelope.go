@@ -0,0 +1,71 @@
+package dbsession
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const dataKeySize = 32
+
+//go:generate mockery --name=KMS
+type KMS interface {
+	// Encrypt wraps the data key with the key encryption key stored in the KMS
+	Encrypt(dataKey []byte) ([]byte, error)
+	// Decrypt unwraps the data key
+	Decrypt(wrappedDataKey []byte) ([]byte, error)
+}
+
+type envelopeKeyProvider struct {
+	keyring *Keyring
+}
+
+// NewEnvelopeKeyProvider provides data keys which are stored wrapped by the KMS, so that the configuration never contains plain keys.
+// Values of the wrapped keys are base64 encoded.
+func NewEnvelopeKeyProvider(kms KMS, legacyKey string, wrappedKeys []Key) (KeyProvider, error) {
+	if len(wrappedKeys) == 0 {
+		return nil, errors.New("no wrapped encryption keys provided")
+	}
+
+	keys := make([]Key, 0, len(wrappedKeys))
+	for _, wrappedKey := range wrappedKeys {
+		wrapped, err := base64.StdEncoding.DecodeString(string(wrappedKey.Value))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while decoding wrapped encryption key %s", wrappedKey.ID)
+		}
+
+		dataKey, err := kms.Decrypt(wrapped)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while unwrapping encryption key %s", wrappedKey.ID)
+		}
+		keys = append(keys, Key{ID: wrappedKey.ID, Value: dataKey})
+	}
+
+	keyring, err := NewKeyring(legacyKey, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &envelopeKeyProvider{keyring: keyring}, nil
+}
+
+func (p *envelopeKeyProvider) Keyring() (*Keyring, error) {
+	return p.keyring, nil
+}
+
+// NewWrappedDataKey generates a random data key and returns it wrapped by the KMS in the format accepted by NewEnvelopeKeyProvider
+func NewWrappedDataKey(kms KMS) (string, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", errors.Wrap(err, "while generating data key")
+	}
+
+	wrapped, err := kms.Encrypt(dataKey)
+	if err != nil {
+		return "", errors.Wrap(err, "while wrapping data key")
+	}
+
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
@@ -0,0 +1,178 @@
+package dbsession_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/fake"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+)
+
+const masterKey = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+
+func TestEnvelopeKeyProvider(t *testing.T) {
+	t.Run("should unwrap data keys with KMS", func(t *testing.T) {
+		// given
+		kms, err := fake.NewKMS([]byte(masterKey))
+		require.NoError(t, err)
+
+		oldKey, err := dbsession.NewWrappedDataKey(kms)
+		require.NoError(t, err)
+		newKey, err := dbsession.NewWrappedDataKey(kms)
+		require.NoError(t, err)
+
+		// when
+		provider, err := dbsession.NewEnvelopeKeyProvider(kms, "", []dbsession.Key{
+			{ID: "2023", Value: []byte(oldKey)},
+			{ID: "2024", Value: []byte(newKey)},
+		})
+
+		// then
+		require.NoError(t, err)
+
+		keyring, err := provider.Keyring()
+		require.NoError(t, err)
+		assert.NotNil(t, keyring)
+	})
+
+	t.Run("should return error when KMS fails to unwrap data key", func(t *testing.T) {
+		// given
+		kms := &mocks.KMS{}
+		kms.On("Decrypt", mock.Anything).Return(nil, errors.New("access denied"))
+
+		// when
+		_, err := dbsession.NewEnvelopeKeyProvider(kms, "", []dbsession.Key{
+			{ID: "2024", Value: []byte(base64.StdEncoding.EncodeToString([]byte("wrapped")))},
+		})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "access denied")
+	})
+
+	t.Run("should return error when data key is wrapped by another KMS key", func(t *testing.T) {
+		// given
+		kms, err := fake.NewKMS([]byte(masterKey))
+		require.NoError(t, err)
+		otherKMS, err := fake.NewKMS([]byte("x7k2m9p4q8r1t6v3w5y0z2a4c6e8g1j3"))
+		require.NoError(t, err)
+
+		wrappedKey, err := dbsession.NewWrappedDataKey(otherKMS)
+		require.NoError(t, err)
+
+		// when
+		_, err = dbsession.NewEnvelopeKeyProvider(kms, "", []dbsession.Key{{ID: "2024", Value: []byte(wrappedKey)}})
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error when no wrapped keys are provided", func(t *testing.T) {
+		// given
+		kms, err := fake.NewKMS([]byte(masterKey))
+		require.NoError(t, err)
+
+		// when
+		_, err = dbsession.NewEnvelopeKeyProvider(kms, "", nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestHTTPKMS(t *testing.T) {
+	t.Run("should wrap and unwrap data keys with KMS endpoint", func(t *testing.T) {
+		// given
+		localKMS, err := fake.NewKMS([]byte(masterKey))
+		require.NoError(t, err)
+
+		tokenPath := filepath.Join(t.TempDir(), "token")
+		err = os.WriteFile(tokenPath, []byte("token\n"), 0600)
+		require.NoError(t, err)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			var request struct {
+				Plaintext  []byte `json:"plaintext"`
+				Ciphertext []byte `json:"ciphertext"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+			switch r.URL.Path {
+			case "/v1/encrypt":
+				ciphertext, err := localKMS.Encrypt(request.Plaintext)
+				require.NoError(t, err)
+				require.NoError(t, json.NewEncoder(w).Encode(map[string][]byte{"ciphertext": ciphertext}))
+			case "/v1/decrypt":
+				plaintext, err := localKMS.Decrypt(request.Ciphertext)
+				require.NoError(t, err)
+				require.NoError(t, json.NewEncoder(w).Encode(map[string][]byte{"plaintext": plaintext}))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		kms, err := dbsession.NewHTTPKMS(server.URL+"/v1/", tokenPath)
+		require.NoError(t, err)
+
+		wrappedKey, err := dbsession.NewWrappedDataKey(kms)
+		require.NoError(t, err)
+
+		// when
+		provider, err := dbsession.NewKeyProvider(dbsession.KeyProviderConfig{
+			Type:              dbsession.EnvelopeKeyProviderType,
+			WrappedSecretKeys: "2024=" + wrappedKey,
+			KMSEndpoint:       server.URL + "/v1",
+			KMSTokenPath:      tokenPath,
+			GCMWritesEnabled:  true,
+		})
+
+		// then
+		require.NoError(t, err)
+		keyring, err := provider.Keyring()
+		require.NoError(t, err)
+		assert.NotNil(t, keyring)
+	})
+
+	t.Run("should return error when KMS endpoint rejects request", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		}))
+		defer server.Close()
+
+		kms, err := dbsession.NewHTTPKMS(server.URL, "")
+		require.NoError(t, err)
+
+		// when
+		_, err = kms.Decrypt([]byte("wrapped"))
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "403")
+	})
+
+	t.Run("should return error when KMS endpoint is empty", func(t *testing.T) {
+		// when
+		_, err := dbsession.NewHTTPKMS("", "")
+
+		// then
+		require.Error(t, err)
+	})
+}
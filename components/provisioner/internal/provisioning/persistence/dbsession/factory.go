@@ -28,7 +28,19 @@ type ReadSession interface {
 	ListInProgressOperations() ([]model.Operation, dberrors.Error)
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
+	GetOperationOwner(operationID string) (model.OperationOwner, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	ListAuditEvents(runtimeID string, from, to *time.Time) ([]model.AuditEvent, dberrors.Error)
+	GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error)
+	GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, dberrors.Error)
+	GetTenantQuotaUsage(tenant string) (model.QuotaValues, dberrors.Error)
+	ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, dberrors.Error)
+	GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, dberrors.Error)
+	ListDriftItems(runtimeID string) ([]model.DriftItem, dberrors.Error)
+	// ListAllDriftItems returns drift of all Runtimes which are not deleted
+	ListAllDriftItems() ([]model.DriftItem, dberrors.Error)
+	// ListRuntimeIDsByLabels returns IDs of not deleted Runtimes of the tenant whose labels contain all of the given labels
+	ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, dberrors.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -36,6 +48,11 @@ type WriteSession interface {
 	InsertCluster(cluster model.Cluster) dberrors.Error
 	InsertGardenerConfig(config model.GardenerConfig) dberrors.Error
 	UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error
+	// LockGardenerConfig locks Gardener config of the Runtime until the end of the transaction and returns its resource version
+	LockGardenerConfig(runtimeID string) (int, dberrors.Error)
+	HasInProgressOperation(runtimeID string) (bool, dberrors.Error)
+	// LockTenantQuota locks quota of the tenant until the end of the transaction and returns the quota override, empty if not stored, and the current usage
+	LockTenantQuota(tenant string) (model.TenantQuotaOverride, model.QuotaValues, dberrors.Error)
 	InsertAdministrators(clusterId string, administrators []string) dberrors.Error
 	InsertOperation(operation model.Operation) dberrors.Error
 	UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error
@@ -47,6 +64,14 @@ type WriteSession interface {
 	UpdateTenant(runtimeID string, tenant string) dberrors.Error
 	UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error
 	UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error
+	// UpdateRuntimeMetadata replaces labels and description of the Runtime
+	UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) dberrors.Error
+	InsertAuditEvent(event model.AuditEvent) dberrors.Error
+	InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) dberrors.Error
+	// InsertGardenerConfigRevision stores the revision with the next number, the number set in the revision is ignored
+	InsertGardenerConfigRevision(revision model.GardenerConfigRevision) dberrors.Error
+	// ReplaceDriftItems replaces the drift recorded for the Runtime with the given items
+	ReplaceDriftItems(runtimeID string, items []model.DriftItem) dberrors.Error
 }
 
 //go:generate mockery --name=ReadWriteSession
@@ -72,14 +97,14 @@ type factory struct {
 	decrypt    decryptFunc
 }
 
-func NewFactory(connection *dbr.Connection, secretKey string) (Factory, error) {
-	if len(secretKey) == 0 {
-		return nil, errors.New("empty encryption key provided")
+func NewFactory(connection *dbr.Connection, keyProvider KeyProvider) (Factory, error) {
+	if keyProvider == nil {
+		return nil, errors.New("empty encryption key provider")
 	}
 	return &factory{
 		connection: connection,
-		encrypt:    newEncryptFunc([]byte(secretKey)),
-		decrypt:    newDecryptFunc([]byte(secretKey)),
+		encrypt:    newEncryptFunc(keyProvider),
+		decrypt:    newDecryptFunc(keyProvider),
 	}, nil
 }
 
@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// KMS wraps data keys locally with the master key instead of calling an external key management service
+type KMS struct {
+	aead cipher.AEAD
+}
+
+func NewKMS(masterKey []byte) (*KMS, error) {
+	block, err := aes.NewCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KMS{aead: aead}, nil
+}
+
+func (k *KMS) Encrypt(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return k.aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (k *KMS) Decrypt(wrappedDataKey []byte) ([]byte, error) {
+	if len(wrappedDataKey) < k.aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+
+	return k.aead.Open(nil, wrappedDataKey[:k.aead.NonceSize()], wrappedDataKey[k.aead.NonceSize():], nil)
+}
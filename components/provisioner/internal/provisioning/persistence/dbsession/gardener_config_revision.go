@@ -0,0 +1,66 @@
+package dbsession
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+type gardenerConfigRevisionDTO struct {
+	ClusterID         string
+	Revision          int
+	OperationID       *string
+	CreationTimestamp time.Time
+	Config            []byte
+}
+
+// gardenerConfigSnapshot stores the provider config as raw JSON, as the interface cannot be decoded directly
+type gardenerConfigSnapshot struct {
+	model.GardenerConfig
+	GardenerProviderConfig string
+}
+
+func encodeGardenerConfigRevision(revision model.GardenerConfigRevision) (gardenerConfigRevisionDTO, error) {
+	snapshot := gardenerConfigSnapshot{GardenerConfig: revision.Config}
+	if revision.Config.GardenerProviderConfig != nil {
+		snapshot.GardenerProviderConfig = revision.Config.GardenerProviderConfig.RawJSON()
+	}
+
+	config, err := json.Marshal(snapshot)
+	if err != nil {
+		return gardenerConfigRevisionDTO{}, err
+	}
+
+	return gardenerConfigRevisionDTO{
+		ClusterID:         revision.RuntimeID,
+		Revision:          revision.Revision,
+		OperationID:       revision.OperationID,
+		CreationTimestamp: revision.CreationTimestamp,
+		Config:            config,
+	}, nil
+}
+
+func (dto gardenerConfigRevisionDTO) decode() (model.GardenerConfigRevision, error) {
+	var snapshot gardenerConfigSnapshot
+	if err := json.Unmarshal(dto.Config, &snapshot); err != nil {
+		return model.GardenerConfigRevision{}, err
+	}
+
+	config := snapshot.GardenerConfig
+	if snapshot.GardenerProviderConfig != "" {
+		providerConfig, err := model.NewGardenerProviderConfigFromJSON(snapshot.GardenerProviderConfig)
+		if err != nil {
+			return model.GardenerConfigRevision{}, err
+		}
+		config.GardenerProviderConfig = providerConfig
+	}
+
+	return model.GardenerConfigRevision{
+		RuntimeID:         dto.ClusterID,
+		Revision:          dto.Revision,
+		OperationID:       dto.OperationID,
+		CreationTimestamp: dto.CreationTimestamp,
+		Config:            config,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package dbsession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGardenerConfigRevisionEncoding(t *testing.T) {
+	// given
+	providerConfig, appErr := model.NewAWSGardenerConfig(&gqlschema.AWSProviderConfigInput{
+		VpcCidr: "10.250.0.0/16",
+		AwsZones: []*gqlschema.AWSZoneInput{
+			{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+		},
+	})
+	require.NoError(t, appErr)
+
+	revision := model.GardenerConfigRevision{
+		RuntimeID:         "runtime",
+		Revision:          3,
+		OperationID:       util.PtrTo("operation"),
+		CreationTimestamp: time.Now().UTC(),
+		Config: model.GardenerConfig{
+			Name:                   "shoot",
+			KubernetesVersion:      "1.30",
+			MachineType:            "m6i.large",
+			AutoScalerMax:          10,
+			Purpose:                util.PtrTo("production"),
+			GardenerProviderConfig: providerConfig,
+			OIDCConfig:             &model.OIDCConfig{ClientID: "client", SigningAlgs: []string{"RS256"}},
+		},
+	}
+
+	// when
+	dto, err := encodeGardenerConfigRevision(revision)
+	require.NoError(t, err)
+
+	decoded, err := dto.decode()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, revision.RuntimeID, decoded.RuntimeID)
+	assert.Equal(t, revision.Revision, decoded.Revision)
+	assert.Equal(t, revision.OperationID, decoded.OperationID)
+	assert.True(t, revision.CreationTimestamp.Equal(decoded.CreationTimestamp))
+	assert.Equal(t, revision.Config.Name, decoded.Config.Name)
+	assert.Equal(t, revision.Config.Purpose, decoded.Config.Purpose)
+	assert.Equal(t, revision.Config.OIDCConfig, decoded.Config.OIDCConfig)
+	require.IsType(t, &model.AWSGardenerConfig{}, decoded.Config.GardenerProviderConfig)
+	assert.Equal(t, providerConfig.RawJSON(), decoded.Config.GardenerProviderConfig.RawJSON())
+}
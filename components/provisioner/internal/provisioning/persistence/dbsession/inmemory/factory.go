@@ -0,0 +1,116 @@
+package inmemory
+
+import (
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+type factory struct {
+	store *store
+}
+
+// NewFactory creates sessions which keep the data in memory. Data is not encrypted and is lost on restart, so the factory should be used only for local development and tests.
+func NewFactory() dbsession.Factory {
+	return &factory{
+		store: newStore(),
+	}
+}
+
+func (f *factory) NewReadSession() dbsession.ReadSession {
+	return readSession{store: f.store}
+}
+
+func (f *factory) NewWriteSession() dbsession.WriteSession {
+	return writeSession{store: f.store}
+}
+
+func (f *factory) NewReadWriteSession() dbsession.ReadWriteSession {
+	return readWriteSession{
+		readSession:  readSession{store: f.store},
+		writeSession: writeSession{store: f.store},
+	}
+}
+
+func (f *factory) NewSessionWithinTransaction() (dbsession.WriteSessionWithinTransaction, dberrors.Error) {
+	return writeSession{
+		store:       f.store,
+		transaction: &transaction{store: f.store},
+	}, nil
+}
+
+type readWriteSession struct {
+	readSession
+	writeSession
+}
+
+// store holds the committed state and row locks of Gardener configs
+type store struct {
+	lock      sync.RWMutex
+	committed *state
+
+	rowLock    sync.Mutex
+	rowUnlock  *sync.Cond
+	lockedRows map[string]*transaction
+}
+
+func newStore() *store {
+	s := &store{
+		committed:  newState(),
+		lockedRows: map[string]*transaction{},
+	}
+	s.rowUnlock = sync.NewCond(&s.rowLock)
+
+	return s
+}
+
+func (s *store) snapshot() *state {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.committed
+}
+
+// write applies the changes to the copy of the committed state, which replaces it only if all changes succeed
+func (s *store) write(changes ...change) dberrors.Error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	next := s.committed.clone()
+	for _, c := range changes {
+		if err := c(next); err != nil {
+			return err
+		}
+	}
+	s.committed = next
+
+	return nil
+}
+
+// lockRow waits until the row is not locked by other transactions, as SELECT FOR UPDATE does
+func (s *store) lockRow(runtimeID string, tx *transaction) {
+	s.rowLock.Lock()
+	defer s.rowLock.Unlock()
+
+	for {
+		owner, locked := s.lockedRows[runtimeID]
+		if !locked || owner == tx {
+			break
+		}
+		s.rowUnlock.Wait()
+	}
+	s.lockedRows[runtimeID] = tx
+}
+
+func (s *store) unlockRows(tx *transaction) {
+	s.rowLock.Lock()
+	defer s.rowLock.Unlock()
+
+	for runtimeID, owner := range s.lockedRows {
+		if owner == tx {
+			delete(s.lockedRows, runtimeID)
+		}
+	}
+	s.rowUnlock.Broadcast()
+}
@@ -0,0 +1,65 @@
+package inmemory
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// normalizeLabels converts the labels to the values decoded from JSON, as the Postgres implementation returns them from the JSONB column
+func normalizeLabels(labels map[string]interface{}) (map[string]interface{}, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// contains follows the JSONB containment operator @>
+func contains(value, filter interface{}) bool {
+	switch filterValue := filter.(type) {
+	case map[string]interface{}:
+		object, ok := value.(map[string]interface{})
+		if !ok && value != nil {
+			return false
+		}
+		for key, element := range filterValue {
+			stored, found := object[key]
+			if !found || !contains(stored, element) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		array, ok := value.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, element := range filterValue {
+			if !containsElement(array, element) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(value, filter)
+	}
+}
+
+func containsElement(array []interface{}, filter interface{}) bool {
+	for _, element := range array {
+		if contains(element, filter) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,296 @@
+package inmemory
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+func (s *state) GetTenant(runtimeID string) (string, dberrors.Error) {
+	cluster, found := s.clusters[runtimeID]
+	if !found {
+		return "", dberrors.NotFound("Cannot find Tenant for runtimeID:'%s", runtimeID)
+	}
+
+	return cluster.Tenant, nil
+}
+
+func (s *state) GetTenantForOperation(operationID string) (string, dberrors.Error) {
+	operation, found := s.operations[operationID]
+	if !found {
+		return "", dberrors.NotFound("Cannot find Tenant for operationID:'%s", operationID)
+	}
+
+	return s.clusters[operation.ClusterID].Tenant, nil
+}
+
+func (s *state) GetOperationOwner(operationID string) (model.OperationOwner, dberrors.Error) {
+	operation, found := s.operations[operationID]
+	if !found {
+		return model.OperationOwner{}, dberrors.NotFound("Cannot find owner of operationID:'%s", operationID)
+	}
+	config, found := s.gardenerConfigs[operation.ClusterID]
+	if !found {
+		return model.OperationOwner{}, dberrors.NotFound("Cannot find owner of operationID:'%s", operationID)
+	}
+
+	return model.OperationOwner{
+		Tenant:      s.clusters[operation.ClusterID].Tenant,
+		Purpose:     config.Purpose,
+		LicenceType: config.LicenceType,
+	}, nil
+}
+
+func (s *state) GetCluster(runtimeID string) (model.Cluster, dberrors.Error) {
+	cluster, found := s.clusters[runtimeID]
+	if !found {
+		return model.Cluster{}, dberrors.NotFound("Cannot find Cluster for runtimeID: %s", runtimeID)
+	}
+
+	config, found := s.gardenerConfigs[runtimeID]
+	if !found {
+		return model.Cluster{}, dberrors.NotFound("Gardener config for %s Runtime not found", runtimeID).
+			Append("Cannot get Provider config for runtimeID: %s", runtimeID)
+	}
+	// Runtimes without OIDC config have the empty one, as the Postgres implementation
+	if config.OIDCConfig == nil {
+		config.OIDCConfig = &model.OIDCConfig{}
+	}
+	cluster.ClusterConfig = config
+
+	if cluster.ActiveKymaConfigId != nil {
+		kymaConfig, found := s.kymaConfigs[*cluster.ActiveKymaConfigId]
+		if !found {
+			return model.Cluster{}, dberrors.NotFound("Cannot find Kyma Config for runtimeID: %s", runtimeID).
+				Append("Cannot get Kyma config for runtimeID: %s", runtimeID)
+		}
+		cluster.KymaConfig = &kymaConfig
+	}
+
+	cluster.Administrators = append([]string{}, s.administrators[runtimeID]...)
+
+	return cluster, nil
+}
+
+func (s *state) GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error) {
+	for runtimeID, config := range s.gardenerConfigs {
+		cluster := s.clusters[runtimeID]
+		if config.Name != name || cluster.Deleted {
+			continue
+		}
+
+		// Only the Gardener config itself is read, without OIDC and DNS configs
+		config.OIDCConfig = nil
+		config.DNSConfig = nil
+		cluster.ClusterConfig = config
+
+		if cluster.ActiveKymaConfigId != nil {
+			kymaConfig, found := s.kymaConfigs[*cluster.ActiveKymaConfigId]
+			if !found {
+				return model.Cluster{}, dberrors.NotFound("Cannot find Kyma Config for runtimeID: %s", runtimeID).
+					Append("Cannot get Kyma config for runtimeID: %s", runtimeID)
+			}
+			cluster.KymaConfig = &kymaConfig
+		}
+
+		return cluster, nil
+	}
+
+	return model.Cluster{}, dberrors.NotFound("Cannot find Gardener Cluster with name: %s", name)
+}
+
+func (s *state) GetOperation(operationID string) (model.Operation, dberrors.Error) {
+	operation, found := s.operations[operationID]
+	if !found {
+		return model.Operation{}, dberrors.NotFound("Operation not found for id: %s", operationID)
+	}
+
+	return operation, nil
+}
+
+func (s *state) GetLastOperation(runtimeID string) (model.Operation, dberrors.Error) {
+	var lastOperation *model.Operation
+
+	for _, operation := range s.operations {
+		if operation.ClusterID != runtimeID {
+			continue
+		}
+		if lastOperation == nil || operation.StartTimestamp.After(lastOperation.StartTimestamp) {
+			lastOperation = &operation
+		}
+	}
+
+	if lastOperation == nil {
+		return model.Operation{}, dberrors.NotFound("Last operation not found for runtime: %s", runtimeID)
+	}
+
+	return *lastOperation, nil
+}
+
+func (s *state) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
+	var operations []model.Operation
+
+	for _, operation := range s.operations {
+		if operation.State == model.InProgress {
+			operations = append(operations, operation)
+		}
+	}
+
+	return operations, nil
+}
+
+// GetRuntimeUpgrade returns empty Runtime upgrade, as they are no longer created
+func (s *state) GetRuntimeUpgrade(string) (model.RuntimeUpgrade, dberrors.Error) {
+	return model.RuntimeUpgrade{}, nil
+}
+
+func (s *state) InProgressOperationsCount() (model.OperationsCount, dberrors.Error) {
+	operationsCount := model.OperationsCount{
+		Count: map[model.OperationType]int{},
+	}
+
+	for _, operation := range s.operations {
+		if operation.State == model.InProgress {
+			operationsCount.Count[operation.Type]++
+		}
+	}
+
+	return operationsCount, nil
+}
+
+func (s *state) ListAuditEvents(runtimeID string, from, to *time.Time) ([]model.AuditEvent, dberrors.Error) {
+	var events []model.AuditEvent
+
+	for _, event := range s.auditEvents {
+		if event.RuntimeID == nil || *event.RuntimeID != runtimeID {
+			continue
+		}
+		if from != nil && event.Timestamp.Before(*from) {
+			continue
+		}
+		if to != nil && event.Timestamp.After(*to) {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Timestamp.Before(events[j].Timestamp)
+	})
+
+	return events, nil
+}
+
+func (s *state) GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error) {
+	idempotencyKey, found := s.idempotencyKeys[idempotencyKeyID{tenant: tenant, key: key}]
+	if !found {
+		return model.IdempotencyKey{}, dberrors.NotFound("Idempotency key %s not found for tenant %s", key, tenant)
+	}
+
+	return idempotencyKey, nil
+}
+
+// GetTenantQuotaOverride returns not found error, as quota overrides are managed directly in the database
+func (s *state) GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, dberrors.Error) {
+	return model.TenantQuotaOverride{}, dberrors.NotFound("Quota override not found for tenant %s", tenant)
+}
+
+func (s *state) GetTenantQuotaUsage(tenant string) (model.QuotaValues, dberrors.Error) {
+	var usage model.QuotaValues
+
+	for runtimeID, cluster := range s.clusters {
+		if cluster.Tenant != tenant {
+			continue
+		}
+		config, found := s.gardenerConfigs[runtimeID]
+		if !cluster.Deleted && found {
+			usage.Runtimes++
+			usage.TotalMaxNodes += config.AutoScalerMax
+		}
+	}
+
+	for _, operation := range s.operations {
+		if operation.State == model.InProgress && s.clusters[operation.ClusterID].Tenant == tenant {
+			usage.ConcurrentOperations++
+		}
+	}
+
+	return usage, nil
+}
+
+func (s *state) HasInProgressOperation(runtimeID string) (bool, dberrors.Error) {
+	for _, operation := range s.operations {
+		if operation.ClusterID == runtimeID && operation.State == model.InProgress {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *state) getResourceVersion(runtimeID string) (int, dberrors.Error) {
+	config, found := s.gardenerConfigs[runtimeID]
+	if !found {
+		return 0, dberrors.NotFound("Gardener config for %s Runtime not found", runtimeID)
+	}
+
+	return config.ResourceVersion, nil
+}
+
+func (s *state) ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, dberrors.Error) {
+	return append([]model.GardenerConfigRevision{}, s.configRevisions[runtimeID]...), nil
+}
+
+func (s *state) GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, dberrors.Error) {
+	revisions := s.configRevisions[runtimeID]
+	if revision < 1 || revision > len(revisions) {
+		return model.GardenerConfigRevision{}, dberrors.NotFound("Gardener config revision %d of %s Runtime not found", revision, runtimeID)
+	}
+
+	return revisions[revision-1], nil
+}
+
+func (s *state) ListDriftItems(runtimeID string) ([]model.DriftItem, dberrors.Error) {
+	return slices.Clone(s.drift[runtimeID]), nil
+}
+
+func (s *state) ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, dberrors.Error) {
+	filter, err := normalizeLabels(labels)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode labels filter: %s", err)
+	}
+
+	var clusters []model.Cluster
+	for _, cluster := range s.clusters {
+		if cluster.Tenant == tenant && !cluster.Deleted && contains(cluster.Labels, filter) {
+			clusters = append(clusters, cluster)
+		}
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].CreationTimestamp.Before(clusters[j].CreationTimestamp)
+	})
+
+	runtimeIDs := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		runtimeIDs = append(runtimeIDs, cluster.ID)
+	}
+
+	return runtimeIDs, nil
+}
+
+func (s *state) ListAllDriftItems() ([]model.DriftItem, dberrors.Error) {
+	var items []model.DriftItem
+
+	for _, runtimeID := range slices.Sorted(maps.Keys(s.drift)) {
+		if cluster, found := s.clusters[runtimeID]; !found || cluster.Deleted {
+			continue
+		}
+		items = append(items, s.drift[runtimeID]...)
+	}
+
+	return items, nil
+}
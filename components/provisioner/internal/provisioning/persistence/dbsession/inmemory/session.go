@@ -0,0 +1,364 @@
+package inmemory
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+type change func(s *state) dberrors.Error
+
+type readSession struct {
+	store *store
+}
+
+func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error) {
+	return r.store.snapshot().GetCluster(runtimeID)
+}
+
+func (r readSession) GetOperation(operationID string) (model.Operation, dberrors.Error) {
+	return r.store.snapshot().GetOperation(operationID)
+}
+
+func (r readSession) GetLastOperation(runtimeID string) (model.Operation, dberrors.Error) {
+	return r.store.snapshot().GetLastOperation(runtimeID)
+}
+
+func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error) {
+	return r.store.snapshot().GetGardenerClusterByName(name)
+}
+
+func (r readSession) GetTenant(runtimeID string) (string, dberrors.Error) {
+	return r.store.snapshot().GetTenant(runtimeID)
+}
+
+func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
+	return r.store.snapshot().ListInProgressOperations()
+}
+
+func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error) {
+	return r.store.snapshot().GetRuntimeUpgrade(operationId)
+}
+
+func (r readSession) GetTenantForOperation(operationID string) (string, dberrors.Error) {
+	return r.store.snapshot().GetTenantForOperation(operationID)
+}
+
+func (r readSession) GetOperationOwner(operationID string) (model.OperationOwner, dberrors.Error) {
+	return r.store.snapshot().GetOperationOwner(operationID)
+}
+
+func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberrors.Error) {
+	return r.store.snapshot().InProgressOperationsCount()
+}
+
+func (r readSession) ListAuditEvents(runtimeID string, from, to *time.Time) ([]model.AuditEvent, dberrors.Error) {
+	return r.store.snapshot().ListAuditEvents(runtimeID, from, to)
+}
+
+func (r readSession) GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error) {
+	return r.store.snapshot().GetIdempotencyKey(tenant, key)
+}
+
+func (r readSession) GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, dberrors.Error) {
+	return r.store.snapshot().GetTenantQuotaOverride(tenant)
+}
+
+func (r readSession) GetTenantQuotaUsage(tenant string) (model.QuotaValues, dberrors.Error) {
+	return r.store.snapshot().GetTenantQuotaUsage(tenant)
+}
+
+func (r readSession) ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, dberrors.Error) {
+	return r.store.snapshot().ListGardenerConfigRevisions(runtimeID)
+}
+
+func (r readSession) GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, dberrors.Error) {
+	return r.store.snapshot().GetGardenerConfigRevision(runtimeID, revision)
+}
+
+func (r readSession) ListDriftItems(runtimeID string) ([]model.DriftItem, dberrors.Error) {
+	return r.store.snapshot().ListDriftItems(runtimeID)
+}
+
+func (r readSession) ListAllDriftItems() ([]model.DriftItem, dberrors.Error) {
+	return r.store.snapshot().ListAllDriftItems()
+}
+
+func (r readSession) ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, dberrors.Error) {
+	return r.store.snapshot().ListRuntimeIDsByLabels(tenant, labels)
+}
+
+// transaction collects changes, which are applied to the committed state on commit.
+// Within the transaction, the changes are visible on top of the latest committed state, as with the read committed isolation level.
+type transaction struct {
+	store *store
+
+	lock     sync.Mutex
+	changes  []change
+	failed   bool
+	finished bool
+}
+
+func (tx *transaction) view() (*state, dberrors.Error) {
+	view := tx.store.snapshot().clone()
+	for _, c := range tx.changes {
+		if err := c(view); err != nil {
+			return nil, err
+		}
+	}
+
+	return view, nil
+}
+
+func (tx *transaction) apply(c change) dberrors.Error {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	if tx.finished {
+		return dberrors.Internal("Transaction has already been finished")
+	}
+	if tx.failed {
+		return dberrors.Internal("Current transaction is aborted")
+	}
+
+	view, err := tx.view()
+	if err == nil {
+		err = c(view)
+	}
+	// As in Postgres, any failed statement aborts the transaction
+	if err != nil {
+		tx.failed = true
+		return err
+	}
+	tx.changes = append(tx.changes, c)
+
+	return nil
+}
+
+func (tx *transaction) lockRow(runtimeID string) dberrors.Error {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	if tx.finished {
+		return dberrors.Internal("Transaction has already been finished")
+	}
+	tx.store.lockRow(runtimeID, tx)
+
+	return nil
+}
+
+func (tx *transaction) read(query func(s *state)) dberrors.Error {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	if tx.finished {
+		return dberrors.Internal("Transaction has already been finished")
+	}
+
+	view, err := tx.view()
+	if err != nil {
+		return err
+	}
+	query(view)
+
+	return nil
+}
+
+func (tx *transaction) commit() dberrors.Error {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	if tx.finished {
+		return dberrors.Internal("Failed to commit transaction: transaction has already been finished")
+	}
+	tx.finished = true
+	defer tx.store.unlockRows(tx)
+
+	if tx.failed {
+		return dberrors.Internal("Failed to commit transaction: transaction is aborted")
+	}
+
+	if err := tx.store.write(tx.changes...); err != nil {
+		return err.Append("Failed to commit transaction")
+	}
+
+	return nil
+}
+
+func (tx *transaction) rollbackUnlessCommitted() {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+
+	if tx.finished {
+		return
+	}
+	tx.finished = true
+	tx.store.unlockRows(tx)
+}
+
+type writeSession struct {
+	store       *store
+	transaction *transaction
+}
+
+func (ws writeSession) write(c change) dberrors.Error {
+	if ws.transaction != nil {
+		return ws.transaction.apply(c)
+	}
+
+	return ws.store.write(c)
+}
+
+func (ws writeSession) InsertCluster(cluster model.Cluster) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertCluster(cluster) })
+}
+
+func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertGardenerConfig(config) })
+}
+
+func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateGardenerClusterConfig(config) })
+}
+
+func (ws writeSession) LockGardenerConfig(runtimeID string) (int, dberrors.Error) {
+	if ws.transaction == nil {
+		return 0, dberrors.Internal("Gardener config of %s Runtime can be locked only within transaction", runtimeID)
+	}
+
+	err := ws.transaction.lockRow(runtimeID)
+	if err != nil {
+		return 0, err
+	}
+
+	var resourceVersion int
+	var dberr dberrors.Error
+	err = ws.transaction.read(func(s *state) {
+		resourceVersion, dberr = s.getResourceVersion(runtimeID)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resourceVersion, dberr
+}
+
+func (ws writeSession) HasInProgressOperation(runtimeID string) (bool, dberrors.Error) {
+	if ws.transaction == nil {
+		return ws.store.snapshot().HasInProgressOperation(runtimeID)
+	}
+
+	var inProgress bool
+	var dberr dberrors.Error
+	err := ws.transaction.read(func(s *state) {
+		inProgress, dberr = s.HasInProgressOperation(runtimeID)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return inProgress, dberr
+}
+
+// LockTenantQuota returns empty quota override, as quota overrides are managed directly in the database
+func (ws writeSession) LockTenantQuota(tenant string) (model.TenantQuotaOverride, model.QuotaValues, dberrors.Error) {
+	if ws.transaction == nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, dberrors.Internal("Quota of tenant %s can be locked only within transaction", tenant)
+	}
+
+	err := ws.transaction.lockRow("tenant_quota:" + tenant)
+	if err != nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, err
+	}
+
+	var usage model.QuotaValues
+	var dberr dberrors.Error
+	err = ws.transaction.read(func(s *state) {
+		usage, dberr = s.GetTenantQuotaUsage(tenant)
+	})
+	if err != nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, err
+	}
+
+	return model.TenantQuotaOverride{}, usage, dberr
+}
+
+func (ws writeSession) InsertAdministrators(clusterId string, administrators []string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertAdministrators(clusterId, administrators) })
+}
+
+func (ws writeSession) InsertOperation(operation model.Operation) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertOperation(operation) })
+}
+
+func (ws writeSession) UpdateOperationState(operationID string, message string, operationState model.OperationState, endTime time.Time) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error {
+		return s.UpdateOperationState(operationID, message, operationState, endTime)
+	})
+}
+
+func (ws writeSession) UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateOperationLastError(operationID, msg, reason, component) })
+}
+
+func (ws writeSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error {
+		return s.TransitionOperation(operationID, message, stage, transitionTime)
+	})
+}
+
+func (ws writeSession) UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateKubeconfig(runtimeID, kubeconfig) })
+}
+
+func (ws writeSession) DeleteCluster(runtimeID string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.DeleteCluster(runtimeID) })
+}
+
+func (ws writeSession) MarkClusterAsDeleted(runtimeID string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.MarkClusterAsDeleted(runtimeID) })
+}
+
+func (ws writeSession) UpdateTenant(runtimeID string, tenant string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateTenant(runtimeID, tenant) })
+}
+
+func (ws writeSession) UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateKubernetesVersion(runtimeID, version) })
+}
+
+func (ws writeSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error {
+		return s.UpdateShootNetworkingFilterDisabled(runtimeID, shootNetworkingFilterDisabled)
+	})
+}
+
+func (ws writeSession) InsertAuditEvent(event model.AuditEvent) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertAuditEvent(event) })
+}
+
+func (ws writeSession) InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertIdempotencyKey(idempotencyKey) })
+}
+
+func (ws writeSession) InsertGardenerConfigRevision(revision model.GardenerConfigRevision) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.InsertGardenerConfigRevision(revision) })
+}
+
+func (ws writeSession) UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.UpdateRuntimeMetadata(runtimeID, labels, description) })
+}
+
+func (ws writeSession) ReplaceDriftItems(runtimeID string, items []model.DriftItem) dberrors.Error {
+	return ws.write(func(s *state) dberrors.Error { return s.ReplaceDriftItems(runtimeID, items) })
+}
+
+func (ws writeSession) Commit() dberrors.Error {
+	return ws.transaction.commit()
+}
+
+func (ws writeSession) RollbackUnlessCommitted() {
+	ws.transaction.rollbackUnlessCommitted()
+}
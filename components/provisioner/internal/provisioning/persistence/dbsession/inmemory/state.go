@@ -0,0 +1,57 @@
+package inmemory
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+type idempotencyKeyID struct {
+	tenant string
+	key    string
+}
+
+// state holds all tables of the database. Committed state is never modified, writes are applied to its clone which replaces it.
+type state struct {
+	// clusters are stored without configurations and administrators, which are kept in separate tables
+	clusters        map[string]model.Cluster
+	administrators  map[string][]string
+	gardenerConfigs map[string]model.GardenerConfig
+	kymaConfigs     map[string]model.KymaConfig
+	operations      map[string]model.Operation
+	auditEvents     []model.AuditEvent
+	idempotencyKeys map[idempotencyKeyID]model.IdempotencyKey
+	// configRevisions are ordered by the revision number
+	configRevisions map[string][]model.GardenerConfigRevision
+	// drift items are ordered by the field
+	drift map[string][]model.DriftItem
+}
+
+func newState() *state {
+	return &state{
+		clusters:        map[string]model.Cluster{},
+		administrators:  map[string][]string{},
+		gardenerConfigs: map[string]model.GardenerConfig{},
+		kymaConfigs:     map[string]model.KymaConfig{},
+		operations:      map[string]model.Operation{},
+		idempotencyKeys: map[idempotencyKeyID]model.IdempotencyKey{},
+		configRevisions: map[string][]model.GardenerConfigRevision{},
+		drift:           map[string][]model.DriftItem{},
+	}
+}
+
+// clone copies all tables. Stored values are replaced as a whole on every write, so they can be shared between the copies.
+func (s *state) clone() *state {
+	return &state{
+		clusters:        maps.Clone(s.clusters),
+		administrators:  maps.Clone(s.administrators),
+		gardenerConfigs: maps.Clone(s.gardenerConfigs),
+		kymaConfigs:     maps.Clone(s.kymaConfigs),
+		operations:      maps.Clone(s.operations),
+		auditEvents:     slices.Clone(s.auditEvents),
+		idempotencyKeys: maps.Clone(s.idempotencyKeys),
+		configRevisions: maps.Clone(s.configRevisions),
+		drift:           maps.Clone(s.drift),
+	}
+}
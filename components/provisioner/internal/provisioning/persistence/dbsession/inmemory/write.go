@@ -0,0 +1,314 @@
+package inmemory
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+func (s *state) InsertCluster(cluster model.Cluster) dberrors.Error {
+	if _, exists := s.clusters[cluster.ID]; exists {
+		return dberrors.AlreadyExists("Cluster with ID %s already exists", cluster.ID)
+	}
+
+	labels, err := normalizeLabels(cluster.Labels)
+	if err != nil {
+		return dberrors.Internal("Failed to encode labels of Cluster %s: %s", cluster.ID, err)
+	}
+
+	stored := model.Cluster{
+		ID:                cluster.ID,
+		CreationTimestamp: cluster.CreationTimestamp,
+		Tenant:            cluster.Tenant,
+		SubAccountId:      cluster.SubAccountId,
+		Description:       cluster.Description,
+		Labels:            labels,
+	}
+	if cluster.KymaConfig != nil {
+		kymaConfigID := cluster.KymaConfig.ID
+		stored.ActiveKymaConfigId = &kymaConfigID
+		s.kymaConfigs[kymaConfigID] = *cluster.KymaConfig
+	}
+	s.clusters[cluster.ID] = stored
+
+	return s.InsertAdministrators(cluster.ID, cluster.Administrators)
+}
+
+func (s *state) InsertAdministrators(clusterId string, administrators []string) dberrors.Error {
+	s.administrators[clusterId] = append([]string{}, administrators...)
+
+	return nil
+}
+
+func (s *state) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	if _, exists := s.clusters[config.ClusterID]; !exists {
+		return dberrors.Internal("Failed to insert record to GardenerConfig table: cluster %s does not exist", config.ClusterID)
+	}
+	if _, exists := s.gardenerConfigs[config.ClusterID]; exists {
+		return dberrors.AlreadyExists("Gardener config for %s Runtime already exists", config.ClusterID)
+	}
+	for _, existing := range s.gardenerConfigs {
+		if existing.ID == config.ID {
+			return dberrors.AlreadyExists("Gardener config with ID %s already exists", config.ID)
+		}
+	}
+
+	config.ResourceVersion = 1
+	s.gardenerConfigs[config.ClusterID] = config
+
+	return nil
+}
+
+func (s *state) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	stored, found := s.gardenerConfigs[config.ClusterID]
+	if !found {
+		return dberrors.NotFound("Failed to update record of configuration for gardener shoot cluster '%s' state", config.Name)
+	}
+
+	stored.KubernetesVersion = config.KubernetesVersion
+	stored.Purpose = config.Purpose
+	stored.Seed = config.Seed
+	stored.MachineType = config.MachineType
+	stored.MachineImage = config.MachineImage
+	stored.MachineImageVersion = config.MachineImageVersion
+	stored.DiskType = config.DiskType
+	stored.VolumeSizeGB = config.VolumeSizeGB
+	stored.AutoScalerMin = config.AutoScalerMin
+	stored.AutoScalerMax = config.AutoScalerMax
+	stored.MaxSurge = config.MaxSurge
+	stored.MaxUnavailable = config.MaxUnavailable
+	stored.EnableKubernetesVersionAutoUpdate = config.EnableKubernetesVersionAutoUpdate
+	stored.EnableMachineImageVersionAutoUpdate = config.EnableMachineImageVersionAutoUpdate
+	stored.ExposureClassName = config.ExposureClassName
+	stored.GardenerProviderConfig = config.GardenerProviderConfig
+	stored.ShootNetworkingFilterDisabled = config.ShootNetworkingFilterDisabled
+	stored.Extensions = config.Extensions
+	stored.Networking = config.Networking
+	stored.KubeAPIServer = config.KubeAPIServer
+	stored.ControlPlaneFailureTolerance = config.ControlPlaneFailureTolerance
+	stored.ResourceVersion++
+	if config.OIDCConfig != nil {
+		stored.OIDCConfig = config.OIDCConfig
+	}
+	s.gardenerConfigs[config.ClusterID] = stored
+
+	return nil
+}
+
+func (s *state) InsertOperation(operation model.Operation) dberrors.Error {
+	if _, exists := s.operations[operation.ID]; exists {
+		return dberrors.Internal("Failed to insert record to Type table: operation %s already exists", operation.ID)
+	}
+	if _, exists := s.clusters[operation.ClusterID]; !exists {
+		return dberrors.Internal("Failed to insert record to Type table: cluster %s does not exist", operation.ClusterID)
+	}
+
+	s.operations[operation.ID] = operation
+
+	return nil
+}
+
+func (s *state) UpdateOperationState(operationID string, message string, operationState model.OperationState, endTime time.Time) dberrors.Error {
+	return s.updateOperation(operationID, "Failed to update operation %s state", func(operation *model.Operation) {
+		operation.State = operationState
+		operation.Message = message
+		operation.EndTimestamp = &endTime
+	})
+}
+
+func (s *state) UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error {
+	return s.updateOperation(operationID, "Failed to update operation %s last error", func(operation *model.Operation) {
+		operation.LastError = model.LastError{
+			ErrMessage: msg,
+			Reason:     reason,
+			Component:  component,
+		}
+	})
+}
+
+func (s *state) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error {
+	return s.updateOperation(operationID, "Failed to update operation %s stage", func(operation *model.Operation) {
+		operation.Stage = stage
+		operation.Message = message
+		operation.LastTransition = &transitionTime
+	})
+}
+
+func (s *state) updateOperation(operationID, errorMsg string, update func(operation *model.Operation)) dberrors.Error {
+	operation, found := s.operations[operationID]
+	if !found {
+		return dberrors.NotFound(errorMsg, operationID)
+	}
+
+	update(&operation)
+	s.operations[operationID] = operation
+
+	return nil
+}
+
+func (s *state) UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error {
+	return s.updateCluster(runtimeID, func(cluster *model.Cluster) {
+		cluster.Kubeconfig = &kubeconfig
+		cluster.IsKubeconfigEncrypted = true
+	})
+}
+
+func (s *state) MarkClusterAsDeleted(runtimeID string) dberrors.Error {
+	return s.updateCluster(runtimeID, func(cluster *model.Cluster) {
+		cluster.Deleted = true
+	})
+}
+
+func (s *state) UpdateTenant(runtimeID string, tenant string) dberrors.Error {
+	return s.updateCluster(runtimeID, func(cluster *model.Cluster) {
+		cluster.Tenant = tenant
+	})
+}
+
+func (s *state) UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) dberrors.Error {
+	normalized, err := normalizeLabels(labels)
+	if err != nil {
+		return dberrors.Internal("Failed to encode labels of Cluster %s: %s", runtimeID, err)
+	}
+
+	return s.updateCluster(runtimeID, func(cluster *model.Cluster) {
+		cluster.Labels = normalized
+		cluster.Description = description
+	})
+}
+
+func (s *state) updateCluster(runtimeID string, update func(cluster *model.Cluster)) dberrors.Error {
+	cluster, found := s.clusters[runtimeID]
+	if !found {
+		return dberrors.NotFound("Failed to update cluster %s data", runtimeID)
+	}
+
+	update(&cluster)
+	s.clusters[runtimeID] = cluster
+
+	return nil
+}
+
+func (s *state) UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error {
+	return s.updateGardenerConfig(runtimeID, "Failed to update Kubernetes version in %s cluster", func(config *model.GardenerConfig) {
+		config.KubernetesVersion = version
+	})
+}
+
+func (s *state) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error {
+	return s.updateGardenerConfig(runtimeID, "Failed to update shoot networking filter disabled in %s cluster", func(config *model.GardenerConfig) {
+		config.ShootNetworkingFilterDisabled = shootNetworkingFilterDisabled
+	})
+}
+
+func (s *state) updateGardenerConfig(runtimeID, errorMsg string, update func(config *model.GardenerConfig)) dberrors.Error {
+	config, found := s.gardenerConfigs[runtimeID]
+	if !found {
+		return dberrors.NotFound(errorMsg, runtimeID)
+	}
+
+	update(&config)
+	s.gardenerConfigs[runtimeID] = config
+
+	return nil
+}
+
+// DeleteCluster deletes the cluster with its configs and operations. Administrators are kept, as in the Postgres implementation.
+func (s *state) DeleteCluster(runtimeID string) dberrors.Error {
+	if _, found := s.clusters[runtimeID]; !found {
+		return dberrors.NotFound("Runtime with ID %s not found", runtimeID)
+	}
+
+	delete(s.clusters, runtimeID)
+	delete(s.gardenerConfigs, runtimeID)
+	delete(s.configRevisions, runtimeID)
+	delete(s.drift, runtimeID)
+	for id, kymaConfig := range s.kymaConfigs {
+		if kymaConfig.ClusterID == runtimeID {
+			delete(s.kymaConfigs, id)
+		}
+	}
+	for id, operation := range s.operations {
+		if operation.ClusterID != runtimeID {
+			continue
+		}
+		delete(s.operations, id)
+		for keyID, idempotencyKey := range s.idempotencyKeys {
+			if idempotencyKey.OperationID == id {
+				delete(s.idempotencyKeys, keyID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *state) InsertAuditEvent(event model.AuditEvent) dberrors.Error {
+	for _, existing := range s.auditEvents {
+		if existing.ID == event.ID {
+			return dberrors.Internal("Failed to insert record to api_audit_event table: event %s already exists", event.ID)
+		}
+	}
+
+	s.auditEvents = append(s.auditEvents, event)
+
+	return nil
+}
+
+func (s *state) InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) dberrors.Error {
+	id := idempotencyKeyID{tenant: idempotencyKey.Tenant, key: idempotencyKey.Key}
+	if _, exists := s.idempotencyKeys[id]; exists {
+		return dberrors.AlreadyExists("Idempotency key %s already exists for tenant %s", idempotencyKey.Key, idempotencyKey.Tenant)
+	}
+	if _, exists := s.operations[idempotencyKey.OperationID]; !exists {
+		return dberrors.Internal("Failed to insert record to idempotency_key table: operation %s does not exist", idempotencyKey.OperationID)
+	}
+
+	s.idempotencyKeys[id] = idempotencyKey
+
+	return nil
+}
+
+func (s *state) InsertGardenerConfigRevision(revision model.GardenerConfigRevision) dberrors.Error {
+	if _, found := s.clusters[revision.RuntimeID]; !found {
+		return dberrors.Internal("Failed to insert record to gardener_config_revision table: cluster %s does not exist", revision.RuntimeID)
+	}
+
+	revisions := s.configRevisions[revision.RuntimeID]
+	revision.Revision = len(revisions) + 1
+	// Clipping makes append allocate a new array, so that the revisions of the committed state are not modified
+	s.configRevisions[revision.RuntimeID] = append(slices.Clip(revisions), revision)
+
+	return nil
+}
+
+func (s *state) ReplaceDriftItems(runtimeID string, items []model.DriftItem) dberrors.Error {
+	if len(items) == 0 {
+		delete(s.drift, runtimeID)
+		return nil
+	}
+
+	if _, found := s.clusters[runtimeID]; !found {
+		return dberrors.Internal("Failed to insert record to runtime_drift table: cluster %s does not exist", runtimeID)
+	}
+
+	drift := make([]model.DriftItem, 0, len(items))
+	for _, item := range items {
+		item.RuntimeID = runtimeID
+		drift = append(drift, item)
+	}
+	sort.SliceStable(drift, func(i, j int) bool {
+		return drift[i].Field < drift[j].Field
+	})
+	for i := 1; i < len(drift); i++ {
+		if drift[i].Field == drift[i-1].Field {
+			return dberrors.Internal("Failed to insert record to runtime_drift table: duplicated field %s", drift[i].Field)
+		}
+	}
+	s.drift[runtimeID] = drift
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package dbsession
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name=KeyProvider
+type KeyProvider interface {
+	// Keyring returns keys which encrypt and decrypt the stored secrets. Keys can change between the calls.
+	Keyring() (*Keyring, error)
+}
+
+const (
+	StaticKeyProviderType   = "static"
+	FileKeyProviderType     = "file"
+	EnvelopeKeyProviderType = "envelope"
+)
+
+// KeyProviderConfig selects the key provider and holds its settings, so that all binaries accessing the database read the keys in the same way
+type KeyProviderConfig struct {
+	Type           string
+	SecretKey      string
+	SecretKeys     string
+	SecretKeysPath string
+	// WrappedSecretKeys are data keys wrapped by the KMS in the "id=key,id=key" format, used by the envelope provider
+	WrappedSecretKeys string
+	KMSEndpoint       string
+	KMSTokenPath      string
+	// GCMWritesEnabled switches encryption of new data from AES-CFB to AES-GCM. Enable it only when no version which cannot read AES-GCM accesses the database.
+	GCMWritesEnabled bool
+}
+
+func NewKeyProvider(config KeyProviderConfig) (KeyProvider, error) {
+	provider, err := newKeyProvider(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.GCMWritesEnabled {
+		return provider, nil
+	}
+	if config.SecretKey == "" {
+		return nil, errors.New("legacy encryption key is required until AES-GCM writes are enabled")
+	}
+
+	return &legacyWritesKeyProvider{provider: provider}, nil
+}
+
+func newKeyProvider(config KeyProviderConfig) (KeyProvider, error) {
+	switch config.Type {
+	case StaticKeyProviderType:
+		keys, err := ParseKeys(config.SecretKeys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse database encryption keys: %s", err.Error())
+		}
+		return NewStaticKeyProvider(config.SecretKey, keys)
+	case FileKeyProviderType:
+		return NewFileKeyProvider(config.SecretKeysPath, config.SecretKey)
+	case EnvelopeKeyProviderType:
+		wrappedKeys, err := ParseKeys(config.WrappedSecretKeys)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse wrapped database encryption keys: %s", err.Error())
+		}
+		kms, err := NewHTTPKMS(config.KMSEndpoint, config.KMSTokenPath)
+		if err != nil {
+			return nil, err
+		}
+		return NewEnvelopeKeyProvider(kms, config.SecretKey, wrappedKeys)
+	default:
+		return nil, fmt.Errorf("unknown database encryption key provider %s", config.Type)
+	}
+}
+
+// legacyWritesKeyProvider provides keys of the wrapped provider, which encrypt with the legacy key
+type legacyWritesKeyProvider struct {
+	provider KeyProvider
+}
+
+func (p *legacyWritesKeyProvider) Keyring() (*Keyring, error) {
+	keyring, err := p.provider.Keyring()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyring.withLegacyWrites(), nil
+}
+
+type staticKeyProvider struct {
+	keyring *Keyring
+}
+
+// NewStaticKeyProvider provides keys passed in the configuration
+func NewStaticKeyProvider(legacyKey string, keys []Key) (KeyProvider, error) {
+	keyring, err := NewKeyring(legacyKey, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &staticKeyProvider{keyring: keyring}, nil
+}
+
+func (p *staticKeyProvider) Keyring() (*Keyring, error) {
+	return p.keyring, nil
+}
+
+const fileCheckInterval = 10 * time.Second
+
+type fileKeyProvider struct {
+	path      string
+	legacyKey string
+	now       func() time.Time
+
+	lock        sync.Mutex
+	keyring     *Keyring
+	modTime     time.Time
+	lastChecked time.Time
+}
+
+// NewFileKeyProvider provides keys read from the file in the format accepted by ParseKeys. The file is read again when it is modified, so that keys mounted from a Secret can be rotated without restart.
+func NewFileKeyProvider(path, legacyKey string) (KeyProvider, error) {
+	provider := &fileKeyProvider{
+		path:      path,
+		legacyKey: legacyKey,
+		now:       time.Now,
+	}
+
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (p *fileKeyProvider) Keyring() (*Keyring, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.now().Sub(p.lastChecked) < fileCheckInterval {
+		return p.keyring, nil
+	}
+
+	// Keys which failed to load are not used, the last valid keys are provided instead
+	if err := p.reload(); err != nil {
+		log.Errorf("Failed to reload encryption keys from %s: %s", p.path, err.Error())
+	}
+
+	return p.keyring, nil
+}
+
+func (p *fileKeyProvider) reload() error {
+	p.lastChecked = p.now()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return errors.Wrap(err, "while checking encryption keys file")
+	}
+	if p.keyring != nil && info.ModTime().Equal(p.modTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return errors.Wrap(err, "while reading encryption keys file")
+	}
+	keys, err := ParseKeys(string(content))
+	if err != nil {
+		return errors.Wrap(err, "while parsing encryption keys file")
+	}
+	keyring, err := NewKeyring(p.legacyKey, keys)
+	if err != nil {
+		return errors.Wrap(err, "while creating keyring from encryption keys file")
+	}
+
+	p.keyring = keyring
+	p.modTime = info.ModTime()
+
+	return nil
+}
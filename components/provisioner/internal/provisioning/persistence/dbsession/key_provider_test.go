@@ -0,0 +1,141 @@
+package dbsession
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticKeyProvider(t *testing.T) {
+	t.Run("should provide keyring with configured keys", func(t *testing.T) {
+		// given
+		provider, err := NewStaticKeyProvider(legacySecretKey, []Key{{ID: "2024", Value: []byte(newSecretKey)}})
+		require.NoError(t, err)
+
+		// when
+		keyring, err := provider.Keyring()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2024", keyring.primaryKeyID)
+		assert.Equal(t, []byte(legacySecretKey), keyring.legacyKey)
+	})
+
+	t.Run("should return error when no keys are configured", func(t *testing.T) {
+		// when
+		_, err := NewStaticKeyProvider("", nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestFileKeyProvider(t *testing.T) {
+	t.Run("should reload keys when file is modified", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "keys")
+		writeKeysFile(t, path, "2023="+legacySecretKey, time.Now().Add(-time.Hour))
+
+		provider, err := NewFileKeyProvider(path, "")
+		require.NoError(t, err)
+
+		now := time.Now()
+		provider.(*fileKeyProvider).now = func() time.Time { return now }
+
+		keyring, err := provider.Keyring()
+		require.NoError(t, err)
+		assert.Equal(t, "2023", keyring.primaryKeyID)
+
+		// when
+		writeKeysFile(t, path, "2023="+legacySecretKey+"\n2024="+newSecretKey+"\n", time.Now())
+		now = now.Add(fileCheckInterval)
+
+		keyring, err = provider.Keyring()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2024", keyring.primaryKeyID)
+		assert.Len(t, keyring.keys, 2)
+	})
+
+	t.Run("should keep last valid keys when file is invalid", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "keys")
+		writeKeysFile(t, path, "2023="+legacySecretKey, time.Now().Add(-time.Hour))
+
+		provider, err := NewFileKeyProvider(path, "")
+		require.NoError(t, err)
+
+		now := time.Now()
+		provider.(*fileKeyProvider).now = func() time.Time { return now }
+
+		// when
+		writeKeysFile(t, path, "2024=too-short", time.Now())
+		now = now.Add(fileCheckInterval)
+
+		keyring, err := provider.Keyring()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "2023", keyring.primaryKeyID)
+	})
+
+	t.Run("should return error when file does not exist", func(t *testing.T) {
+		// when
+		_, err := NewFileKeyProvider(filepath.Join(t.TempDir(), "missing"), "")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func writeKeysFile(t *testing.T, path, content string, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestNewKeyProvider(t *testing.T) {
+	t.Run("should create static key provider", func(t *testing.T) {
+		// when
+		provider, err := NewKeyProvider(KeyProviderConfig{Type: StaticKeyProviderType, SecretKeys: "2024=" + newSecretKey, GCMWritesEnabled: true})
+
+		// then
+		require.NoError(t, err)
+		keyring, err := provider.Keyring()
+		require.NoError(t, err)
+		assert.Equal(t, "2024", keyring.primaryKeyID)
+		assert.False(t, keyring.legacyWrites)
+	})
+
+	t.Run("should encrypt with legacy key until AES-GCM writes are enabled", func(t *testing.T) {
+		// when
+		provider, err := NewKeyProvider(KeyProviderConfig{Type: StaticKeyProviderType, SecretKey: legacySecretKey, SecretKeys: "2024=" + newSecretKey})
+
+		// then
+		require.NoError(t, err)
+		keyring, err := provider.Keyring()
+		require.NoError(t, err)
+		assert.True(t, keyring.legacyWrites)
+		assert.Equal(t, "2024", keyring.primaryKeyID)
+	})
+
+	t.Run("should return error when legacy key is missing and AES-GCM writes are disabled", func(t *testing.T) {
+		// when
+		_, err := NewKeyProvider(KeyProviderConfig{Type: StaticKeyProviderType, SecretKeys: "2024=" + newSecretKey})
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error for unknown key provider", func(t *testing.T) {
+		// when
+		_, err := NewKeyProvider(KeyProviderConfig{Type: "unknown"})
+
+		// then
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,103 @@
+package dbsession
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const kmsRequestTimeout = 10 * time.Second
+
+type httpKMS struct {
+	endpoint  string
+	tokenPath string
+	client    *http.Client
+}
+
+type kmsRequest struct {
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+// NewHTTPKMS calls the KMS endpoint which wraps data keys with POST <endpoint>/encrypt and unwraps them with POST <endpoint>/decrypt.
+// Both calls take and return JSON objects with base64 encoded "plaintext" and "ciphertext" fields.
+// The token file, if set, is read on every call and sent as the bearer token, so that rotated projected service account tokens are used.
+func NewHTTPKMS(endpoint, tokenPath string) (KMS, error) {
+	if endpoint == "" {
+		return nil, errors.New("KMS endpoint is empty")
+	}
+
+	return &httpKMS{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		tokenPath: tokenPath,
+		client:    &http.Client{Timeout: kmsRequestTimeout},
+	}, nil
+}
+
+func (k *httpKMS) Encrypt(dataKey []byte) ([]byte, error) {
+	response, err := k.call("encrypt", kmsRequest{Plaintext: dataKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Ciphertext) == 0 {
+		return nil, errors.New("KMS returned empty ciphertext")
+	}
+
+	return response.Ciphertext, nil
+}
+
+func (k *httpKMS) Decrypt(wrappedDataKey []byte) ([]byte, error) {
+	response, err := k.call("decrypt", kmsRequest{Ciphertext: wrappedDataKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Plaintext) == 0 {
+		return nil, errors.New("KMS returned empty plaintext")
+	}
+
+	return response.Plaintext, nil
+}
+
+func (k *httpKMS) call(operation string, body kmsRequest) (kmsRequest, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return kmsRequest{}, errors.Wrap(err, "while encoding KMS request")
+	}
+
+	request, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/%s", k.endpoint, operation), bytes.NewReader(payload))
+	if err != nil {
+		return kmsRequest{}, errors.Wrap(err, "while creating KMS request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	if k.tokenPath != "" {
+		token, err := os.ReadFile(k.tokenPath)
+		if err != nil {
+			return kmsRequest{}, errors.Wrap(err, "while reading KMS token")
+		}
+		request.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	}
+
+	response, err := k.client.Do(request)
+	if err != nil {
+		return kmsRequest{}, errors.Wrapf(err, "while calling KMS to %s data key", operation)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return kmsRequest{}, fmt.Errorf("KMS failed to %s data key with status %d", operation, response.StatusCode)
+	}
+
+	var decoded kmsRequest
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return kmsRequest{}, errors.Wrap(err, "while decoding KMS response")
+	}
+
+	return decoded, nil
+}
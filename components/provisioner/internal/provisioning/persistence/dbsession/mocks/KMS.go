@@ -0,0 +1,76 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// KMS is an autogenerated mock type for the KMS type
+type KMS struct {
+	mock.Mock
+}
+
+// Decrypt provides a mock function with given fields: wrappedDataKey
+func (_m *KMS) Decrypt(wrappedDataKey []byte) ([]byte, error) {
+	ret := _m.Called(wrappedDataKey)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(wrappedDataKey)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(wrappedDataKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(wrappedDataKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Encrypt provides a mock function with given fields: dataKey
+func (_m *KMS) Encrypt(dataKey []byte) ([]byte, error) {
+	ret := _m.Called(dataKey)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]byte) ([]byte, error)); ok {
+		return rf(dataKey)
+	}
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(dataKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(dataKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewKMS creates a new instance of KMS. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKMS(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KMS {
+	mock := &KMS{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dbsession "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KeyProvider is an autogenerated mock type for the KeyProvider type
+type KeyProvider struct {
+	mock.Mock
+}
+
+// Keyring provides a mock function with given fields:
+func (_m *KeyProvider) Keyring() (*dbsession.Keyring, error) {
+	ret := _m.Called()
+
+	var r0 *dbsession.Keyring
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*dbsession.Keyring, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *dbsession.Keyring); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*dbsession.Keyring)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewKeyProvider creates a new instance of KeyProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeyProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeyProvider {
+	mock := &KeyProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	time "time"
 )
 
 // ReadSession is an autogenerated mock type for the ReadSession type
@@ -67,6 +69,58 @@ func (_m *ReadSession) GetGardenerClusterByName(name string) (model.Cluster, app
 	return r0, r1
 }
 
+// GetGardenerConfigRevision provides a mock function with given fields: runtimeID, revision
+func (_m *ReadSession) GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, apperrors.AppError) {
+	ret := _m.Called(runtimeID, revision)
+
+	var r0 model.GardenerConfigRevision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int) (model.GardenerConfigRevision, apperrors.AppError)); ok {
+		return rf(runtimeID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) model.GardenerConfigRevision); ok {
+		r0 = rf(runtimeID, revision)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfigRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) apperrors.AppError); ok {
+		r1 = rf(runtimeID, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *ReadSession) GetIdempotencyKey(tenant string, key string) (model.IdempotencyKey, apperrors.AppError) {
+	ret := _m.Called(tenant, key)
+
+	var r0 model.IdempotencyKey
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) (model.IdempotencyKey, apperrors.AppError)); ok {
+		return rf(tenant, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) model.IdempotencyKey); ok {
+		r0 = rf(tenant, key)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(tenant, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetLastOperation provides a mock function with given fields: runtimeID
 func (_m *ReadSession) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -119,6 +173,32 @@ func (_m *ReadSession) GetOperation(operationID string) (model.Operation, apperr
 	return r0, r1
 }
 
+// GetOperationOwner provides a mock function with given fields: operationID
+func (_m *ReadSession) GetOperationOwner(operationID string) (model.OperationOwner, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.OperationOwner
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.OperationOwner, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.OperationOwner); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.OperationOwner)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetRuntimeUpgrade provides a mock function with given fields: operationId
 func (_m *ReadSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, apperrors.AppError) {
 	ret := _m.Called(operationId)
@@ -197,6 +277,58 @@ func (_m *ReadSession) GetTenantForOperation(operationID string) (string, apperr
 	return r0, r1
 }
 
+// GetTenantQuotaOverride provides a mock function with given fields: tenant
+func (_m *ReadSession) GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.TenantQuotaOverride
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.TenantQuotaOverride, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.TenantQuotaOverride); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.TenantQuotaOverride)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTenantQuotaUsage provides a mock function with given fields: tenant
+func (_m *ReadSession) GetTenantQuotaUsage(tenant string) (model.QuotaValues, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.QuotaValues
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.QuotaValues, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.QuotaValues); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.QuotaValues)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InProgressOperationsCount provides a mock function with given fields:
 func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, apperrors.AppError) {
 	ret := _m.Called()
@@ -223,6 +355,118 @@ func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, apper
 	return r0, r1
 }
 
+// ListAllDriftItems provides a mock function with given fields:
+func (_m *ReadSession) ListAllDriftItems() ([]model.DriftItem, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []model.DriftItem
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func() ([]model.DriftItem, apperrors.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.DriftItem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DriftItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: runtimeID, from, to
+func (_m *ReadSession) ListAuditEvents(runtimeID string, from *time.Time, to *time.Time) ([]model.AuditEvent, apperrors.AppError) {
+	ret := _m.Called(runtimeID, from, to)
+
+	var r0 []model.AuditEvent
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) ([]model.AuditEvent, apperrors.AppError)); ok {
+		return rf(runtimeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) []model.AuditEvent); ok {
+		r0 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time) apperrors.AppError); ok {
+		r1 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListDriftItems provides a mock function with given fields: runtimeID
+func (_m *ReadSession) ListDriftItems(runtimeID string) ([]model.DriftItem, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.DriftItem
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.DriftItem, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.DriftItem); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DriftItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGardenerConfigRevisions provides a mock function with given fields: runtimeID
+func (_m *ReadSession) ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.GardenerConfigRevision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.GardenerConfigRevision, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.GardenerConfigRevision); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfigRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -251,6 +495,34 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.
 	return r0, r1
 }
 
+// ListRuntimeIDsByLabels provides a mock function with given fields: tenant, labels
+func (_m *ReadSession) ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, apperrors.AppError) {
+	ret := _m.Called(tenant, labels)
+
+	var r0 []string
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) ([]string, apperrors.AppError)); ok {
+		return rf(tenant, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) []string); ok {
+		r0 = rf(tenant, labels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) apperrors.AppError); ok {
+		r1 = rf(tenant, labels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // NewReadSession creates a new instance of ReadSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewReadSession(t interface {
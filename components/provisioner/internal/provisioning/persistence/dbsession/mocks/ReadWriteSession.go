@@ -85,6 +85,58 @@ func (_m *ReadWriteSession) GetGardenerClusterByName(name string) (model.Cluster
 	return r0, r1
 }
 
+// GetGardenerConfigRevision provides a mock function with given fields: runtimeID, revision
+func (_m *ReadWriteSession) GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, apperrors.AppError) {
+	ret := _m.Called(runtimeID, revision)
+
+	var r0 model.GardenerConfigRevision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int) (model.GardenerConfigRevision, apperrors.AppError)); ok {
+		return rf(runtimeID, revision)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) model.GardenerConfigRevision); ok {
+		r0 = rf(runtimeID, revision)
+	} else {
+		r0 = ret.Get(0).(model.GardenerConfigRevision)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) apperrors.AppError); ok {
+		r1 = rf(runtimeID, revision)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetIdempotencyKey provides a mock function with given fields: tenant, key
+func (_m *ReadWriteSession) GetIdempotencyKey(tenant string, key string) (model.IdempotencyKey, apperrors.AppError) {
+	ret := _m.Called(tenant, key)
+
+	var r0 model.IdempotencyKey
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) (model.IdempotencyKey, apperrors.AppError)); ok {
+		return rf(tenant, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) model.IdempotencyKey); ok {
+		r0 = rf(tenant, key)
+	} else {
+		r0 = ret.Get(0).(model.IdempotencyKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(tenant, key)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetLastOperation provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) GetLastOperation(runtimeID string) (model.Operation, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -137,6 +189,32 @@ func (_m *ReadWriteSession) GetOperation(operationID string) (model.Operation, a
 	return r0, r1
 }
 
+// GetOperationOwner provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetOperationOwner(operationID string) (model.OperationOwner, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.OperationOwner
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.OperationOwner, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.OperationOwner); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.OperationOwner)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetRuntimeUpgrade provides a mock function with given fields: operationId
 func (_m *ReadWriteSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, apperrors.AppError) {
 	ret := _m.Called(operationId)
@@ -215,6 +293,84 @@ func (_m *ReadWriteSession) GetTenantForOperation(operationID string) (string, a
 	return r0, r1
 }
 
+// GetTenantQuotaOverride provides a mock function with given fields: tenant
+func (_m *ReadWriteSession) GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.TenantQuotaOverride
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.TenantQuotaOverride, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.TenantQuotaOverride); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.TenantQuotaOverride)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTenantQuotaUsage provides a mock function with given fields: tenant
+func (_m *ReadWriteSession) GetTenantQuotaUsage(tenant string) (model.QuotaValues, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.QuotaValues
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.QuotaValues, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.QuotaValues); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.QuotaValues)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// HasInProgressOperation provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) HasInProgressOperation(runtimeID string) (bool, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (bool, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InProgressOperationsCount provides a mock function with given fields:
 func (_m *ReadWriteSession) InProgressOperationsCount() (model.OperationsCount, apperrors.AppError) {
 	ret := _m.Called()
@@ -257,6 +413,22 @@ func (_m *ReadWriteSession) InsertAdministrators(clusterId string, administrator
 	return r0
 }
 
+// InsertAuditEvent provides a mock function with given fields: event
+func (_m *ReadWriteSession) InsertAuditEvent(event model.AuditEvent) apperrors.AppError {
+	ret := _m.Called(event)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.AuditEvent) apperrors.AppError); ok {
+		r0 = rf(event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertCluster provides a mock function with given fields: cluster
 func (_m *ReadWriteSession) InsertCluster(cluster model.Cluster) apperrors.AppError {
 	ret := _m.Called(cluster)
@@ -289,6 +461,38 @@ func (_m *ReadWriteSession) InsertGardenerConfig(config model.GardenerConfig) ap
 	return r0
 }
 
+// InsertGardenerConfigRevision provides a mock function with given fields: revision
+func (_m *ReadWriteSession) InsertGardenerConfigRevision(revision model.GardenerConfigRevision) apperrors.AppError {
+	ret := _m.Called(revision)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.GardenerConfigRevision) apperrors.AppError); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertIdempotencyKey provides a mock function with given fields: idempotencyKey
+func (_m *ReadWriteSession) InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) apperrors.AppError {
+	ret := _m.Called(idempotencyKey)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.IdempotencyKey) apperrors.AppError); ok {
+		r0 = rf(idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertOperation provides a mock function with given fields: operation
 func (_m *ReadWriteSession) InsertOperation(operation model.Operation) apperrors.AppError {
 	ret := _m.Called(operation)
@@ -305,6 +509,118 @@ func (_m *ReadWriteSession) InsertOperation(operation model.Operation) apperrors
 	return r0
 }
 
+// ListAllDriftItems provides a mock function with given fields:
+func (_m *ReadWriteSession) ListAllDriftItems() ([]model.DriftItem, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []model.DriftItem
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func() ([]model.DriftItem, apperrors.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.DriftItem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DriftItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListAuditEvents provides a mock function with given fields: runtimeID, from, to
+func (_m *ReadWriteSession) ListAuditEvents(runtimeID string, from *time.Time, to *time.Time) ([]model.AuditEvent, apperrors.AppError) {
+	ret := _m.Called(runtimeID, from, to)
+
+	var r0 []model.AuditEvent
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) ([]model.AuditEvent, apperrors.AppError)); ok {
+		return rf(runtimeID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, *time.Time, *time.Time) []model.AuditEvent); ok {
+		r0 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AuditEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *time.Time, *time.Time) apperrors.AppError); ok {
+		r1 = rf(runtimeID, from, to)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListDriftItems provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) ListDriftItems(runtimeID string) ([]model.DriftItem, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.DriftItem
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.DriftItem, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.DriftItem); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.DriftItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGardenerConfigRevisions provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 []model.GardenerConfigRevision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.GardenerConfigRevision, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.GardenerConfigRevision); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfigRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -333,6 +649,93 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListRuntimeIDsByLabels provides a mock function with given fields: tenant, labels
+func (_m *ReadWriteSession) ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, apperrors.AppError) {
+	ret := _m.Called(tenant, labels)
+
+	var r0 []string
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) ([]string, apperrors.AppError)); ok {
+		return rf(tenant, labels)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}) []string); ok {
+		r0 = rf(tenant, labels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[string]interface{}) apperrors.AppError); ok {
+		r1 = rf(tenant, labels)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockGardenerConfig provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) LockGardenerConfig(runtimeID string) (int, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 int
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (int, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockTenantQuota provides a mock function with given fields: tenant
+func (_m *ReadWriteSession) LockTenantQuota(tenant string) (model.TenantQuotaOverride, model.QuotaValues, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.TenantQuotaOverride
+	var r1 model.QuotaValues
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.TenantQuotaOverride, model.QuotaValues, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.TenantQuotaOverride); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.TenantQuotaOverride)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) model.QuotaValues); ok {
+		r1 = rf(tenant)
+	} else {
+		r1 = ret.Get(1).(model.QuotaValues)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) apperrors.AppError); ok {
+		r2 = rf(tenant)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -349,6 +752,22 @@ func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.App
 	return r0
 }
 
+// ReplaceDriftItems provides a mock function with given fields: runtimeID, items
+func (_m *ReadWriteSession) ReplaceDriftItems(runtimeID string, items []model.DriftItem) apperrors.AppError {
+	ret := _m.Called(runtimeID, items)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.DriftItem) apperrors.AppError); ok {
+		r0 = rf(runtimeID, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *ReadWriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -445,6 +864,22 @@ func (_m *ReadWriteSession) UpdateOperationState(operationID string, message str
 	return r0
 }
 
+// UpdateRuntimeMetadata provides a mock function with given fields: runtimeID, labels, description
+func (_m *ReadWriteSession) UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) apperrors.AppError {
+	ret := _m.Called(runtimeID, labels, description)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, *string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, labels, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateShootNetworkingFilterDisabled provides a mock function with given fields: runtimeID, shootNetworkingFilterDisabled
 func (_m *ReadWriteSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) apperrors.AppError {
 	ret := _m.Called(runtimeID, shootNetworkingFilterDisabled)
@@ -33,6 +33,32 @@ func (_m *WriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	return r0
 }
 
+// HasInProgressOperation provides a mock function with given fields: runtimeID
+func (_m *WriteSession) HasInProgressOperation(runtimeID string) (bool, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (bool, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // InsertAdministrators provides a mock function with given fields: clusterId, administrators
 func (_m *WriteSession) InsertAdministrators(clusterId string, administrators []string) apperrors.AppError {
 	ret := _m.Called(clusterId, administrators)
@@ -49,6 +75,22 @@ func (_m *WriteSession) InsertAdministrators(clusterId string, administrators []
 	return r0
 }
 
+// InsertAuditEvent provides a mock function with given fields: event
+func (_m *WriteSession) InsertAuditEvent(event model.AuditEvent) apperrors.AppError {
+	ret := _m.Called(event)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.AuditEvent) apperrors.AppError); ok {
+		r0 = rf(event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertCluster provides a mock function with given fields: cluster
 func (_m *WriteSession) InsertCluster(cluster model.Cluster) apperrors.AppError {
 	ret := _m.Called(cluster)
@@ -81,6 +123,38 @@ func (_m *WriteSession) InsertGardenerConfig(config model.GardenerConfig) apperr
 	return r0
 }
 
+// InsertGardenerConfigRevision provides a mock function with given fields: revision
+func (_m *WriteSession) InsertGardenerConfigRevision(revision model.GardenerConfigRevision) apperrors.AppError {
+	ret := _m.Called(revision)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.GardenerConfigRevision) apperrors.AppError); ok {
+		r0 = rf(revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertIdempotencyKey provides a mock function with given fields: idempotencyKey
+func (_m *WriteSession) InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) apperrors.AppError {
+	ret := _m.Called(idempotencyKey)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.IdempotencyKey) apperrors.AppError); ok {
+		r0 = rf(idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // InsertOperation provides a mock function with given fields: operation
 func (_m *WriteSession) InsertOperation(operation model.Operation) apperrors.AppError {
 	ret := _m.Called(operation)
@@ -97,6 +171,65 @@ func (_m *WriteSession) InsertOperation(operation model.Operation) apperrors.App
 	return r0
 }
 
+// LockGardenerConfig provides a mock function with given fields: runtimeID
+func (_m *WriteSession) LockGardenerConfig(runtimeID string) (int, apperrors.AppError) {
+	ret := _m.Called(runtimeID)
+
+	var r0 int
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (int, apperrors.AppError)); ok {
+		return rf(runtimeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(runtimeID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(runtimeID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// LockTenantQuota provides a mock function with given fields: tenant
+func (_m *WriteSession) LockTenantQuota(tenant string) (model.TenantQuotaOverride, model.QuotaValues, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.TenantQuotaOverride
+	var r1 model.QuotaValues
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.TenantQuotaOverride, model.QuotaValues, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.TenantQuotaOverride); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.TenantQuotaOverride)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) model.QuotaValues); ok {
+		r1 = rf(tenant)
+	} else {
+		r1 = ret.Get(1).(model.QuotaValues)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) apperrors.AppError); ok {
+		r2 = rf(tenant)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -113,6 +246,22 @@ func (_m *WriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppErro
 	return r0
 }
 
+// ReplaceDriftItems provides a mock function with given fields: runtimeID, items
+func (_m *WriteSession) ReplaceDriftItems(runtimeID string, items []model.DriftItem) apperrors.AppError {
+	ret := _m.Called(runtimeID, items)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.DriftItem) apperrors.AppError); ok {
+		r0 = rf(runtimeID, items)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *WriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -209,6 +358,22 @@ func (_m *WriteSession) UpdateOperationState(operationID string, message string,
 	return r0
 }
 
+// UpdateRuntimeMetadata provides a mock function with given fields: runtimeID, labels, description
+func (_m *WriteSession) UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) apperrors.AppError {
+	ret := _m.Called(runtimeID, labels, description)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, map[string]interface{}, *string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, labels, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateShootNetworkingFilterDisabled provides a mock function with given fields: runtimeID, shootNetworkingFilterDisabled
 func (_m *WriteSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) apperrors.AppError {
 	ret := _m.Called(runtimeID, shootNetworkingFilterDisabled)
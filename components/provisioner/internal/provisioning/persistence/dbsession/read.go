@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gocraft/dbr/v2"
 
@@ -58,17 +59,39 @@ func (r readSession) GetTenantForOperation(operationID string) (string, dberrors
 	return tenant, nil
 }
 
+func (r readSession) GetOperationOwner(operationID string) (model.OperationOwner, dberrors.Error) {
+	var owner model.OperationOwner
+
+	err := r.session.
+		Select("cluster.tenant", "gardener_config.purpose", "gardener_config.licence_type").
+		From("operation").
+		Join("cluster", "operation.cluster_id=cluster.id").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.Eq("operation.id", operationID)).
+		LoadOne(&owner)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.OperationOwner{}, dberrors.NotFound("Cannot find owner of operationID:'%s", operationID)
+		}
+
+		return model.OperationOwner{}, dberrors.Internal("Failed to get owner of operation: %s", err)
+	}
+	return owner, nil
+}
+
 func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error) {
-	var cluster model.Cluster
+	var clusterWithLabels clusterRead
 
 	err := r.session.
 		Select(
 			"id", "kubeconfig", "tenant",
 			"creation_timestamp", "deleted", "sub_account_id",
-			"active_kyma_config_id", "is_kubeconfig_encrypted").
+			"active_kyma_config_id", "is_kubeconfig_encrypted",
+			"labels", "description").
 		From("cluster").
 		Where(dbr.Eq("cluster.id", runtimeID)).
-		LoadOne(&cluster)
+		LoadOne(&clusterWithLabels)
 
 	if err != nil {
 		if err == dbr.ErrNotFound {
@@ -77,6 +100,11 @@ func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error
 		return model.Cluster{}, dberrors.Internal("Failed to get Cluster: %s", err)
 	}
 
+	cluster, err := clusterWithLabels.decode()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode labels of Cluster %s: %s", runtimeID, err)
+	}
+
 	if cluster.IsKubeconfigEncrypted {
 		decryptedClusterKubeconfig, dberr := r.decryptKubeconfig(cluster.Kubeconfig)
 		if dberr != nil {
@@ -123,6 +151,7 @@ func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error
 	return cluster, nil
 }
 
+// GetGardenerClusterByName returns the Runtime which is not deleted, as names of deleted Runtimes can be used again
 func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error) {
 	var clusterWithProvider = struct {
 		model.Cluster
@@ -138,10 +167,10 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "extensions", "networking", "kube_api_server", "control_plane_failure_tolerance", "shoot_and_seed_same_region", "resource_version").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
-		Where(dbr.Eq("name", name)).
+		Where(dbr.And(dbr.Eq("name", name), dbr.Eq("cluster.deleted", false))).
 		LoadOne(&clusterWithProvider)
 
 	if err != nil {
@@ -294,7 +323,10 @@ func (r readSession) getClusterAdministrators(runtimeID string) ([]model.Cluster
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig string  `db:"provider_specific_config"`
+	ExtensionsJSON         *string `db:"extensions"`
+	NetworkingJSON         *string `db:"networking"`
+	KubeAPIServerJSON      *string `db:"kube_api_server"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -304,6 +336,24 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	}
 
 	gcr.GardenerProviderConfig = gardenerConfigProviderConfig
+
+	if gcr.ExtensionsJSON != nil {
+		if err := json.Unmarshal([]byte(*gcr.ExtensionsJSON), &gcr.Extensions); err != nil {
+			return fmt.Errorf("error decoding Gardener extensions: %s", err.Error())
+		}
+	}
+
+	if gcr.NetworkingJSON != nil {
+		if err := json.Unmarshal([]byte(*gcr.NetworkingJSON), &gcr.Networking); err != nil {
+			return fmt.Errorf("error decoding Gardener networking: %s", err.Error())
+		}
+	}
+
+	if gcr.KubeAPIServerJSON != nil {
+		if err := json.Unmarshal([]byte(*gcr.KubeAPIServerJSON), &gcr.KubeAPIServer); err != nil {
+			return fmt.Errorf("error decoding kube-apiserver config: %s", err.Error())
+		}
+	}
 	return nil
 }
 
@@ -317,7 +367,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access").
+			"shoot_networking_filter_disabled", "extensions", "networking", "kube_api_server", "control_plane_failure_tolerance", "eu_access", "shoot_and_seed_same_region", "resource_version").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -341,7 +391,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 
 var (
 	operationColumns = []string{
-		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component",
+		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component", "seed_placement",
 	}
 )
 
@@ -455,6 +505,244 @@ func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberror
 	return operationsCount, nil
 }
 
+var (
+	idempotencyKeyColumns = []string{
+		"tenant", "key", "mutation", "request_hash", "operation_id", "created_at",
+	}
+
+	auditEventColumns = []string{
+		"id", "timestamp", "caller", "user_agent", "tenant", "sub_account_id", "mutation", "runtime_id", "input", "operation_id", "outcome", "err_message",
+	}
+
+	gardenerConfigRevisionColumns = []string{
+		"cluster_id", "revision", "operation_id", "creation_timestamp", "config",
+	}
+
+	driftItemColumns = []string{
+		"cluster_id", "field", "stored_value", "actual_value", "detected_at",
+	}
+)
+
+func (r readSession) ListAuditEvents(runtimeID string, from, to *time.Time) ([]model.AuditEvent, dberrors.Error) {
+	var events []model.AuditEvent
+
+	conditions := []dbr.Builder{dbr.Eq("runtime_id", runtimeID)}
+	if from != nil {
+		conditions = append(conditions, dbr.Gte("timestamp", *from))
+	}
+	if to != nil {
+		conditions = append(conditions, dbr.Lte("timestamp", *to))
+	}
+
+	_, err := r.session.
+		Select(auditEventColumns...).
+		From("api_audit_event").
+		Where(dbr.And(conditions...)).
+		OrderBy("timestamp").
+		Load(&events)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return []model.AuditEvent{}, nil
+		}
+		return nil, dberrors.Internal("Failed to list audit events for Runtime %s: %s", runtimeID, err)
+	}
+
+	return events, nil
+}
+
+func (r readSession) ListGardenerConfigRevisions(runtimeID string) ([]model.GardenerConfigRevision, dberrors.Error) {
+	var dtos []gardenerConfigRevisionDTO
+
+	_, err := r.session.
+		Select(gardenerConfigRevisionColumns...).
+		From("gardener_config_revision").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		OrderBy("revision").
+		Load(&dtos)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list Gardener config revisions of %s Runtime: %s", runtimeID, err)
+	}
+
+	revisions := make([]model.GardenerConfigRevision, 0, len(dtos))
+	for _, dto := range dtos {
+		revision, err := dto.decode()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode Gardener config revision %d of %s Runtime: %s", dto.Revision, runtimeID, err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (r readSession) GetGardenerConfigRevision(runtimeID string, revision int) (model.GardenerConfigRevision, dberrors.Error) {
+	var dto gardenerConfigRevisionDTO
+
+	err := r.session.
+		Select(gardenerConfigRevisionColumns...).
+		From("gardener_config_revision").
+		Where(dbr.And(dbr.Eq("cluster_id", runtimeID), dbr.Eq("revision", revision))).
+		LoadOne(&dto)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.GardenerConfigRevision{}, dberrors.NotFound("Gardener config revision %d of %s Runtime not found", revision, runtimeID)
+		}
+		return model.GardenerConfigRevision{}, dberrors.Internal("Failed to get Gardener config revision %d of %s Runtime: %s", revision, runtimeID, err)
+	}
+
+	configRevision, err := dto.decode()
+	if err != nil {
+		return model.GardenerConfigRevision{}, dberrors.Internal("Failed to decode Gardener config revision %d of %s Runtime: %s", revision, runtimeID, err)
+	}
+
+	return configRevision, nil
+}
+
+func (r readSession) ListDriftItems(runtimeID string) ([]model.DriftItem, dberrors.Error) {
+	var items []model.DriftItem
+
+	_, err := r.session.
+		Select(driftItemColumns...).
+		From("runtime_drift").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		OrderBy("field").
+		Load(&items)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list drift of %s Runtime: %s", runtimeID, err)
+	}
+
+	return items, nil
+}
+
+func (r readSession) ListAllDriftItems() ([]model.DriftItem, dberrors.Error) {
+	var items []model.DriftItem
+
+	_, err := r.session.
+		Select(driftItemColumns...).
+		From("runtime_drift").
+		Where(dbr.Expr("cluster_id IN (SELECT id FROM cluster WHERE deleted = false)")).
+		OrderBy("cluster_id").
+		OrderBy("field").
+		Load(&items)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list drift of Runtimes: %s", err)
+	}
+
+	return items, nil
+}
+
+func (r readSession) GetIdempotencyKey(tenant, key string) (model.IdempotencyKey, dberrors.Error) {
+	var idempotencyKey model.IdempotencyKey
+
+	err := r.session.
+		Select(idempotencyKeyColumns...).
+		From("idempotency_key").
+		Where(dbr.And(dbr.Eq("tenant", tenant), dbr.Eq("key", key))).
+		LoadOne(&idempotencyKey)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.IdempotencyKey{}, dberrors.NotFound("Idempotency key %s not found for tenant %s", key, tenant)
+		}
+		return model.IdempotencyKey{}, dberrors.Internal("Failed to get idempotency key %s: %s", key, err)
+	}
+
+	return idempotencyKey, nil
+}
+
+func (r readSession) GetTenantQuotaOverride(tenant string) (model.TenantQuotaOverride, dberrors.Error) {
+	return getTenantQuotaOverride(r.session, tenant)
+}
+
+func getTenantQuotaOverride(session dbr.SessionRunner, tenant string) (model.TenantQuotaOverride, dberrors.Error) {
+	var override model.TenantQuotaOverride
+
+	err := session.
+		Select("tenant", "runtimes", "total_max_nodes", "concurrent_operations").
+		From("tenant_quota").
+		Where(dbr.Eq("tenant", tenant)).
+		LoadOne(&override)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.TenantQuotaOverride{}, dberrors.NotFound("Quota override not found for tenant %s", tenant)
+		}
+		return model.TenantQuotaOverride{}, dberrors.Internal("Failed to get quota override for tenant %s: %s", tenant, err)
+	}
+
+	return override, nil
+}
+
+func (r readSession) ListRuntimeIDsByLabels(tenant string, labels map[string]interface{}) ([]string, dberrors.Error) {
+	condition := dbr.And(dbr.Eq("tenant", tenant), dbr.Eq("deleted", false))
+
+	filter, err := encodeRuntimeLabels(labels)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode labels filter: %s", err)
+	}
+	if filter != nil {
+		condition = dbr.And(condition, dbr.Expr("labels @> ?::jsonb", *filter))
+	}
+
+	var runtimeIDs []string
+	_, err = r.session.
+		Select("id").
+		From("cluster").
+		Where(condition).
+		OrderBy("creation_timestamp").
+		Load(&runtimeIDs)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list Runtimes of tenant %s: %s", tenant, err)
+	}
+
+	return runtimeIDs, nil
+}
+
+func (r readSession) GetTenantQuotaUsage(tenant string) (model.QuotaValues, dberrors.Error) {
+	return getTenantQuotaUsage(r.session, tenant)
+}
+
+func getTenantQuotaUsage(session dbr.SessionRunner, tenant string) (model.QuotaValues, dberrors.Error) {
+	var usage model.QuotaValues
+
+	var runtimes struct {
+		Count    int `db:"runtimes"`
+		MaxNodes int `db:"total_max_nodes"`
+	}
+
+	err := session.
+		Select("count(*) AS runtimes", "coalesce(sum(gardener_config.auto_scaler_max), 0) AS total_max_nodes").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.And(dbr.Eq("cluster.tenant", tenant), dbr.Eq("cluster.deleted", false))).
+		LoadOne(&runtimes)
+
+	if err != nil {
+		return model.QuotaValues{}, dberrors.Internal("Failed to count Runtimes of tenant %s: %s", tenant, err)
+	}
+	usage.Runtimes = runtimes.Count
+	usage.TotalMaxNodes = runtimes.MaxNodes
+
+	err = session.
+		Select("count(*)").
+		From("operation").
+		Join("cluster", "operation.cluster_id=cluster.id").
+		Where(dbr.And(dbr.Eq("cluster.tenant", tenant), dbr.Eq("operation.state", model.InProgress))).
+		LoadOne(&usage.ConcurrentOperations)
+
+	if err != nil {
+		return model.QuotaValues{}, dberrors.Internal("Failed to count operations in progress of tenant %s: %s", tenant, err)
+	}
+
+	return usage, nil
+}
+
 func (r readSession) getOidcConfig(gardenerConfigID string) (model.OIDCConfig, dberrors.Error) {
 	var oidc model.OIDCConfig
 	var algorithms []string
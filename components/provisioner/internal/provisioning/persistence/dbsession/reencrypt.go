@@ -0,0 +1,164 @@
+package dbsession
+
+import (
+	"context"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const reEncryptionBatchSize = 100
+
+// reEncryptionLockID identifies the Postgres advisory lock held by the replica or CLI which re-encrypts the secrets
+const reEncryptionLockID = 4_817_263_002
+
+// ErrReEncryptionInProgress is returned when other replica or CLI is re-encrypting the secrets
+var ErrReEncryptionInProgress = errors.New("re-encryption is already in progress")
+
+type ReEncryptionResult struct {
+	Kubeconfigs    int
+	Administrators int
+}
+
+type encryptedRow struct {
+	ID          string `db:"id"`
+	Value       string `db:"value"`
+	IsEncrypted bool   `db:"is_encrypted"`
+}
+
+type reEncryptedTable struct {
+	name           string
+	valueColumn    string
+	isEncryptedCol string
+}
+
+var (
+	kubeconfigTable = reEncryptedTable{
+		name:           "cluster",
+		valueColumn:    "kubeconfig",
+		isEncryptedCol: "is_kubeconfig_encrypted",
+	}
+	administratorTable = reEncryptedTable{
+		name:           "cluster_administrator",
+		valueColumn:    "user_id",
+		isEncryptedCol: "is_user_id_encrypted",
+	}
+)
+
+// ReEncrypt rewrites kubeconfigs and administrators which are stored in plain text or are not encrypted with the newest key of the keyring.
+// Rows modified concurrently are skipped, as they are already written with the newest key.
+func ReEncrypt(connection *dbr.Connection, keyProvider KeyProvider) (ReEncryptionResult, error) {
+	// The same keys are used during the whole re-encryption, even if the provider reloads them
+	keyring, err := keyProvider.Keyring()
+	if err != nil {
+		return ReEncryptionResult{}, errors.Wrap(err, "while getting encryption keys")
+	}
+
+	unlock, err := lockReEncryption(connection)
+	if err != nil {
+		return ReEncryptionResult{}, err
+	}
+	defer unlock()
+
+	session := connection.NewSession(nil)
+
+	kubeconfigs, err := reEncryptTable(session, kubeconfigTable, keyring)
+	if err != nil {
+		return ReEncryptionResult{}, errors.Wrap(err, "while re-encrypting kubeconfigs")
+	}
+
+	administrators, err := reEncryptTable(session, administratorTable, keyring)
+	if err != nil {
+		return ReEncryptionResult{Kubeconfigs: kubeconfigs}, errors.Wrap(err, "while re-encrypting administrators")
+	}
+
+	return ReEncryptionResult{Kubeconfigs: kubeconfigs, Administrators: administrators}, nil
+}
+
+// lockReEncryption acquires the advisory lock on the dedicated connection, as the lock is released when the connection is closed
+func lockReEncryption(connection *dbr.Connection) (func(), error) {
+	ctx := context.Background()
+
+	conn, err := connection.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting database connection")
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", reEncryptionLockID).Scan(&acquired)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "while acquiring re-encryption lock")
+	}
+	if !acquired {
+		conn.Close()
+		return nil, ErrReEncryptionInProgress
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", reEncryptionLockID); err != nil {
+			log.Warnf("Failed to release re-encryption lock: %s", err.Error())
+		}
+		conn.Close()
+	}, nil
+}
+
+func reEncryptTable(session *dbr.Session, table reEncryptedTable, keyring *Keyring) (int, error) {
+	reEncrypted := 0
+	// IDs are UUIDs which are never nil, so the nil UUID precedes all of them
+	lastID := "00000000-0000-0000-0000-000000000000"
+
+	for {
+		var rows []encryptedRow
+
+		_, err := session.
+			Select("id", table.valueColumn+" AS value", table.isEncryptedCol+" AS is_encrypted").
+			From(table.name).
+			Where(dbr.And(dbr.Gt("id", lastID), dbr.Neq(table.valueColumn, nil))).
+			OrderBy("id").
+			Limit(reEncryptionBatchSize).
+			Load(&rows)
+		if err != nil {
+			return reEncrypted, errors.Wrapf(err, "while reading %s table", table.name)
+		}
+		if len(rows) == 0 {
+			return reEncrypted, nil
+		}
+
+		for _, row := range rows {
+			if row.IsEncrypted && !keyring.needsReEncryption([]byte(row.Value)) {
+				continue
+			}
+
+			plain := []byte(row.Value)
+			if row.IsEncrypted {
+				plain, err = keyring.decrypt(plain)
+				if err != nil {
+					return reEncrypted, errors.Wrapf(err, "while decrypting %s of %s", table.valueColumn, row.ID)
+				}
+			}
+
+			encrypted, err := keyring.encrypt(plain)
+			if err != nil {
+				return reEncrypted, errors.Wrapf(err, "while encrypting %s of %s", table.valueColumn, row.ID)
+			}
+
+			res, err := session.
+				Update(table.name).
+				Set(table.valueColumn, string(encrypted)).
+				Set(table.isEncryptedCol, true).
+				Where(dbr.And(dbr.Eq("id", row.ID), dbr.Eq(table.valueColumn, row.Value))).
+				Exec()
+			if err != nil {
+				return reEncrypted, errors.Wrapf(err, "while updating %s of %s", table.valueColumn, row.ID)
+			}
+
+			if rowsAffected, err := res.RowsAffected(); err == nil && rowsAffected > 0 {
+				reEncrypted++
+			}
+		}
+
+		lastID = rows[len(rows)-1].ID
+	}
+}
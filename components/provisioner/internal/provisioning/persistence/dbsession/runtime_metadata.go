@@ -0,0 +1,53 @@
+package dbsession
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// clusterRead holds the labels as raw JSON, as the map cannot be scanned directly
+type clusterRead struct {
+	model.Cluster
+	LabelsJSON *string `db:"labels"`
+}
+
+func (cr clusterRead) decode() (model.Cluster, error) {
+	cluster := cr.Cluster
+
+	labels, err := decodeRuntimeLabels(cr.LabelsJSON)
+	if err != nil {
+		return model.Cluster{}, err
+	}
+	cluster.Labels = labels
+
+	return cluster, nil
+}
+
+// encodeRuntimeLabels returns nil for empty labels, so that the Runtimes without labels have NULL in the database
+func encodeRuntimeLabels(labels map[string]interface{}) (*string, error) {
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := string(raw)
+	return &encoded, nil
+}
+
+func decodeRuntimeLabels(raw *string) (map[string]interface{}, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	var labels map[string]interface{}
+	if err := json.Unmarshal([]byte(*raw), &labels); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
@@ -9,11 +9,14 @@ import (
 
 	dbr "github.com/gocraft/dbr/v2"
 	uuid "github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 )
 
+const uniqueViolationError = "23505"
+
 type writeSession struct {
 	session     *dbr.Session
 	transaction *dbr.Tx
@@ -26,16 +29,26 @@ func (ws writeSession) InsertCluster(cluster model.Cluster) dberrors.Error {
 		kymaConfigId = &cluster.KymaConfig.ID
 	}
 
-	_, err := ws.insertInto("cluster").
+	labels, err := encodeRuntimeLabels(cluster.Labels)
+	if err != nil {
+		return dberrors.Internal("Failed to encode labels of Cluster %s: %s", cluster.ID, err)
+	}
+
+	_, err = ws.insertInto("cluster").
 		Pair("id", cluster.ID).
 		Pair("creation_timestamp", cluster.CreationTimestamp).
 		Pair("tenant", cluster.Tenant).
 		Pair("sub_account_id", cluster.SubAccountId).
 		Pair("active_kyma_config_id", kymaConfigId). // Possible due to deferred constrain
 		Pair("is_kubeconfig_encrypted", false).
+		Pair("labels", labels).
+		Pair("description", cluster.Description).
 		Exec()
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return dberrors.AlreadyExists("Cluster with ID %s already exists", cluster.ID)
+		}
 		return dberrors.Internal("Failed to insert record to Cluster table: %s", err)
 	}
 
@@ -78,6 +91,19 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	extensions, dberr := encodeExtensions(config.Extensions)
+	if dberr != nil {
+		return dberr
+	}
+	networking, dberr := encodeNetworking(config.Networking)
+	if dberr != nil {
+		return dberr
+	}
+	kubeAPIServer, dberr := encodeKubeAPIServer(config.KubeAPIServer)
+	if dberr != nil {
+		return dberr
+	}
+
 	_, err := ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
@@ -107,11 +133,18 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("exposure_class_name", config.ExposureClassName).
 		Pair("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
+		Pair("extensions", extensions).
+		Pair("networking", networking).
+		Pair("kube_api_server", kubeAPIServer).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
 		Pair("eu_access", config.EuAccess).
+		Pair("shoot_and_seed_same_region", config.ShootAndSeedSameRegion).
 		Exec()
 
 	if err != nil {
+		if isUniqueViolation(err) {
+			return dberrors.AlreadyExists("Gardener config for %s Runtime already exists", config.ClusterID)
+		}
 		return dberrors.Internal("Failed to insert record to GardenerConfig table: %s", err)
 	}
 
@@ -192,6 +225,19 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	extensions, dberr := encodeExtensions(config.Extensions)
+	if dberr != nil {
+		return dberr
+	}
+	networking, dberr := encodeNetworking(config.Networking)
+	if dberr != nil {
+		return dberr
+	}
+	kubeAPIServer, dberr := encodeKubeAPIServer(config.KubeAPIServer)
+	if dberr != nil {
+		return dberr
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -211,7 +257,11 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("exposure_class_name", config.ExposureClassName).
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
+		Set("extensions", extensions).
+		Set("networking", networking).
+		Set("kube_api_server", kubeAPIServer).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("resource_version", dbr.Expr("resource_version + 1")).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -228,6 +278,71 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update record of configuration for gardener shoot cluster '%s' state: %s", config.Name, err))
 }
 
+func (ws writeSession) LockGardenerConfig(runtimeID string) (int, dberrors.Error) {
+	if ws.transaction == nil {
+		return 0, dberrors.Internal("Gardener config of %s Runtime can be locked only within transaction", runtimeID)
+	}
+
+	var resourceVersion int
+
+	err := ws.selectFrom("gardener_config", "resource_version").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		Suffix("FOR UPDATE").
+		LoadOne(&resourceVersion)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return 0, dberrors.NotFound("Gardener config for %s Runtime not found", runtimeID)
+		}
+		return 0, dberrors.Internal("Failed to lock Gardener config for %s Runtime: %s", runtimeID, err)
+	}
+
+	return resourceVersion, nil
+}
+
+func (ws writeSession) LockTenantQuota(tenant string) (model.TenantQuotaOverride, model.QuotaValues, dberrors.Error) {
+	if ws.transaction == nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, dberrors.Internal("Quota of tenant %s can be locked only within transaction", tenant)
+	}
+
+	// The tenant may have no row to lock, so the advisory lock released at the end of the transaction is used instead
+	var locked int
+	err := ws.transaction.SelectBySql("SELECT 1 FROM pg_advisory_xact_lock(hashtext(?))", tenantQuotaLockKey(tenant)).LoadOne(&locked)
+	if err != nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, dberrors.Internal("Failed to lock quota of tenant %s: %s", tenant, err)
+	}
+
+	override, dberr := getTenantQuotaOverride(ws.transaction, tenant)
+	if dberr != nil && dberr.Code() != dberrors.CodeNotFound {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, dberr
+	}
+
+	usage, dberr := getTenantQuotaUsage(ws.transaction, tenant)
+	if dberr != nil {
+		return model.TenantQuotaOverride{}, model.QuotaValues{}, dberr
+	}
+
+	return override, usage, nil
+}
+
+func tenantQuotaLockKey(tenant string) string {
+	return "tenant_quota:" + tenant
+}
+
+func (ws writeSession) HasInProgressOperation(runtimeID string) (bool, dberrors.Error) {
+	var count int
+
+	err := ws.selectFrom("operation", "count(*)").
+		Where(dbr.And(dbr.Eq("cluster_id", runtimeID), dbr.Eq("state", model.InProgress))).
+		LoadOne(&count)
+
+	if err != nil {
+		return false, dberrors.Internal("Failed to count operations in progress for %s Runtime: %s", runtimeID, err)
+	}
+
+	return count > 0, nil
+}
+
 func (ws writeSession) updateOidcConfig(config model.GardenerConfig) dberrors.Error {
 	_, err := ws.deleteFrom("oidc_config").
 		Where(dbr.Eq("gardener_config_id", config.ID)).
@@ -310,6 +425,91 @@ func (ws writeSession) InsertOperation(operation model.Operation) dberrors.Error
 	return nil
 }
 
+func (ws writeSession) InsertGardenerConfigRevision(revision model.GardenerConfigRevision) dberrors.Error {
+	var lastRevision int
+	err := ws.selectFrom("gardener_config_revision", "COALESCE(MAX(revision), 0)").
+		Where(dbr.Eq("cluster_id", revision.RuntimeID)).
+		LoadOne(&lastRevision)
+	if err != nil {
+		return dberrors.Internal("Failed to get last Gardener config revision of %s Runtime: %s", revision.RuntimeID, err)
+	}
+	revision.Revision = lastRevision + 1
+
+	dto, err := encodeGardenerConfigRevision(revision)
+	if err != nil {
+		return dberrors.Internal("Failed to encode Gardener config revision of %s Runtime: %s", revision.RuntimeID, err)
+	}
+
+	_, err = ws.insertInto("gardener_config_revision").
+		Columns(gardenerConfigRevisionColumns...).
+		Record(dto).
+		Exec()
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return dberrors.AlreadyExists("Gardener config revision %d of %s Runtime already exists", revision.Revision, revision.RuntimeID)
+		}
+		return dberrors.Internal("Failed to insert record to gardener_config_revision table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) ReplaceDriftItems(runtimeID string, items []model.DriftItem) dberrors.Error {
+	_, err := ws.deleteFrom("runtime_drift").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		Exec()
+	if err != nil {
+		return dberrors.Internal("Failed to delete drift of %s Runtime: %s", runtimeID, err)
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	insert := ws.insertInto("runtime_drift").Columns(driftItemColumns...)
+	for _, item := range items {
+		item.RuntimeID = runtimeID
+		insert = insert.Record(item)
+	}
+
+	_, err = insert.Exec()
+	if err != nil {
+		return dberrors.Internal("Failed to insert record to runtime_drift table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertAuditEvent(event model.AuditEvent) dberrors.Error {
+	_, err := ws.insertInto("api_audit_event").
+		Columns(auditEventColumns...).
+		Record(event).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert record to api_audit_event table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) InsertIdempotencyKey(idempotencyKey model.IdempotencyKey) dberrors.Error {
+	_, err := ws.insertInto("idempotency_key").
+		Columns(idempotencyKeyColumns...).
+		Record(idempotencyKey).
+		Exec()
+
+	if err != nil {
+		if isUniqueViolation(err) {
+			return dberrors.AlreadyExists("Idempotency key %s already exists for tenant %s", idempotencyKey.Key, idempotencyKey.Tenant)
+		}
+		return dberrors.Internal("Failed to insert record to idempotency_key table: %s", err)
+	}
+
+	return nil
+}
+
 func (ws writeSession) DeleteCluster(runtimeID string) dberrors.Error {
 	result, err := ws.deleteFrom("cluster").
 		Where(dbr.Eq("id", runtimeID)).
@@ -426,6 +626,7 @@ func (ws writeSession) MarkClusterAsDeleted(runtimeID string) dberrors.Error {
 	res, err := ws.update("cluster").
 		Where(dbr.Eq("id", runtimeID)).
 		Set("deleted", true).
+		Set("deleted_at", time.Now()).
 		Exec()
 
 	if err != nil {
@@ -447,6 +648,24 @@ func (ws writeSession) UpdateTenant(runtimeID, tenant string) dberrors.Error {
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update tenant %s: %s", tenant, err))
 }
 
+func (ws writeSession) UpdateRuntimeMetadata(runtimeID string, labels map[string]interface{}, description *string) dberrors.Error {
+	encodedLabels, err := encodeRuntimeLabels(labels)
+	if err != nil {
+		return dberrors.Internal("Failed to encode labels of Cluster %s: %s", runtimeID, err)
+	}
+
+	res, err := ws.update("cluster").
+		Where(dbr.Eq("id", runtimeID)).
+		Set("labels", encodedLabels).
+		Set("description", description).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update cluster %s metadata: %s", runtimeID, err)
+	}
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update cluster %s metadata", runtimeID))
+}
+
 func (ws writeSession) updateSucceeded(result sql.Result, errorMsg string) dberrors.Error {
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
@@ -489,6 +708,14 @@ func (ws writeSession) deleteFrom(table string) *dbr.DeleteStmt {
 	return ws.session.DeleteFrom(table)
 }
 
+func (ws writeSession) selectFrom(table string, column ...string) *dbr.SelectStmt {
+	if ws.transaction != nil {
+		return ws.transaction.Select(column...).From(table)
+	}
+
+	return ws.session.Select(column...).From(table)
+}
+
 func (ws writeSession) update(table string) *dbr.UpdateStmt {
 	if ws.transaction != nil {
 		return ws.transaction.Update(table)
@@ -497,6 +724,53 @@ func (ws writeSession) update(table string) *dbr.UpdateStmt {
 	return ws.session.Update(table)
 }
 
+// encodeExtensions returns nil for the configs without extensions, so that their shoots keep the default extensions
+func encodeExtensions(extensions []model.Extension) (*string, dberrors.Error) {
+	if extensions == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(extensions)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode Gardener extensions: %s", err.Error())
+	}
+	extensionsJSON := string(encoded)
+	return &extensionsJSON, nil
+}
+
+// encodeNetworking returns nil for the configs without networking, so that their shoots keep the default networking
+func encodeNetworking(networking *model.NetworkingConfig) (*string, dberrors.Error) {
+	if networking == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(networking)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode Gardener networking: %s", err.Error())
+	}
+	networkingJSON := string(encoded)
+	return &networkingJSON, nil
+}
+
+// encodeKubeAPIServer returns nil for the configs without kube-apiserver settings
+func encodeKubeAPIServer(kubeAPIServer *model.KubeAPIServerConfig) (*string, dberrors.Error) {
+	if kubeAPIServer == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(kubeAPIServer)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode kube-apiserver config: %s", err.Error())
+	}
+	kubeAPIServerJSON := string(encoded)
+	return &kubeAPIServerJSON, nil
+}
+
+func isUniqueViolation(err error) bool {
+	psqlErr, converted := err.(*pq.Error)
+	return converted && psqlErr.Code == uniqueViolationError
+}
+
 func (ws writeSession) encryptString(s string) (string, dberrors.Error) {
 	encrypted, err := ws.encrypt([]byte(s))
 	if err != nil {
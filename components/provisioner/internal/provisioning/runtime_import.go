@@ -0,0 +1,145 @@
+package provisioning
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	log "github.com/sirupsen/logrus"
+)
+
+const importedRuntimeMessage = "Runtime imported from existing Shoot"
+
+// ImportRuntime brings the existing shoot under management of the provisioner. The Runtime is recorded as provisioned by a succeeded Provision operation.
+func (r *service) ImportRuntime(shootName, tenant string, subAccount *string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	shoot, err := r.provisioner.GetShoot(shootName)
+	if err != nil {
+		return nil, err
+	}
+	if shoot.DeletionTimestamp != nil {
+		return nil, apperrors.BadRequest("shoot %s is being deleted", shootName)
+	}
+
+	gardenerConfig, err := model.NewGardenerConfigFromShoot(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	runtimeID := r.uuidGenerator.New()
+	log.Infof("Assigned new ID for imported Runtime: %s ", runtimeID)
+
+	gardenerConfig.ID = r.uuidGenerator.New()
+	gardenerConfig.ClusterID = runtimeID
+
+	creationTimestamp := shoot.CreationTimestamp.Time
+	if creationTimestamp.IsZero() {
+		creationTimestamp = time.Now()
+	}
+
+	cluster := model.Cluster{
+		ID:                runtimeID,
+		CreationTimestamp: creationTimestamp,
+		Tenant:            tenant,
+		SubAccountId:      subAccount,
+		ClusterConfig:     gardenerConfig,
+	}
+
+	dbSession, dberr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return nil, dberr
+	}
+	defer dbSession.RollbackUnlessCommitted()
+
+	// Imports of the tenant wait for the tenant lock, so that the shoot is checked after a concurrent import of it is committed
+	_, _, dberr = dbSession.LockTenantQuota(tenant)
+	if dberr != nil {
+		return nil, dberr.Append("failed to lock tenant %s", tenant)
+	}
+
+	// The imported Runtime counts against the tenant quota as a provisioned one
+	if r.quotaChecker != nil {
+		err = r.quotaChecker.CheckProvisioning(dbSession, tenant, gardenerConfig.AutoScalerMax)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = r.verifyShootNotManaged(shootName)
+	if err != nil {
+		return nil, err
+	}
+
+	operation, dberr := r.setRuntimeImported(dbSession, cluster)
+	if dberr != nil {
+		return nil, dberr
+	}
+
+	// The shoot is annotated before the commit, so that the Runtime is not stored if the shoot cannot be adopted.
+	// If the commit fails, the shoot stays annotated without a Runtime, and importing it again overwrites the annotations.
+	err = r.provisioner.AdoptCluster(cluster, operation.ID)
+	if err != nil {
+		return nil, err.Append("failed to adopt shoot %s", shootName)
+	}
+
+	dberr = dbSession.Commit()
+	if dberr != nil {
+		return nil, dberr
+	}
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) verifyShootNotManaged(shootName string) apperrors.AppError {
+	_, dberr := r.dbSessionFactory.NewReadSession().GetGardenerClusterByName(shootName)
+	if dberr == nil {
+		return apperrors.Conflict("shoot %s is already managed by the provisioner", shootName)
+	}
+	if dberr.Code() != dberrors.CodeNotFound {
+		return dberr.Append("failed to verify if shoot %s is already managed", shootName)
+	}
+
+	return nil
+}
+
+func (r *service) setRuntimeImported(dbSession dbsession.WriteSession, cluster model.Cluster) (model.Operation, dberrors.Error) {
+	// Errors are appended rather than wrapped to keep their codes
+	if err := dbSession.InsertCluster(cluster); err != nil {
+		return model.Operation{}, err.Append("Failed to import Runtime")
+	}
+
+	if err := dbSession.InsertGardenerConfig(cluster.ClusterConfig); err != nil {
+		return model.Operation{}, err.Append("Failed to import Runtime")
+	}
+
+	timestamp := time.Now()
+	operation := model.Operation{
+		ID:             r.uuidGenerator.New(),
+		Type:           model.Provision,
+		StartTimestamp: timestamp,
+		EndTimestamp:   &timestamp,
+		State:          model.Succeeded,
+		Message:        importedRuntimeMessage,
+		ClusterID:      cluster.ID,
+		Stage:          model.FinishedStage,
+		LastTransition: &timestamp,
+	}
+
+	if err := dbSession.InsertOperation(operation); err != nil {
+		return model.Operation{}, err.Append("Failed to import Runtime")
+	}
+
+	err := dbSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+		RuntimeID:         cluster.ID,
+		OperationID:       &operation.ID,
+		CreationTimestamp: timestamp,
+		Config:            cluster.ClusterConfig,
+	})
+	if err != nil {
+		return model.Operation{}, err.Append("Failed to import Runtime")
+	}
+
+	return operation, nil
+}
@@ -0,0 +1,67 @@
+package provisioning
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// UpdateRuntimeMetadata replaces labels of the Runtime if they are provided and sets its description if it is provided, the empty description removes it.
+// The Runtime is updated in the database and on the shoot in one step, the database is not changed if the shoot cannot be updated.
+func (r *service) UpdateRuntimeMetadata(id string, labels gqlschema.Labels, description *string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
+	dbSession, dberr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dberr != nil {
+		return nil, dberr
+	}
+	defer dbSession.RollbackUnlessCommitted()
+
+	// The Runtime is read after locking its Gardener config, so that concurrent updates do not overwrite each other's labels
+	_, dberr = dbSession.LockGardenerConfig(id)
+	if dberr != nil {
+		return nil, dberr.Append("failed to lock Runtime %s", id)
+	}
+
+	cluster, dberr := r.dbSessionFactory.NewReadSession().GetCluster(id)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Runtime %s", id)
+	}
+	if cluster.Deleted {
+		return nil, apperrors.BadRequest("Runtime %s is deleted", id)
+	}
+
+	if labels != nil {
+		cluster.Labels = labels
+	}
+	if description != nil {
+		cluster.Description = description
+		if *description == "" {
+			cluster.Description = nil
+		}
+	}
+
+	dberr = dbSession.UpdateRuntimeMetadata(id, cluster.Labels, cluster.Description)
+	if dberr != nil {
+		return nil, dberr.Append("failed to update metadata of Runtime %s", id)
+	}
+
+	err := r.provisioner.UpdateRuntimeMetadata(cluster)
+	if err != nil {
+		return nil, err.Append("failed to update metadata of Runtime %s", id)
+	}
+
+	dberr = dbSession.Commit()
+	if dberr != nil {
+		return nil, dberr
+	}
+
+	return r.RuntimeStatus(id)
+}
+
+// RuntimeIDs returns IDs of the Runtimes of the tenant which have all of the given labels, ordered from the oldest
+func (r *service) RuntimeIDs(tenant string, labels gqlschema.Labels) ([]string, apperrors.AppError) {
+	runtimeIDs, dberr := r.dbSessionFactory.NewReadSession().ListRuntimeIDsByLabels(tenant, labels)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes of tenant %s", tenant)
+	}
+
+	return runtimeIDs, nil
+}
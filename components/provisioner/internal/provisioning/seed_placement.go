@@ -0,0 +1,40 @@
+package provisioning
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
+	log "github.com/sirupsen/logrus"
+)
+
+// placeSeed chooses the seed of the shoot unless it was requested explicitly, and returns the reasons of the choice which are recorded on the operation.
+// The seed must have the labels which Gardener would require by the seed selector of the shoot, and must allow EU access if the shoot requires it.
+func (r *service) placeSeed(config *model.GardenerConfig, seedSelector map[string]string) (*string, apperrors.AppError) {
+	if r.seedPlacer == nil || config.Seed != "" {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for key, value := range seedSelector {
+		labels[key] = value
+	}
+	if config.ShootAndSeedSameRegion {
+		labels[model.SeedRegionLabel] = config.Region
+	}
+
+	decision, err := r.seedPlacer.Place(seedplacement.Requirements{
+		Provider: config.Provider,
+		Region:   config.Region,
+		Labels:   labels,
+		EuAccess: config.EuAccess,
+	})
+	if err != nil {
+		return nil, err.Append("failed to choose seed of %s shoot", config.Name)
+	}
+
+	config.Seed = decision.SeedName
+	reasons := decision.String()
+	log.Infof("Seed of %s shoot placed: %s", config.Name, reasons)
+
+	return &reasons, nil
+}
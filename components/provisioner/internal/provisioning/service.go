@@ -6,10 +6,15 @@ import (
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/hashicorp/go-version"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/network"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/quota"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	uuid "github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -22,12 +27,19 @@ type DynamicKubeconfigProvider interface {
 
 //go:generate mockery --name=Service
 type Service interface {
-	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError)
 	DeprovisionRuntime(id string) (string, apperrors.AppError)
-	UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError)
 	ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	AuditEvents(runtimeID string, from, to *time.Time) ([]*gqlschema.AuditEvent, apperrors.AppError)
+	TenantQuota(tenant string) (*gqlschema.TenantQuota, apperrors.AppError)
+	RuntimeConfigRevisions(runtimeID string) ([]*gqlschema.RuntimeConfigRevision, apperrors.AppError)
+	RuntimeConfigRevisionDiff(runtimeID string, from, to int) ([]*gqlschema.ConfigChange, apperrors.AppError)
+	ImportRuntime(shootName, tenant string, subAccount *string) (*gqlschema.OperationStatus, apperrors.AppError)
+	UpdateRuntimeMetadata(id string, labels gqlschema.Labels, description *string) (*gqlschema.RuntimeStatus, apperrors.AppError)
+	RuntimeIDs(tenant string, labels gqlschema.Labels) ([]string, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
@@ -35,6 +47,9 @@ type Provisioner interface {
 	ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError
 	DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
 	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	GetShoot(shootName string) (gardener_Types.Shoot, apperrors.AppError)
+	AdoptCluster(cluster model.Cluster, operationId string) apperrors.AppError
+	UpdateRuntimeMetadata(cluster model.Cluster) apperrors.AppError
 }
 
 //go:generate mockery --name=ShootProvider
@@ -47,6 +62,10 @@ type service struct {
 	graphQLConverter          GraphQLConverter
 	shootProvider             ShootProvider
 	dynamicKubeconfigProvider DynamicKubeconfigProvider
+	quotaChecker              quota.Checker
+	regionPolicies            regionpolicy.Provider
+	seedPlacer                seedplacement.Placer
+	cloudProfiles             cloudprofile.Provider
 
 	dbSessionFactory dbsession.Factory
 	provisioner      Provisioner
@@ -70,7 +89,10 @@ func NewProvisioningService(
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
 	dynamicKubeconfigProvider DynamicKubeconfigProvider,
-
+	quotaChecker quota.Checker,
+	regionPolicies regionpolicy.Provider,
+	seedPlacer seedplacement.Placer,
+	cloudProfiles cloudprofile.Provider,
 ) Service {
 	return &service{
 		inputConverter:            inputConverter,
@@ -83,10 +105,31 @@ func NewProvisioningService(
 		shootUpgradeQueue:         shootUpgradeQueue,
 		shootProvider:             shootProvider,
 		dynamicKubeconfigProvider: dynamicKubeconfigProvider,
+		quotaChecker:              quotaChecker,
+		regionPolicies:            regionPolicies,
+		seedPlacer:                seedPlacer,
+		cloudProfiles:             cloudProfiles,
 	}
 }
 
-func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	key, err := newIdempotencyKey(tenant, idempotencyKey, provisionRuntimeMutation, struct {
+		Config     gqlschema.ProvisionRuntimeInput
+		SubAccount string
+	}{config, subAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := r.findIdempotentOperation(key)
+	if err != nil || status != nil {
+		return status, err
+	}
+
+	config, err = r.resolveProvisioningInput(config)
+	if err != nil {
+		return nil, err
+	}
 
 	var runtimeID string
 
@@ -98,18 +141,56 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 		return nil, err
 	}
 
+	var seedSelector map[string]string
+	if r.regionPolicies != nil {
+		policy := r.regionPolicies.Policy(cluster.ClusterConfig.Provider, cluster.ClusterConfig.Region)
+		err = policy.ValidateMachineType(cluster.ClusterConfig.MachineType)
+		if err != nil {
+			return nil, err
+		}
+		policy.ApplyToConfig(&cluster.ClusterConfig)
+		seedSelector = policy.SeedSelector
+	}
+
+	seedPlacement, err := r.placeSeed(&cluster.ClusterConfig, seedSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	dbSession, dberr := r.dbSessionFactory.NewSessionWithinTransaction()
 	if dberr != nil {
 		return nil, dberr
 	}
 	defer dbSession.RollbackUnlessCommitted()
 
+	// A concurrent retry waits for the tenant lock until the original request commits its idempotency key
+	status, err = r.findIdempotentOperationAfterLock(key, func() dberrors.Error {
+		_, _, dberr := dbSession.LockTenantQuota(tenant)
+		return dberr
+	})
+	if err != nil || status != nil {
+		return status, err
+	}
+
+	// The quota is checked within the transaction, so that concurrent requests of the tenant cannot exceed it together
+	if r.quotaChecker != nil {
+		err = r.quotaChecker.CheckProvisioning(dbSession, tenant, cluster.ClusterConfig.AutoScalerMax)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Try to set provisioning started before triggering it (which is hard to interrupt) to verify all unique constraints
-	operation, dberr := r.setProvisioningStarted(dbSession, runtimeID, cluster)
+	operation, dberr := r.setProvisioningStarted(dbSession, runtimeID, cluster, seedPlacement)
 	if dberr != nil {
 		return nil, dberr
 	}
 
+	status, err = r.storeIdempotencyKey(dbSession, key, operation.ID)
+	if err != nil || status != nil {
+		return status, err
+	}
+
 	err = r.provisioner.ProvisionCluster(cluster, operation.ID)
 	if err != nil {
 		return nil, err.Append("Failed to start provisioning")
@@ -155,16 +236,34 @@ func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return operation.ID, nil
 }
 
-func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.UpgradeShootInput, idempotencyKey *string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	log.Infof("Starting Upgrade of Gardener Shoot for Runtime '%s'...", runtimeID)
 
-	if input.GardenerConfig == nil {
+	if input.GardenerConfig == nil && input.Revision == nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Error: Gardener config is nil")
 	}
 
 	session := r.dbSessionFactory.NewReadSession()
 
-	err := r.verifyLastOperationFinished(session, runtimeID)
+	key, err := upgradeShootIdempotencyKey(session, runtimeID, input, idempotencyKey)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	status, err := r.findIdempotentOperation(key)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+	if status != nil {
+		return status, nil
+	}
+
+	input, err = allocateUpgradeZones(input)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	err = r.verifyLastOperationFinished(session, runtimeID)
 	if err != nil {
 		return &gqlschema.OperationStatus{}, err
 	}
@@ -174,9 +273,26 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to find shoot cluster to upgrade in database: %s", dberr.Error())
 	}
 
-	gardenerConfig, err := r.inputConverter.UpgradeShootInputToGardenerConfig(*input.GardenerConfig, cluster.ClusterConfig)
+	gardenerConfig, err := r.upgradedGardenerConfig(session, input, cluster.ClusterConfig)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	revisions, dberr := session.ListGardenerConfigRevisions(runtimeID)
+	if dberr != nil {
+		return &gqlschema.OperationStatus{}, dberr.Append("Failed to get Gardener config revisions")
+	}
+
+	if r.regionPolicies != nil {
+		policy := r.regionPolicies.Policy(gardenerConfig.Provider, gardenerConfig.Region)
+		// Only the change of the machine type is validated, so that Runtimes provisioned before the policy was changed can still be upgraded
+		if gardenerConfig.MachineType != cluster.ClusterConfig.MachineType {
+			err = policy.ValidateMachineType(gardenerConfig.MachineType)
+			if err != nil {
+				return &gqlschema.OperationStatus{}, err
+			}
+		}
+		policy.ApplyToConfig(&gardenerConfig)
 	}
 
 	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
@@ -184,6 +300,11 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 		return &gqlschema.OperationStatus{}, err.Append("Failed to get shoot")
 	}
 
+	err = r.resolveCloudProfileValues(shoot.Spec.CloudProfileName, &gardenerConfig, cluster.ClusterConfig)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
 	// This is a workaround for a problem with Kubernetes auto upgrade. If Kubernetes gets updated the current Kubernetes version is obtained for the shoot and stored in the database.
 	shouldTakeShootKubernetesVersion, err := isVersionHigher(shoot.Spec.Kubernetes.Version, gardenerConfig.KubernetesVersion)
 	if err != nil {
@@ -196,7 +317,7 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 
 	// This is a workaround for the possible manual modification of the Shoot Spec Extensions. If ShootNetworkingFilterDisabled is modified manually, Provisioner should use the actual value.
 	shootNetworkingFilterDisabled := getShootNetworkingFilterDisabled(shoot.Spec.Extensions)
-	if input.GardenerConfig.ShootNetworkingFilterDisabled == nil && shootNetworkingFilterDisabled != nil {
+	if input.GardenerConfig != nil && input.GardenerConfig.ShootNetworkingFilterDisabled == nil && shootNetworkingFilterDisabled != nil {
 		log.Warnf("ShootNetworkingFilter extension was different than the one provided in UpgradeGardenerShoot. Value fetched from the shoot will be used: %t.", *shootNetworkingFilterDisabled)
 		gardenerConfig.ShootNetworkingFilterDisabled = shootNetworkingFilterDisabled
 	}
@@ -206,17 +327,63 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	if err != nil {
 		return &gqlschema.OperationStatus{}, err.Append("Invalid gardener provider config change")
 	}
+
+	err = gardenerConfig.ValidateNetworkingChange(&shoot)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err.Append("Invalid networking change")
+	}
 	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
 	if dbErr != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
 	}
 	defer txSession.RollbackUnlessCommitted()
 
+	// A concurrent retry waits for the Gardener config lock until the original request commits its idempotency key
+	status, err = r.findIdempotentOperationAfterLock(key, func() dberrors.Error {
+		_, dberr := txSession.LockGardenerConfig(runtimeID)
+		return dberr
+	})
+	if err != nil || status != nil {
+		return status, err
+	}
+
+	err = r.verifyNoConcurrentModification(txSession, runtimeID, expectedResourceVersion(input))
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	if r.quotaChecker != nil {
+		err = r.quotaChecker.CheckUpgrade(txSession, cluster.Tenant, cluster.ClusterConfig.AutoScalerMax, gardenerConfig.AutoScalerMax)
+		if err != nil {
+			return &gqlschema.OperationStatus{}, err
+		}
+	}
+
+	// Runtimes provisioned before revisions were introduced get their current config recorded, so that it is possible to roll back to it
+	if len(revisions) == 0 {
+		dberr = txSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+			RuntimeID:         runtimeID,
+			CreationTimestamp: time.Now(),
+			Config:            cluster.ClusterConfig,
+		})
+		if dberr != nil {
+			return &gqlschema.OperationStatus{}, dberr.Append("Failed to record current Gardener config")
+		}
+	}
+
 	operation, gardError := r.setGardenerShootUpgradeStarted(txSession, cluster, gardenerConfig, input.Administrators)
 	if gardError != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set shoot upgrade started: %s", gardError.Error())
 	}
 
+	status, err = r.storeIdempotencyKey(txSession, key, operation.ID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+	if status != nil {
+		return status, nil
+	}
+
 	err = r.provisioner.UpgradeCluster(cluster.ID, gardenerConfig)
 	if err != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to upgrade Cluster: %s", err.Error())
@@ -232,6 +399,179 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+// upgradedGardenerConfig returns the config requested by the input, either directly or as the revision to roll back to
+func (r *service) upgradedGardenerConfig(session dbsession.ReadSession, input gqlschema.UpgradeShootInput, config model.GardenerConfig) (model.GardenerConfig, apperrors.AppError) {
+	if input.Revision == nil {
+		gardenerConfig, err := r.inputConverter.UpgradeShootInputToGardenerConfig(*input.GardenerConfig, config)
+		if err != nil {
+			return model.GardenerConfig{}, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
+		}
+		return gardenerConfig, nil
+	}
+
+	revision, dberr := session.GetGardenerConfigRevision(config.ClusterID, *input.Revision)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			return model.GardenerConfig{}, apperrors.BadRequest("Gardener config revision %d of %s Runtime does not exist", *input.Revision, config.ClusterID)
+		}
+		return model.GardenerConfig{}, dberr.Append("Failed to get Gardener config revision")
+	}
+	log.Infof("Rolling back Gardener config of Runtime '%s' to revision %d", config.ClusterID, revision.Revision)
+
+	return rollbackGardenerConfig(revision.Config, config), nil
+}
+
+// resolveCloudProfileValues validates the values changed by the upgrade against the CloudProfile of the shoot and completes the omitted versions.
+// The values which are not changed are not validated, so that Runtimes using versions deprecated since the provisioning can still be upgraded.
+func (r *service) resolveCloudProfileValues(profileName string, config *model.GardenerConfig, current model.GardenerConfig) apperrors.AppError {
+	if r.cloudProfiles == nil || profileName == "" {
+		return nil
+	}
+
+	var values cloudprofile.Values
+	if config.KubernetesVersion != current.KubernetesVersion {
+		values.KubernetesVersion = config.KubernetesVersion
+	}
+	if config.MachineType != current.MachineType {
+		values.Region = config.Region
+		values.MachineType = config.MachineType
+	}
+	imageChanged := util.UnwrapOrZero(config.MachineImage) != util.UnwrapOrZero(current.MachineImage)
+	if imageChanged || util.UnwrapOrZero(config.MachineImageVersion) != util.UnwrapOrZero(current.MachineImageVersion) {
+		values.MachineImage = util.UnwrapOrZero(config.MachineImage)
+		values.MachineImageVersion = util.UnwrapOrZero(config.MachineImageVersion)
+		// The version of the previous image cannot be used with the new one, the latest version of the new image is chosen instead
+		if imageChanged && config.MachineImageVersion == current.MachineImageVersion {
+			values.MachineImageVersion = ""
+		}
+	}
+	if values == (cloudprofile.Values{}) {
+		return nil
+	}
+
+	profile, err := r.cloudProfiles.CloudProfile(profileName)
+	if err != nil {
+		return err
+	}
+
+	values, err = cloudprofile.Resolve(profile, values, time.Now())
+	if err != nil {
+		return err.Append("Gardener config validation error while starting Shoot Upgrade")
+	}
+
+	if values.KubernetesVersion != "" {
+		config.KubernetesVersion = values.KubernetesVersion
+	}
+	if values.MachineImageVersion != "" {
+		config.MachineImageVersion = &values.MachineImageVersion
+	}
+	return nil
+}
+
+// resolveProvisioningInput returns the input in which the zone allocation is replaced with the allocated zones
+// and the versions omitted in the Gardener config are completed with the latest ones supported by the CloudProfile.
+// The input is resolved after the idempotency key is computed, so that the key covers the input sent by the client.
+func (r *service) resolveProvisioningInput(config gqlschema.ProvisionRuntimeInput) (gqlschema.ProvisionRuntimeInput, apperrors.AppError) {
+	if config.ClusterConfig == nil || config.ClusterConfig.GardenerConfig == nil {
+		return config, nil
+	}
+
+	gardenerConfig := *config.ClusterConfig.GardenerConfig
+	providerConfig, err := network.AllocateZones(gardenerConfig.ProviderSpecificConfig)
+	if err != nil {
+		return config, err.Append("Failed to allocate zones")
+	}
+	gardenerConfig.ProviderSpecificConfig = providerConfig
+
+	gardenerConfig, err = ResolveCloudProfileValues(r.cloudProfiles, gardenerConfig)
+	if err != nil {
+		return config, err.Append("Cluster config validation error while starting Runtime provisioning")
+	}
+
+	clusterConfig := *config.ClusterConfig
+	clusterConfig.GardenerConfig = &gardenerConfig
+	config.ClusterConfig = &clusterConfig
+	return config, nil
+}
+
+// ResolveCloudProfileValues validates the Gardener config against the CloudProfile of its provider if the provider of CloudProfiles is not nil.
+// The returned config contains the versions omitted in the input completed with the latest ones supported by the CloudProfile.
+func ResolveCloudProfileValues(cloudProfiles cloudprofile.Provider, gardenerConfig gqlschema.GardenerConfigInput) (gqlschema.GardenerConfigInput, apperrors.AppError) {
+	if cloudProfiles == nil || gardenerConfig.ProviderSpecificConfig == nil {
+		return gardenerConfig, nil
+	}
+
+	profileName := cloudProfileName(*gardenerConfig.ProviderSpecificConfig)
+	if profileName == "" {
+		return gardenerConfig, nil
+	}
+
+	profile, err := cloudProfiles.CloudProfile(profileName)
+	if err != nil {
+		return gardenerConfig, err
+	}
+
+	values, err := cloudprofile.Resolve(profile, cloudprofile.Values{
+		Region:              gardenerConfig.Region,
+		KubernetesVersion:   gardenerConfig.KubernetesVersion,
+		MachineType:         gardenerConfig.MachineType,
+		MachineImage:        util.UnwrapOrZero(gardenerConfig.MachineImage),
+		MachineImageVersion: util.UnwrapOrZero(gardenerConfig.MachineImageVersion),
+	}, time.Now())
+	if err != nil {
+		return gardenerConfig, err
+	}
+
+	gardenerConfig.KubernetesVersion = values.KubernetesVersion
+	if values.MachineImageVersion != "" {
+		gardenerConfig.MachineImageVersion = &values.MachineImageVersion
+	}
+	return gardenerConfig, nil
+}
+
+// cloudProfileName returns the name of the CloudProfile used by the shoot template of the provider, or empty name if the provider config is missing
+func cloudProfileName(providerConfig gqlschema.ProviderSpecificInput) string {
+	switch {
+	case providerConfig.GcpConfig != nil:
+		return "gcp"
+	case providerConfig.AzureConfig != nil:
+		return "az"
+	case providerConfig.AwsConfig != nil:
+		return "aws"
+	case providerConfig.OpenStackConfig != nil:
+		if providerConfig.OpenStackConfig.CloudProfileName != nil {
+			return *providerConfig.OpenStackConfig.CloudProfileName
+		}
+		return OpenStackCloudProfileName
+	default:
+		return ""
+	}
+}
+
+// allocateUpgradeZones returns the input in which the zone allocation is replaced with the allocated zones.
+func allocateUpgradeZones(input gqlschema.UpgradeShootInput) (gqlschema.UpgradeShootInput, apperrors.AppError) {
+	if input.GardenerConfig == nil {
+		return input, nil
+	}
+
+	gardenerConfig := *input.GardenerConfig
+	providerConfig, err := network.AllocateZones(gardenerConfig.ProviderSpecificConfig)
+	if err != nil {
+		return input, err.Append("Failed to allocate zones")
+	}
+	gardenerConfig.ProviderSpecificConfig = providerConfig
+
+	input.GardenerConfig = &gardenerConfig
+	return input, nil
+}
+
+func expectedResourceVersion(input gqlschema.UpgradeShootInput) *int {
+	if input.GardenerConfig == nil {
+		return nil
+	}
+	return input.GardenerConfig.ExpectedResourceVersion
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -245,6 +585,29 @@ func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, run
 	return nil
 }
 
+// verifyNoConcurrentModification must be called within the transaction which modifies the Runtime, as it holds the lock on the Gardener config until the transaction ends
+func (r *service) verifyNoConcurrentModification(txSession dbsession.WriteSession, runtimeID string, expectedResourceVersion *int) apperrors.AppError {
+	resourceVersion, dberr := txSession.LockGardenerConfig(runtimeID)
+	if dberr != nil {
+		return dberr.Append("failed to lock Gardener config")
+	}
+
+	if expectedResourceVersion != nil && *expectedResourceVersion != resourceVersion {
+		return apperrors.Conflict("Gardener config of %s Runtime was modified, expected resource version %d, current resource version %d", runtimeID, *expectedResourceVersion, resourceVersion)
+	}
+
+	inProgress, dberr := txSession.HasInProgressOperation(runtimeID)
+	if dberr != nil {
+		return dberr.Append("failed to check operations in progress")
+	}
+
+	if inProgress {
+		return apperrors.Conflict("cannot start new operation for %s Runtime while previous one is in progress", runtimeID)
+	}
+
+	return nil
+}
+
 func (r *service) ReconnectRuntimeAgent(string) (string, apperrors.AppError) {
 	return "", nil
 }
@@ -269,6 +632,74 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) AuditEvents(runtimeID string, from, to *time.Time) ([]*gqlschema.AuditEvent, apperrors.AppError) {
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	events, dberr := readSession.ListAuditEvents(runtimeID, from, to)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get audit events")
+	}
+
+	auditEvents := make([]*gqlschema.AuditEvent, 0, len(events))
+	for _, event := range events {
+		auditEvents = append(auditEvents, r.graphQLConverter.AuditEventToGraphQLAuditEvent(event))
+	}
+
+	return auditEvents, nil
+}
+
+func (r *service) RuntimeConfigRevisions(runtimeID string) ([]*gqlschema.RuntimeConfigRevision, apperrors.AppError) {
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	revisions, dberr := readSession.ListGardenerConfigRevisions(runtimeID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Gardener config revisions")
+	}
+
+	configRevisions := make([]*gqlschema.RuntimeConfigRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		configRevisions = append(configRevisions, r.graphQLConverter.GardenerConfigRevisionToGraphQLRevision(revision))
+	}
+
+	return configRevisions, nil
+}
+
+func (r *service) RuntimeConfigRevisionDiff(runtimeID string, from, to int) ([]*gqlschema.ConfigChange, apperrors.AppError) {
+	readSession := r.dbSessionFactory.NewReadSession()
+
+	fromRevision, dberr := readSession.GetGardenerConfigRevision(runtimeID, from)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Gardener config revision %d", from)
+	}
+
+	toRevision, dberr := readSession.GetGardenerConfigRevision(runtimeID, to)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Gardener config revision %d", to)
+	}
+
+	changes, err := diffGardenerConfigs(
+		r.graphQLConverter.GardenerConfigRevisionToGraphQLRevision(fromRevision).ClusterConfig,
+		r.graphQLConverter.GardenerConfigRevisionToGraphQLRevision(toRevision).ClusterConfig)
+	if err != nil {
+		return nil, apperrors.Internal("failed to compare Gardener config revisions: %s", err.Error())
+	}
+
+	return changes, nil
+}
+
+func (r *service) TenantQuota(tenant string) (*gqlschema.TenantQuota, apperrors.AppError) {
+	if r.quotaChecker == nil {
+		return nil, apperrors.Internal("tenant quotas are not configured")
+	}
+
+	tenantQuota, err := r.quotaChecker.TenantQuota(tenant)
+	if err != nil {
+		return nil, err.Append("failed to get tenant quota")
+	}
+
+	return r.graphQLConverter.TenantQuotaToGraphQLTenantQuota(tenantQuota), nil
+}
+
 func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apperrors.AppError) {
 	session := r.dbSessionFactory.NewReadSession()
 
@@ -289,13 +720,19 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 
 	cluster.Kubeconfig = util.PtrTo(string(kubeconfig))
 
+	drift, err := session.ListDriftItems(runtimeID)
+	if err != nil {
+		return model.RuntimeStatus{}, err
+	}
+
 	return model.RuntimeStatus{
 		LastOperationStatus:  operation,
 		RuntimeConfiguration: cluster,
+		Drift:                drift,
 	}, nil
 }
 
-func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster) (model.Operation, dberrors.Error) {
+func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster, seedPlacement *string) (model.Operation, dberrors.Error) {
 	timestamp := time.Now()
 	cluster.CreationTimestamp = timestamp
 
@@ -309,11 +746,21 @@ func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runti
 
 	provisioningMode := model.Provision
 
-	operation, err := r.setOperationStarted(dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, timestamp, "Provisioning started")
+	operation, err := r.setOperationStarted(dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, timestamp, "Provisioning started", seedPlacement)
 	if err != nil {
 		return model.Operation{}, err.Append("Failed to set provisioning started: %s")
 	}
 
+	err = dbSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+		RuntimeID:         runtimeID,
+		OperationID:       &operation.ID,
+		CreationTimestamp: timestamp,
+		Config:            cluster.ClusterConfig,
+	})
+	if err != nil {
+		return model.Operation{}, err.Append("Failed to set provisioning started")
+	}
+
 	return operation, nil
 }
 
@@ -330,12 +777,22 @@ func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSessio
 		return model.Operation{}, dberrors.Internal("Failed to set Shoot Upgrade started: %s", dberr.Error())
 	}
 
-	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, time.Now(), "Starting Gardener Shoot upgrade")
+	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, time.Now(), "Starting Gardener Shoot upgrade", nil)
 
 	if dbError != nil {
 		return model.Operation{}, dbError.Append("Failed to start operation of Gardener Shoot upgrade %s", dbError.Error())
 	}
 
+	dbError = txSession.InsertGardenerConfigRevision(model.GardenerConfigRevision{
+		RuntimeID:         currentCluster.ID,
+		OperationID:       &operation.ID,
+		CreationTimestamp: operation.StartTimestamp,
+		Config:            appliedGardenerConfig(gardenerConfig, currentCluster.ClusterConfig),
+	})
+	if dbError != nil {
+		return model.Operation{}, dbError.Append("Failed to record Gardener config of Gardener Shoot upgrade")
+	}
+
 	return operation, nil
 }
 
@@ -345,7 +802,8 @@ func (r *service) setOperationStarted(
 	operationType model.OperationType,
 	operationStage model.OperationStage,
 	timestamp time.Time,
-	message string) (model.Operation, dberrors.Error) {
+	message string,
+	seedPlacement *string) (model.Operation, dberrors.Error) {
 	id := r.uuidGenerator.New()
 
 	operation := model.Operation{
@@ -357,6 +815,7 @@ func (r *service) setOperationStarted(
 		ClusterID:      runtimeID,
 		Stage:          operationStage,
 		LastTransition: &timestamp,
+		SeedPlacement:  seedPlacement,
 	}
 
 	err := dbSession.InsertOperation(operation)
@@ -367,6 +826,19 @@ func (r *service) setOperationStarted(
 	return operation, nil
 }
 
+// appliedGardenerConfig completes the upgraded config with the fields which are not changed by the upgrade, as they are kept in the database
+func appliedGardenerConfig(upgraded model.GardenerConfig, current model.GardenerConfig) model.GardenerConfig {
+	upgraded.PodsCIDR = current.PodsCIDR
+	upgraded.ServicesCIDR = current.ServicesCIDR
+	upgraded.EuAccess = current.EuAccess
+	upgraded.ShootAndSeedSameRegion = current.ShootAndSeedSameRegion
+	upgraded.DNSConfig = current.DNSConfig
+	if upgraded.OIDCConfig == nil {
+		upgraded.OIDCConfig = current.OIDCConfig
+	}
+	return upgraded
+}
+
 func isVersionHigher(version1, version2 string) (bool, apperrors.AppError) {
 	parsedVersion1, err := version.NewVersion(version1)
 	if err != nil {
@@ -9,14 +9,21 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	cloudProfileMocks "github.com/kyma-project/control-plane/components/provisioner/internal/cloudprofile/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/extensions"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/mocks"
 	queue_mock "github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	mocks2 "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	quotaMocks "github.com/kyma-project/control-plane/components/provisioner/internal/quota/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/regionpolicy"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
+	seedPlacementMocks "github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
@@ -60,7 +67,7 @@ func kubeconfigProviderMock() *queue_mock.KubeconfigProvider {
 }
 
 func TestService_ProvisionRuntime(t *testing.T) {
-	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 	graphQLConverter := NewGraphQLConverter()
 
 	clusterConfig := &gqlschema.ClusterConfigInput{
@@ -120,16 +127,19 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.MatchedBy(func(revision model.GardenerConfigRevision) bool {
+			return revision.RuntimeID == runtimeID && revision.OperationID != nil && revision.Config.ClusterID == runtimeID
+		})).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, kubeconfigProviderMock, nil, nil, nil, nil)
 
 		// when
-		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId, nil)
 		require.NoError(t, err)
 
 		// then
@@ -155,14 +165,15 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(expectErr)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock, nil, nil, nil, nil)
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId, nil)
 		require.Error(t, err)
 
 		//then
@@ -173,6 +184,166 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.AssertExpectations(t)
 	})
 
+	t.Run("Should return error when tenant quota is exceeded", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		provisioner := &mocks2.Provisioner{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		quotaChecker := &quotaMocks.Checker{}
+
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		uuidGeneratorMock.On("New").Return(runtimeID)
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		quotaChecker.On("CheckProvisioning", writeSessionWithinTransactionMock, tenant, 0).Return(apperrors.Forbidden("quota exceeded").SetReason(apperrors.ErrProvisionerQuotaExceeded))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock, quotaChecker, nil, nil, nil)
+
+		// when
+		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId, nil)
+		require.Error(t, err)
+
+		// then
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Equal(t, apperrors.ErrProvisionerQuotaExceeded, err.Reason())
+		quotaChecker.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertNotCalled(t, "InsertCluster", mock.Anything)
+		provisioner.AssertNotCalled(t, "ProvisionCluster")
+	})
+
+	t.Run("Should return error when machine type is not allowed in region", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		provisioner := &mocks2.Provisioner{}
+
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		uuidGeneratorMock.On("New").Return(runtimeID)
+
+		regionPolicies := regionpolicy.NewStaticProvider(regionpolicy.Policies{
+			"gcp": {"me-central2": {AllowedMachineTypes: []string{"n2-standard-4"}}},
+		})
+
+		gardenerConfig := *clusterConfig.GardenerConfig
+		gardenerConfig.Provider = "gcp"
+		gardenerConfig.Region = "me-central2"
+		gardenerConfig.MachineType = "n2-standard-16"
+
+		input := provisionRuntimeInput
+		input.ClusterConfig = &gqlschema.ClusterConfigInput{GardenerConfig: &gardenerConfig}
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock, nil, regionPolicies, nil, nil)
+
+		// when
+		_, err := service.ProvisionRuntime(input, tenant, subAccountId, nil)
+		require.Error(t, err)
+
+		// then
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Contains(t, err.Error(), "machine type n2-standard-16 is not allowed")
+		sessionFactoryMock.AssertNotCalled(t, "NewSessionWithinTransaction")
+		provisioner.AssertNotCalled(t, "ProvisionCluster")
+	})
+
+	t.Run("Should place shoot on seed chosen by seed placer and record the reasons", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		regionPolicies := regionpolicy.NewStaticProvider(regionpolicy.Policies{
+			"gcp": {"me-central2": {SeedSelector: map[string]string{"sovereign": "ksa"}, ForceEuAccess: true}},
+		})
+
+		gardenerConfig := *clusterConfig.GardenerConfig
+		gardenerConfig.Provider = "gcp"
+		gardenerConfig.Region = "me-central2"
+		gardenerConfig.ShootAndSeedSameRegion = util.PtrTo(true)
+
+		input := provisionRuntimeInputNoKymaConfig
+		input.ClusterConfig = &gqlschema.ClusterConfigInput{GardenerConfig: &gardenerConfig}
+
+		seedPlacer := &seedPlacementMocks.Placer{}
+		seedPlacer.On("Place", seedplacement.Requirements{
+			Provider: "gcp",
+			Region:   "me-central2",
+			Labels:   map[string]string{"sovereign": "ksa", model.SeedRegionLabel: "me-central2"},
+			EuAccess: true,
+		}).Return(seedplacement.Decision{SeedName: "ksa-seed", Reasons: []string{"seed ksa-seed chosen with 0 shoots among 1 candidates"}}, nil)
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.MatchedBy(func(config model.GardenerConfig) bool {
+			return config.Seed == "ksa-seed"
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(func(operation model.Operation) bool {
+			return util.UnwrapOrZero(operation.SeedPlacement) == "seed ksa-seed chosen with 0 shoots among 1 candidates"
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.MatchedBy(func(cluster model.Cluster) bool {
+			return cluster.ClusterConfig.Seed == "ksa-seed"
+		}), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, kubeconfigProviderMock, nil, regionPolicies, seedPlacer, nil)
+
+		// when
+		operationStatus, err := service.ProvisionRuntime(input, tenant, subAccountId, nil)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "seed ksa-seed chosen with 0 shoots among 1 candidates", util.UnwrapOrZero(operationStatus.SeedPlacement))
+		seedPlacer.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should not place shoot on seed when seed is requested explicitly", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGeneratorMock := &uuidMocks.UUIDGenerator{}
+		provisioningQueue := &mocks.OperationQueue{}
+		seedPlacer := &seedPlacementMocks.Placer{}
+
+		gardenerConfig := *clusterConfig.GardenerConfig
+		gardenerConfig.Seed = util.PtrTo("requested-seed")
+
+		input := provisionRuntimeInputNoKymaConfig
+		input.ClusterConfig = &gqlschema.ClusterConfigInput{GardenerConfig: &gardenerConfig}
+
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		uuidGeneratorMock.On("New").Return(runtimeID)
+		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(func(operation model.Operation) bool {
+			return operation.SeedPlacement == nil
+		})).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
+		provisioner.On("ProvisionCluster", mock.MatchedBy(func(cluster model.Cluster) bool {
+			return cluster.ClusterConfig.Seed == "requested-seed"
+		}), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, provisioningQueue, nil, nil, kubeconfigProviderMock, nil, nil, seedPlacer, nil)
+
+		// when
+		_, err := service.ProvisionRuntime(input, tenant, subAccountId, nil)
+		require.NoError(t, err)
+
+		// then
+		seedPlacer.AssertNotCalled(t, "Place")
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+	})
+
 	t.Run("Should return error when failed to start provisioning", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -186,13 +357,14 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock)
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGeneratorMock, nil, nil, nil, nil, kubeconfigProviderMock, nil, nil, nil, nil)
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId, nil)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -206,7 +378,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 }
 
 func TestService_DeprovisionRuntime(t *testing.T) {
-	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 	graphQLConverter := NewGraphQLConverter()
 	lastOperation := model.Operation{State: model.Succeeded}
 	mockedKubeconfig := kubeconfig
@@ -248,7 +420,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -294,7 +466,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -319,7 +491,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -344,7 +516,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -368,7 +540,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -391,7 +563,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -408,7 +580,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 func TestService_RuntimeOperationStatus(t *testing.T) {
 	uuidGenerator := &uuidMocks.UUIDGenerator{}
-	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 	graphQLConverter := NewGraphQLConverter()
 
 	operation := model.Operation{
@@ -427,7 +599,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeOperationStatus(operationID)
@@ -450,7 +622,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeOperationStatus(operationID)
@@ -462,9 +634,189 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestService_TenantQuota(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	t.Run("Should return tenant quota", func(t *testing.T) {
+		// given
+		quotaChecker := &quotaMocks.Checker{}
+		quotaChecker.On("TenantQuota", tenant).Return(model.TenantQuota{
+			Tenant: tenant,
+			Limits: model.QuotaLimits{Runtimes: util.PtrTo(10)},
+			Usage:  model.QuotaValues{Runtimes: 2, TotalMaxNodes: 6, ConcurrentOperations: 1},
+		}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, nil, nil, nil, nil, nil, quotaChecker, nil, nil, nil)
+
+		// when
+		tenantQuota, err := service.TenantQuota(tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gqlschema.TenantQuota{
+			Tenant: tenant,
+			Limits: &gqlschema.QuotaLimits{Runtimes: util.PtrTo(10)},
+			Usage:  &gqlschema.QuotaUsage{Runtimes: 2, TotalMaxNodes: 6, ConcurrentOperations: 1},
+		}, tenantQuota)
+	})
+}
+
+func TestService_AuditEvents(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	from := time.Now().Add(-time.Hour)
+
+	event := model.AuditEvent{
+		ID:          "4d9a2d3e-5c0f-4a3b-9e8f-4f1f6a1c2b3d",
+		Timestamp:   time.Now(),
+		Caller:      "kyma-environment-broker",
+		Tenant:      tenant,
+		Mutation:    "deprovisionRuntime",
+		RuntimeID:   util.PtrTo(runtimeID),
+		OperationID: util.PtrTo(operationID),
+		Outcome:     model.AuditEventSucceeded,
+	}
+
+	t.Run("Should return audit events", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListAuditEvents", runtimeID, &from, (*time.Time)(nil)).Return([]model.AuditEvent{event}, nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		events, err := resolver.AuditEvents(runtimeID, &from, nil)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, event.ID, events[0].ID)
+		assert.Equal(t, event.Mutation, events[0].Mutation)
+		assert.Equal(t, event.OperationID, events[0].OperationID)
+		assert.Equal(t, gqlschema.AuditEventOutcomeSucceeded, events[0].Outcome)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when failed to list audit events", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListAuditEvents", runtimeID, (*time.Time)(nil), (*time.Time)(nil)).Return(nil, dberrors.Internal("error"))
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.AuditEvents(runtimeID, nil, nil)
+
+		// then
+		require.Error(t, err)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
+func TestService_RuntimeConfigRevisions(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	firstRevision := model.GardenerConfigRevision{
+		RuntimeID:         runtimeID,
+		Revision:          1,
+		OperationID:       util.PtrTo(operationID),
+		CreationTimestamp: time.Now().Add(-time.Hour),
+		Config: model.GardenerConfig{
+			ClusterID:              runtimeID,
+			KubernetesVersion:      "1.19",
+			MachineType:            "m5.xlarge",
+			AutoScalerMax:          3,
+			GardenerProviderConfig: providerConfig,
+		},
+	}
+	secondRevision := firstRevision
+	secondRevision.Revision = 2
+	secondRevision.OperationID = util.PtrTo("2c1c4bd4-1d37-4a2c-8e0f-0b3f4f6e7a52")
+	secondRevision.Config.KubernetesVersion = "1.20"
+	secondRevision.Config.AutoScalerMax = 5
+	secondRevision.Config.ResourceVersion = 2
+
+	t.Run("Should return config revisions", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListGardenerConfigRevisions", runtimeID).Return([]model.GardenerConfigRevision{firstRevision, secondRevision}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		revisions, err := service.RuntimeConfigRevisions(runtimeID)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, revisions, 2)
+		assert.Equal(t, 1, revisions[0].Revision)
+		assert.Equal(t, firstRevision.OperationID, revisions[0].OperationID)
+		assert.Equal(t, "1.19", *revisions[0].ClusterConfig.KubernetesVersion)
+		assert.Equal(t, 2, revisions[1].Revision)
+		assert.Equal(t, "1.20", *revisions[1].ClusterConfig.KubernetesVersion)
+	})
+
+	t.Run("Should return changes between revisions", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 1).Return(firstRevision, nil)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 2).Return(secondRevision, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		changes, err := service.RuntimeConfigRevisionDiff(runtimeID, 1, 2)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*gqlschema.ConfigChange{
+			{Field: "autoScalerMax", From: util.PtrTo("3"), To: util.PtrTo("5")},
+			{Field: "kubernetesVersion", From: util.PtrTo(`"1.19"`), To: util.PtrTo(`"1.20"`)},
+		}, changes)
+	})
+
+	t.Run("Should return error when revision does not exist", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 1).Return(firstRevision, nil)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 3).Return(model.GardenerConfigRevision{}, dberrors.NotFound("not found"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.RuntimeConfigRevisionDiff(runtimeID, 1, 3)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, dberrors.CodeNotFound)
+	})
+}
+
 func TestService_RuntimeStatus(t *testing.T) {
 	uuidGenerator := &uuidMocks.UUIDGenerator{}
-	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 	graphQLConverter := NewGraphQLConverter()
 
 	operation := model.Operation{
@@ -480,6 +832,8 @@ func TestService_RuntimeStatus(t *testing.T) {
 		Kubeconfig: util.PtrTo(kubeconfig),
 	}
 
+	detectedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
 	t.Run("Should return runtime status", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -488,10 +842,13 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
+		readSession.On("ListDriftItems", operationID).Return([]model.DriftItem{
+			{RuntimeID: runtimeID, Field: "machineType", StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: detectedAt},
+		}, nil)
 
 		provisioner := &mocks2.Provisioner{}
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, kubeconfigProviderMock())
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, kubeconfigProviderMock(), nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeStatus(operationID)
@@ -500,6 +857,9 @@ func TestService_RuntimeStatus(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, cluster.ID, *status.LastOperationStatus.RuntimeID)
 		assert.Equal(t, cluster.Kubeconfig, status.RuntimeConfiguration.Kubeconfig)
+		assert.Equal(t, []*gqlschema.RuntimeDriftItem{
+			{Field: "machineType", StoredValue: util.PtrTo("m5.xlarge"), ActualValue: util.PtrTo("m5.2xlarge"), DetectedAt: detectedAt},
+		}, status.Drift)
 		sessionFactoryMock.AssertExpectations(t)
 		readSession.AssertExpectations(t)
 	})
@@ -513,7 +873,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -532,7 +892,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -545,7 +905,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 }
 
 func TestService_UpgradeGardenerShoot(t *testing.T) {
-	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2)
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
 	graphQLConverter := NewGraphQLConverter()
 	uuidGenerator := uuid.NewUUIDGenerator()
 
@@ -572,6 +932,8 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 		}
 	}
 
+	revisions := []model.GardenerConfigRevision{{RuntimeID: runtimeID, Revision: 1, Config: cluster.ClusterConfig}}
+
 	upgradeShootInput := newUpgradeShootInputAwsAzureGCP("testing")
 	upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(*upgradeShootInput.GardenerConfig, cluster.ClusterConfig)
 	require.NoError(t, err)
@@ -584,6 +946,9 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 	}
 
 	operationMatcher := getOperationMatcher(operation)
+	upgradeRevisionMatcher := func(revision model.GardenerConfigRevision) bool {
+		return revision.RuntimeID == runtimeID && revision.OperationID != nil
+	}
 
 	for _, testCase := range []struct {
 		description string
@@ -595,7 +960,13 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return([]model.GardenerConfigRevision{}, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+				writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
+				writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(func(revision model.GardenerConfigRevision) bool {
+					return revision.RuntimeID == runtimeID && revision.OperationID == nil && revision.Config.ClusterID == runtimeID
+				})).Return(nil).Once()
 
 				newUpgradedConfig := upgradedConfig
 				newUpgradedConfig.KubernetesVersion = "1.20"
@@ -605,6 +976,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(upgradeRevisionMatcher)).Return(nil)
 				provisioner.On("UpgradeCluster", runtimeID, newUpgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
@@ -617,12 +989,16 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+				writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(upgradeRevisionMatcher)).Return(nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
@@ -643,10 +1019,10 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, nil, nil)
 
 			// when
-			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput, nil)
 			require.NoError(t, err)
 
 			// then
@@ -669,10 +1045,14 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+				writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(upgradeRevisionMatcher)).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
@@ -686,10 +1066,14 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+				writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(upgradeRevisionMatcher)).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(apperrors.Internal("error"))
@@ -702,7 +1086,10 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+				writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
 				writeSession.On("RollbackUnlessCommitted").Return()
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
@@ -714,6 +1101,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				sessionFactory.On("NewSessionWithinTransaction").Return(nil, dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
@@ -746,6 +1134,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				sessionFactory.On("NewReadSession").Return(readSession)
 				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				readSession.On("ListGardenerConfigRevisions", runtimeID).Return(revisions, nil)
 				shootProvider.On("Get", runtimeID, tenant).Return(gardener_Types.Shoot{}, apperrors.Internal("oh, no!"))
 			},
 		},
@@ -763,10 +1152,10 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, nil, nil)
 
 			// when
-			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput, nil)
 			require.Error(t, err)
 
 			// then
@@ -777,6 +1166,680 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 	}
 }
 
+func TestService_UpgradeGardenerShoot_ConcurrentModification(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID:              runtimeID,
+			GardenerProviderConfig: providerConfig,
+			ResourceVersion:        3,
+		},
+	}
+
+	shoot := gardener_Types.Shoot{
+		Spec: gardener_Types.ShootSpec{
+			Kubernetes: gardener_Types.Kubernetes{Version: "1.19"},
+		},
+	}
+
+	for _, testCase := range []struct {
+		description             string
+		expectedResourceVersion *int
+		currentResourceVersion  int
+		inProgress              bool
+	}{
+		{
+			description:             "should return conflict when resource version was changed",
+			expectedResourceVersion: util.PtrTo(3),
+			currentResourceVersion:  4,
+		},
+		{
+			description:            "should return conflict when operation was started concurrently",
+			currentResourceVersion: 3,
+			inProgress:             true,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactory := &sessionMocks.Factory{}
+			readSession := &sessionMocks.ReadSession{}
+			writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+			shootProvider := &mocks2.ShootProvider{}
+
+			sessionFactory.On("NewReadSession").Return(readSession)
+			readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.Succeeded}, nil)
+			readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+			readSession.On("ListGardenerConfigRevisions", runtimeID).Return([]model.GardenerConfigRevision{}, nil)
+			shootProvider.On("Get", runtimeID, tenant).Return(shoot, nil)
+			sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+			writeSession.On("LockGardenerConfig", runtimeID).Return(testCase.currentResourceVersion, nil)
+			writeSession.On("HasInProgressOperation", runtimeID).Return(testCase.inProgress, nil).Maybe()
+			writeSession.On("RollbackUnlessCommitted").Return()
+
+			upgradeShootInput := newUpgradeShootInputAwsAzureGCP("testing")
+			upgradeShootInput.GardenerConfig.ExpectedResourceVersion = testCase.expectedResourceVersion
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuid.NewUUIDGenerator(), shootProvider, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput, nil)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeConflict)
+			sessionFactory.AssertExpectations(t)
+			writeSession.AssertExpectations(t)
+			writeSession.AssertNotCalled(t, "UpdateGardenerClusterConfig", mock.Anything)
+		})
+	}
+}
+
+func TestService_UpgradeGardenerShoot_CloudProfile(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	profile := &gardener_Types.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "gcp"},
+		Spec: gardener_Types.CloudProfileSpec{
+			Kubernetes: gardener_Types.KubernetesSettings{
+				Versions: []gardener_Types.ExpirableVersion{
+					{Version: "1.28.2", Classification: util.PtrTo(gardener_Types.ClassificationSupported)},
+					{Version: "1.28.1", Classification: util.PtrTo(gardener_Types.ClassificationDeprecated)},
+				},
+			},
+			MachineImages: []gardener_Types.MachineImage{
+				{
+					Name: "gardenlinux",
+					Versions: []gardener_Types.MachineImageVersion{
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.10.0"}},
+					},
+				},
+			},
+			MachineTypes: []gardener_Types.MachineType{{Name: "n2-standard-4"}},
+		},
+	}
+
+	current := model.GardenerConfig{
+		Region:              "europe-west3",
+		KubernetesVersion:   "1.27.5",
+		MachineType:         "n1-standard-4",
+		MachineImage:        util.PtrTo("gardenlinux"),
+		MachineImageVersion: util.PtrTo("934.9.0"),
+	}
+
+	t.Run("should not validate values which are not changed", func(t *testing.T) {
+		// given
+		cloudProfiles := &cloudProfileMocks.Provider{}
+		svc := &service{cloudProfiles: cloudProfiles}
+
+		config := current
+		config.AutoScalerMax = 10
+
+		// when
+		err := svc.resolveCloudProfileValues("gcp", &config, current)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, current.KubernetesVersion, config.KubernetesVersion)
+		cloudProfiles.AssertNotCalled(t, "CloudProfile", mock.Anything)
+	})
+
+	t.Run("should complete Kubernetes version and validate changed machine type", func(t *testing.T) {
+		// given
+		cloudProfiles := &cloudProfileMocks.Provider{}
+		cloudProfiles.On("CloudProfile", "gcp").Return(profile, nil)
+		svc := &service{cloudProfiles: cloudProfiles}
+
+		config := current
+		config.KubernetesVersion = "1.28"
+		config.MachineType = "n2-standard-4"
+
+		// when
+		err := svc.resolveCloudProfileValues("gcp", &config, current)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1.28.2", config.KubernetesVersion)
+		assert.Equal(t, "934.9.0", util.UnwrapOrZero(config.MachineImageVersion))
+	})
+
+	for _, testCase := range []struct {
+		description string
+		modify      func(config *model.GardenerConfig)
+	}{
+		{
+			description: "should reject deprecated Kubernetes version",
+			modify:      func(config *model.GardenerConfig) { config.KubernetesVersion = "1.28.1" },
+		},
+		{
+			description: "should reject machine type not available in CloudProfile",
+			modify:      func(config *model.GardenerConfig) { config.MachineType = "n2-standard-64" },
+		},
+		{
+			description: "should reject machine image version not available in CloudProfile",
+			modify:      func(config *model.GardenerConfig) { config.MachineImageVersion = util.PtrTo("1.0.0") },
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			cloudProfiles := &cloudProfileMocks.Provider{}
+			cloudProfiles.On("CloudProfile", "gcp").Return(profile, nil)
+			svc := &service{cloudProfiles: cloudProfiles}
+
+			config := current
+			testCase.modify(&config)
+
+			// when
+			err := svc.resolveCloudProfileValues("gcp", &config, current)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("should reject upgrade before it is started", func(t *testing.T) {
+		// given
+		providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west3-a"}})
+		cluster := model.Cluster{ID: runtimeID, Tenant: tenant, ClusterConfig: current}
+		cluster.ClusterConfig.ClusterID = runtimeID
+		cluster.ClusterConfig.GardenerProviderConfig = providerConfig
+
+		shoot := gardener_Types.Shoot{
+			Spec: gardener_Types.ShootSpec{
+				CloudProfileName: "gcp",
+				Kubernetes:       gardener_Types.Kubernetes{Version: "1.27.5"},
+			},
+		}
+
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+		cloudProfiles := &cloudProfileMocks.Provider{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("ListGardenerConfigRevisions", runtimeID).Return([]model.GardenerConfigRevision{}, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(shoot, nil)
+		cloudProfiles.On("CloudProfile", "gcp").Return(profile, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{KubernetesVersion: util.PtrTo("1.28.1")},
+		}
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuid.NewUUIDGenerator(), shootProvider, nil, nil, nil, nil, nil, nil, nil, cloudProfiles)
+
+		// when
+		_, err := service.UpgradeGardenerShoot(runtimeID, input, nil)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+	})
+}
+
+func TestService_UpgradeGardenerShoot_Rollback(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID:              runtimeID,
+			Name:                   "shoot",
+			Region:                 "europe-west1",
+			KubernetesVersion:      "1.19",
+			MachineType:            "n2-standard-8",
+			AutoScalerMax:          6,
+			GardenerProviderConfig: providerConfig,
+			ResourceVersion:        2,
+		},
+	}
+
+	revision := model.GardenerConfigRevision{
+		RuntimeID: runtimeID,
+		Revision:  1,
+		Config: model.GardenerConfig{
+			ClusterID:              runtimeID,
+			Name:                   "shoot",
+			Region:                 "europe-west1",
+			KubernetesVersion:      "1.19",
+			MachineType:            "n2-standard-4",
+			AutoScalerMax:          3,
+			GardenerProviderConfig: providerConfig,
+		},
+	}
+
+	shoot := gardener_Types.Shoot{
+		Spec: gardener_Types.ShootSpec{
+			Kubernetes: gardener_Types.Kubernetes{Version: "1.19"},
+		},
+	}
+
+	input := gqlschema.UpgradeShootInput{Revision: util.PtrTo(1)}
+
+	t.Run("Should upgrade Shoot to the config of the revision", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		upgradeShootQueue := &mocks.OperationQueue{}
+
+		rolledBackConfig := func(config model.GardenerConfig) bool {
+			return config.MachineType == "n2-standard-4" && config.AutoScalerMax == 3 && config.Name == "shoot"
+		}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 1).Return(revision, nil)
+		readSession.On("ListGardenerConfigRevisions", runtimeID).Return([]model.GardenerConfigRevision{revision}, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(shoot, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(2, nil)
+		writeSession.On("HasInProgressOperation", runtimeID).Return(false, nil)
+		writeSession.On("UpdateGardenerClusterConfig", mock.MatchedBy(rolledBackConfig)).Return(nil)
+		writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+		writeSession.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(func(revision model.GardenerConfigRevision) bool {
+			return revision.OperationID != nil && rolledBackConfig(revision.Config)
+		})).Return(nil)
+		writeSession.On("Commit").Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		provisioner.On("UpgradeCluster", runtimeID, mock.MatchedBy(rolledBackConfig)).Return(nil)
+		upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuid.NewUUIDGenerator(), shootProvider, nil, nil, upgradeShootQueue, nil, nil, nil, nil, nil)
+
+		// when
+		operationStatus, err := service.UpgradeGardenerShoot(runtimeID, input, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		upgradeShootQueue.AssertExpectations(t)
+	})
+
+	t.Run("Should return bad request when revision does not exist", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		readSession.On("GetGardenerConfigRevision", runtimeID, 1).Return(model.GardenerConfigRevision{}, dberrors.NotFound("not found"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.UpgradeGardenerShoot(runtimeID, input, nil)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+	})
+}
+
+func TestService_ImportRuntime(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	gardenerConfig := model.GardenerConfig{
+		Name:                   "shoot",
+		ProjectName:            gardenerProject,
+		Region:                 "europe-west1",
+		Provider:               "gcp",
+		TargetSecret:           "secret",
+		KubernetesVersion:      "1.27.5",
+		MachineType:            "n2-standard-4",
+		AutoScalerMin:          3,
+		AutoScalerMax:          6,
+		WorkerCidr:             "10.250.0.0/16",
+		GardenerProviderConfig: providerConfig,
+	}
+	shoot, err := gardenerConfig.ToShootTemplate("garden-"+gardenerProject, tenant, subAccountId, nil, nil)
+	require.NoError(t, err)
+
+	importedCluster := func(cluster model.Cluster) bool {
+		return cluster.ID == runtimeID && cluster.Tenant == tenant && cluster.ClusterConfig.Name == "shoot" &&
+			cluster.ClusterConfig.MachineType == "n2-standard-4" && cluster.ClusterConfig.ClusterID == runtimeID
+	}
+
+	t.Run("Should import shoot as Runtime", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		uuidGenerator := &uuidMocks.UUIDGenerator{}
+		quotaChecker := &quotaMocks.Checker{}
+
+		uuidGenerator.On("New").Return(runtimeID).Once()
+		uuidGenerator.On("New").Return("gardener-config-id").Once()
+		uuidGenerator.On("New").Return(operationID).Once()
+		provisioner.On("GetShoot", "shoot").Return(*shoot, nil)
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetGardenerClusterByName", "shoot").Return(model.Cluster{}, dberrors.NotFound("not found"))
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		quotaChecker.On("CheckProvisioning", writeSession, tenant, 6).Return(nil)
+		writeSession.On("InsertCluster", mock.MatchedBy(importedCluster)).Return(nil)
+		writeSession.On("InsertGardenerConfig", mock.MatchedBy(func(config model.GardenerConfig) bool {
+			return config.ID == "gardener-config-id" && config.ClusterID == runtimeID
+		})).Return(nil)
+		writeSession.On("InsertOperation", mock.MatchedBy(getOperationMatcher(model.Operation{
+			Type:      model.Provision,
+			ClusterID: runtimeID,
+			State:     model.Succeeded,
+			Stage:     model.FinishedStage,
+		}))).Return(nil)
+		writeSession.On("InsertGardenerConfigRevision", mock.MatchedBy(func(revision model.GardenerConfigRevision) bool {
+			return revision.RuntimeID == runtimeID && *revision.OperationID == operationID
+		})).Return(nil)
+		provisioner.On("AdoptCluster", mock.MatchedBy(importedCluster), operationID).Return(nil)
+		writeSession.On("Commit").Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuidGenerator, nil, nil, nil, nil, nil, quotaChecker, nil, nil, nil)
+
+		// when
+		operationStatus, err := service.ImportRuntime("shoot", tenant, util.PtrTo(subAccountId))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Equal(t, operationID, *operationStatus.ID)
+		assert.Equal(t, gqlschema.OperationStateSucceeded, operationStatus.State)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		quotaChecker.AssertExpectations(t)
+	})
+
+	t.Run("Should return conflict when shoot is already managed", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		provisioner.On("GetShoot", "shoot").Return(*shoot, nil)
+		sessionFactory.On("NewReadSession").Return(readSession)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		readSession.On("GetGardenerClusterByName", "shoot").Return(model.Cluster{ID: runtimeID}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ImportRuntime("shoot", tenant, nil)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeConflict)
+		writeSession.AssertNotCalled(t, "InsertCluster", mock.Anything)
+		provisioner.AssertNotCalled(t, "AdoptCluster", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should return error when tenant quota is exceeded", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		quotaChecker := &quotaMocks.Checker{}
+
+		provisioner.On("GetShoot", "shoot").Return(*shoot, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		quotaChecker.On("CheckProvisioning", writeSession, tenant, 6).Return(apperrors.Forbidden("quota exceeded").SetReason(apperrors.ErrProvisionerQuotaExceeded))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, quotaChecker, nil, nil, nil)
+
+		// when
+		_, err := service.ImportRuntime("shoot", tenant, nil)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeForbidden)
+		assert.Equal(t, apperrors.ErrProvisionerQuotaExceeded, err.Reason())
+		quotaChecker.AssertExpectations(t)
+		writeSession.AssertNotCalled(t, "InsertCluster", mock.Anything)
+		provisioner.AssertNotCalled(t, "AdoptCluster", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should not commit when shoot cannot be adopted", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		provisioner.On("GetShoot", "shoot").Return(*shoot, nil)
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetGardenerClusterByName", "shoot").Return(model.Cluster{}, dberrors.NotFound("not found"))
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, nil)
+		writeSession.On("InsertCluster", mock.AnythingOfType("model.Cluster")).Return(nil)
+		writeSession.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSession.On("InsertOperation", mock.AnythingOfType("model.Operation")).Return(nil)
+		writeSession.On("InsertGardenerConfigRevision", mock.AnythingOfType("model.GardenerConfigRevision")).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		provisioner.On("AdoptCluster", mock.AnythingOfType("model.Cluster"), mock.AnythingOfType("string")).Return(apperrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ImportRuntime("shoot", tenant, nil)
+
+		// then
+		require.Error(t, err)
+		writeSession.AssertNotCalled(t, "Commit")
+		writeSession.AssertCalled(t, "RollbackUnlessCommitted")
+	})
+}
+
+func TestService_UpdateRuntimeMetadata(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, defaultEnableIMDSv2, extensions.Config{})
+	graphQLConverter := NewGraphQLConverter()
+
+	cluster := model.Cluster{
+		ID:            runtimeID,
+		Labels:        map[string]interface{}{"team": "core"},
+		Description:   util.PtrTo("Runtime of the core team"),
+		ClusterConfig: model.GardenerConfig{Name: "shoot"},
+	}
+	operation := model.Operation{ID: operationID, ClusterID: runtimeID, State: model.Succeeded}
+
+	t.Run("Should replace labels and remove description", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		labels := map[string]interface{}{"team": "edge"}
+		updated := cluster
+		updated.Labels = labels
+		updated.Description = nil
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil).Once()
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+		writeSession.On("UpdateRuntimeMetadata", runtimeID, labels, (*string)(nil)).Return(nil)
+		provisioner.On("UpdateRuntimeMetadata", updated).Return(nil)
+		writeSession.On("Commit").Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(updated, nil).Once()
+		readSession.On("ListDriftItems", runtimeID).Return(nil, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, nil, nil, nil, nil, nil, kubeconfigProviderMock(), nil, nil, nil, nil)
+
+		// when
+		status, err := service.UpdateRuntimeMetadata(runtimeID, labels, util.PtrTo(""))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.Labels(labels), status.Labels)
+		assert.Nil(t, status.Description)
+		sessionFactory.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should keep labels when only description is provided", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		updated := cluster
+		updated.Description = util.PtrTo("Moved")
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil).Once()
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+		writeSession.On("UpdateRuntimeMetadata", runtimeID, cluster.Labels, util.PtrTo("Moved")).Return(nil)
+		provisioner.On("UpdateRuntimeMetadata", updated).Return(nil)
+		writeSession.On("Commit").Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		readSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readSession.On("GetCluster", runtimeID).Return(updated, nil).Once()
+		readSession.On("ListDriftItems", runtimeID).Return(nil, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, nil, nil, nil, nil, nil, kubeconfigProviderMock(), nil, nil, nil, nil)
+
+		// when
+		status, err := service.UpdateRuntimeMetadata(runtimeID, nil, util.PtrTo("Moved"))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.Labels(cluster.Labels), status.Labels)
+		assert.Equal(t, util.PtrTo("Moved"), status.Description)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should not commit when shoot cannot be updated", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+		writeSession.On("UpdateRuntimeMetadata", runtimeID, mock.Anything, mock.Anything).Return(nil)
+		provisioner.On("UpdateRuntimeMetadata", mock.Anything).Return(apperrors.Internal("error"))
+		writeSession.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, provisioner, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.UpdateRuntimeMetadata(runtimeID, map[string]interface{}{"team": "edge"}, nil)
+
+		// then
+		require.Error(t, err)
+		writeSession.AssertNotCalled(t, "Commit")
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+	})
+
+	t.Run("Should not read Runtime when it cannot be locked", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(0, dberrors.NotFound("not found"))
+		writeSession.On("RollbackUnlessCommitted").Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.UpdateRuntimeMetadata(runtimeID, nil, util.PtrTo("Moved"))
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, dberrors.CodeNotFound)
+		sessionFactory.AssertNotCalled(t, "NewReadSession")
+		writeSession.AssertNotCalled(t, "UpdateRuntimeMetadata", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should return error for deleted Runtime", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+
+		deleted := cluster
+		deleted.Deleted = true
+
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		writeSession.On("LockGardenerConfig", runtimeID).Return(1, nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetCluster", runtimeID).Return(deleted, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.UpdateRuntimeMetadata(runtimeID, nil, util.PtrTo("Moved"))
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestService_RuntimeIDs(t *testing.T) {
+	// given
+	sessionFactory := &sessionMocks.Factory{}
+	readSession := &sessionMocks.ReadSession{}
+
+	labels := gqlschema.Labels{"team": "core"}
+
+	sessionFactory.On("NewReadSession").Return(readSession)
+	readSession.On("ListRuntimeIDsByLabels", tenant, map[string]interface{}(labels)).Return([]string{runtimeID}, nil)
+
+	service := NewProvisioningService(nil, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	// when
+	runtimeIDs, err := service.RuntimeIDs(tenant, labels)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{runtimeID}, runtimeIDs)
+	readSession.AssertExpectations(t)
+}
+
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
 	return func(op model.Operation) bool {
 		return op.Type == expected.Type && op.ClusterID == expected.ClusterID &&
@@ -0,0 +1,160 @@
+package quota
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+)
+
+//go:generate mockery --name=Checker
+type Checker interface {
+	// CheckProvisioning locks quota of the tenant until the end of the transaction, so it must be called before the Runtime is stored
+	CheckProvisioning(session dbsession.WriteSession, tenant string, maxNodes int) apperrors.AppError
+	// CheckUpgrade locks quota of the tenant until the end of the transaction, so it must be called before the upgrade operation is stored
+	CheckUpgrade(session dbsession.WriteSession, tenant string, currentMaxNodes, newMaxNodes int) apperrors.AppError
+	TenantQuota(tenant string) (model.TenantQuota, apperrors.AppError)
+}
+
+// LoadDefaultLimits reads limits applied to tenants without the override stored in the database. Empty path means no limits.
+func LoadDefaultLimits(path string) (model.QuotaLimits, error) {
+	if path == "" {
+		return model.QuotaLimits{}, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return model.QuotaLimits{}, fmt.Errorf("failed to open quota config file: %s", err.Error())
+	}
+	defer file.Close()
+
+	var limits model.QuotaLimits
+	if err := json.NewDecoder(file).Decode(&limits); err != nil {
+		return model.QuotaLimits{}, fmt.Errorf("failed to decode quota config file: %s", err.Error())
+	}
+
+	return limits, nil
+}
+
+type checker struct {
+	defaultLimits    model.QuotaLimits
+	dbSessionFactory dbsession.Factory
+}
+
+func NewChecker(defaultLimits model.QuotaLimits, factory dbsession.Factory) Checker {
+	return &checker{
+		defaultLimits:    defaultLimits,
+		dbSessionFactory: factory,
+	}
+}
+
+func (c *checker) CheckProvisioning(session dbsession.WriteSession, tenant string, maxNodes int) apperrors.AppError {
+	quota, err := c.lockTenantQuota(session, tenant)
+	if err != nil {
+		return err
+	}
+
+	requested := model.QuotaValues{
+		Runtimes:             1,
+		TotalMaxNodes:        maxNodes,
+		ConcurrentOperations: 1,
+	}
+
+	return checkLimits(quota, requested)
+}
+
+// CheckUpgrade verifies only the increase of the resources, so that upgrades reducing the usage are always allowed
+func (c *checker) CheckUpgrade(session dbsession.WriteSession, tenant string, currentMaxNodes, newMaxNodes int) apperrors.AppError {
+	quota, err := c.lockTenantQuota(session, tenant)
+	if err != nil {
+		return err
+	}
+
+	requested := model.QuotaValues{
+		ConcurrentOperations: 1,
+	}
+	if newMaxNodes > currentMaxNodes {
+		requested.TotalMaxNodes = newMaxNodes - currentMaxNodes
+	}
+
+	return checkLimits(quota, requested)
+}
+
+func (c *checker) lockTenantQuota(session dbsession.WriteSession, tenant string) (model.TenantQuota, apperrors.AppError) {
+	override, usage, dberr := session.LockTenantQuota(tenant)
+	if dberr != nil {
+		return model.TenantQuota{}, dberr.Append("failed to lock quota")
+	}
+
+	return model.TenantQuota{
+		Tenant: tenant,
+		Limits: applyOverride(c.defaultLimits, override),
+		Usage:  usage,
+	}, nil
+}
+
+func (c *checker) TenantQuota(tenant string) (model.TenantQuota, apperrors.AppError) {
+	session := c.dbSessionFactory.NewReadSession()
+
+	limits := c.defaultLimits
+
+	override, dberr := session.GetTenantQuotaOverride(tenant)
+	if dberr != nil && dberr.Code() != dberrors.CodeNotFound {
+		return model.TenantQuota{}, dberr.Append("failed to get quota override")
+	}
+	if dberr == nil {
+		limits = applyOverride(limits, override)
+	}
+
+	usage, dberr := session.GetTenantQuotaUsage(tenant)
+	if dberr != nil {
+		return model.TenantQuota{}, dberr.Append("failed to get quota usage")
+	}
+
+	return model.TenantQuota{
+		Tenant: tenant,
+		Limits: limits,
+		Usage:  usage,
+	}, nil
+}
+
+func applyOverride(limits model.QuotaLimits, override model.TenantQuotaOverride) model.QuotaLimits {
+	if override.Runtimes != nil {
+		limits.Runtimes = override.Runtimes
+	}
+	if override.TotalMaxNodes != nil {
+		limits.TotalMaxNodes = override.TotalMaxNodes
+	}
+	if override.ConcurrentOperations != nil {
+		limits.ConcurrentOperations = override.ConcurrentOperations
+	}
+	return limits
+}
+
+func checkLimits(quota model.TenantQuota, requested model.QuotaValues) apperrors.AppError {
+	if exceeds(quota.Usage.Runtimes, requested.Runtimes, quota.Limits.Runtimes) {
+		return quotaExceeded(quota.Tenant, "Runtimes", quota.Usage.Runtimes, *quota.Limits.Runtimes)
+	}
+	if exceeds(quota.Usage.TotalMaxNodes, requested.TotalMaxNodes, quota.Limits.TotalMaxNodes) {
+		return quotaExceeded(quota.Tenant, "total max nodes", quota.Usage.TotalMaxNodes, *quota.Limits.TotalMaxNodes)
+	}
+	if exceeds(quota.Usage.ConcurrentOperations, requested.ConcurrentOperations, quota.Limits.ConcurrentOperations) {
+		return quotaExceeded(quota.Tenant, "concurrent operations", quota.Usage.ConcurrentOperations, *quota.Limits.ConcurrentOperations)
+	}
+
+	return nil
+}
+
+// exceeds treats nil limit as unlimited, while zero limit blocks any request of the resource
+func exceeds(usage, requested int, limit *int) bool {
+	return limit != nil && requested > 0 && usage+requested > *limit
+}
+
+func quotaExceeded(tenant, resource string, usage, limit int) apperrors.AppError {
+	return apperrors.Forbidden("quota of %s exceeded for tenant %s: %d used, limit %d", resource, tenant, usage, limit).
+		SetReason(apperrors.ErrProvisionerQuotaExceeded)
+}
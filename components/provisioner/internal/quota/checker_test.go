@@ -0,0 +1,212 @@
+package quota
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+const tenant = "tenant"
+
+func TestLoadDefaultLimits(t *testing.T) {
+	t.Run("Should load limits from file", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "quota.json")
+		err := os.WriteFile(path, []byte(`{"runtimes": 10, "totalMaxNodes": 100}`), 0600)
+		require.NoError(t, err)
+
+		// when
+		limits, err := LoadDefaultLimits(path)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.QuotaLimits{Runtimes: util.PtrTo(10), TotalMaxNodes: util.PtrTo(100)}, limits)
+	})
+
+	t.Run("Should return no limits if path is empty", func(t *testing.T) {
+		// when
+		limits, err := LoadDefaultLimits("")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.QuotaLimits{}, limits)
+	})
+
+	t.Run("Should return error if file does not exist", func(t *testing.T) {
+		// when
+		_, err := LoadDefaultLimits(filepath.Join(t.TempDir(), "missing.json"))
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestChecker(t *testing.T) {
+	defaultLimits := model.QuotaLimits{Runtimes: util.PtrTo(2), TotalMaxNodes: util.PtrTo(20), ConcurrentOperations: util.PtrTo(2)}
+
+	for _, testCase := range []struct {
+		description   string
+		defaultLimits *model.QuotaLimits
+		override      model.TenantQuotaOverride
+		usage         model.QuotaValues
+		check         func(Checker, dbsession.WriteSession) apperrors.AppError
+		exceeded      bool
+	}{
+		{
+			description: "should allow provisioning within default limits",
+			usage:       model.QuotaValues{Runtimes: 1, TotalMaxNodes: 10},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 10)
+			},
+		},
+		{
+			description: "should reject provisioning exceeding Runtimes limit",
+			usage:       model.QuotaValues{Runtimes: 2, TotalMaxNodes: 6},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 3)
+			},
+			exceeded: true,
+		},
+		{
+			description: "should reject provisioning exceeding total max nodes limit",
+			usage:       model.QuotaValues{Runtimes: 1, TotalMaxNodes: 15},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 6)
+			},
+			exceeded: true,
+		},
+		{
+			description: "should allow provisioning with limit raised by override",
+			override:    model.TenantQuotaOverride{Tenant: tenant, Runtimes: util.PtrTo(5)},
+			usage:       model.QuotaValues{Runtimes: 2, TotalMaxNodes: 6},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 3)
+			},
+		},
+		{
+			description: "should reject provisioning with zero limit set by override",
+			override:    model.TenantQuotaOverride{Tenant: tenant, Runtimes: util.PtrTo(0)},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 3)
+			},
+			exceeded: true,
+		},
+		{
+			description:   "should allow provisioning without limits",
+			defaultLimits: &model.QuotaLimits{},
+			usage:         model.QuotaValues{Runtimes: 100, TotalMaxNodes: 1000, ConcurrentOperations: 10},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckProvisioning(s, tenant, 60)
+			},
+		},
+		{
+			description: "should reject upgrade exceeding concurrent operations limit",
+			usage:       model.QuotaValues{Runtimes: 2, TotalMaxNodes: 6, ConcurrentOperations: 2},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckUpgrade(s, tenant, 3, 3)
+			},
+			exceeded: true,
+		},
+		{
+			description: "should reject upgrade increasing max nodes above limit",
+			usage:       model.QuotaValues{Runtimes: 2, TotalMaxNodes: 18},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckUpgrade(s, tenant, 9, 12)
+			},
+			exceeded: true,
+		},
+		{
+			description: "should allow upgrade decreasing max nodes when limit is already exceeded",
+			usage:       model.QuotaValues{Runtimes: 2, TotalMaxNodes: 30},
+			check: func(c Checker, s dbsession.WriteSession) apperrors.AppError {
+				return c.CheckUpgrade(s, tenant, 20, 10)
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			writeSession := &sessionMocks.WriteSession{}
+			writeSession.On("LockTenantQuota", tenant).Return(testCase.override, testCase.usage, nil)
+
+			checker := NewChecker(util.UnwrapOrDefault(testCase.defaultLimits, defaultLimits), &sessionMocks.Factory{})
+
+			// when
+			err := testCase.check(checker, writeSession)
+
+			// then
+			if testCase.exceeded {
+				require.Error(t, err)
+				assert.Equal(t, apperrors.CodeForbidden, err.Code())
+				assert.Equal(t, apperrors.ErrProvisionerQuotaExceeded, err.Reason())
+			} else {
+				require.NoError(t, err)
+			}
+			writeSession.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return tenant quota with applied override", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenantQuotaOverride", tenant).Return(model.TenantQuotaOverride{Tenant: tenant, ConcurrentOperations: util.PtrTo(1)}, nil)
+		readSession.On("GetTenantQuotaUsage", tenant).Return(model.QuotaValues{Runtimes: 1, TotalMaxNodes: 3}, nil)
+
+		checker := NewChecker(defaultLimits, sessionFactory)
+
+		// when
+		quota, err := checker.TenantQuota(tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, model.TenantQuota{
+			Tenant: tenant,
+			Limits: model.QuotaLimits{Runtimes: util.PtrTo(2), TotalMaxNodes: util.PtrTo(20), ConcurrentOperations: util.PtrTo(1)},
+			Usage:  model.QuotaValues{Runtimes: 1, TotalMaxNodes: 3},
+		}, quota)
+	})
+
+	t.Run("should return default limits when override is not found", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenantQuotaOverride", tenant).Return(model.TenantQuotaOverride{}, dberrors.NotFound("Quota override not found for tenant %s", tenant))
+		readSession.On("GetTenantQuotaUsage", tenant).Return(model.QuotaValues{}, nil)
+
+		checker := NewChecker(defaultLimits, sessionFactory)
+
+		// when
+		quota, err := checker.TenantQuota(tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, defaultLimits, quota.Limits)
+	})
+
+	t.Run("should return error when failed to lock quota", func(t *testing.T) {
+		// given
+		writeSession := &sessionMocks.WriteSession{}
+		writeSession.On("LockTenantQuota", tenant).Return(model.TenantQuotaOverride{}, model.QuotaValues{}, dberrors.Internal("error"))
+
+		checker := NewChecker(defaultLimits, &sessionMocks.Factory{})
+
+		// when
+		err := checker.CheckProvisioning(writeSession, tenant, 3)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to lock quota")
+	})
+}
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	dbsession "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// Checker is an autogenerated mock type for the Checker type
+type Checker struct {
+	mock.Mock
+}
+
+// CheckProvisioning provides a mock function with given fields: session, tenant, maxNodes
+func (_m *Checker) CheckProvisioning(session dbsession.WriteSession, tenant string, maxNodes int) apperrors.AppError {
+	ret := _m.Called(session, tenant, maxNodes)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(dbsession.WriteSession, string, int) apperrors.AppError); ok {
+		r0 = rf(session, tenant, maxNodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// CheckUpgrade provides a mock function with given fields: session, tenant, currentMaxNodes, newMaxNodes
+func (_m *Checker) CheckUpgrade(session dbsession.WriteSession, tenant string, currentMaxNodes int, newMaxNodes int) apperrors.AppError {
+	ret := _m.Called(session, tenant, currentMaxNodes, newMaxNodes)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(dbsession.WriteSession, string, int, int) apperrors.AppError); ok {
+		r0 = rf(session, tenant, currentMaxNodes, newMaxNodes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// TenantQuota provides a mock function with given fields: tenant
+func (_m *Checker) TenantQuota(tenant string) (model.TenantQuota, apperrors.AppError) {
+	ret := _m.Called(tenant)
+
+	var r0 model.TenantQuota
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.TenantQuota, apperrors.AppError)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.TenantQuota); ok {
+		r0 = rf(tenant)
+	} else {
+		r0 = ret.Get(0).(model.TenantQuota)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(tenant)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewChecker creates a new instance of Checker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Checker {
+	mock := &Checker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,149 @@
+package regionpolicy
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// Policy defines the requirements for shoots of a provider in a region
+type Policy struct {
+	// Tolerations are keys of the tolerations added to the shoot
+	Tolerations []string `json:"tolerations,omitempty"`
+	// SeedSelector contains labels which the seed of the shoot must have
+	SeedSelector map[string]string `json:"seedSelector,omitempty"`
+	// ForceEuAccess enables EU access regardless of the input
+	ForceEuAccess bool `json:"forceEuAccess,omitempty"`
+	// AllowedMachineTypes restrict the machine types of the workers. Empty list allows all machine types.
+	AllowedMachineTypes []string `json:"allowedMachineTypes,omitempty"`
+	// Annotations are added to the shoot
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Labels are added to the shoot
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Policies map provider types to regions and their policies
+type Policies map[string]map[string]Policy
+
+// DefaultPolicies are used when no region policy config is provided
+func DefaultPolicies() Policies {
+	return Policies{
+		"gcp": {
+			"me-central2": {Tolerations: []string{"ksa-assured-workload"}},
+		},
+	}
+}
+
+// Policy returns the policy of the region. Regions without policy get the empty one, which does not change the shoot.
+func (p Policies) Policy(provider, region string) Policy {
+	return p[provider][region]
+}
+
+// ParsePolicies reads policies from the JSON config and validates them
+func ParsePolicies(data []byte) (Policies, error) {
+	var policies Policies
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("failed to decode region policy config: %s", err.Error())
+	}
+
+	for provider, regions := range policies {
+		if provider == "" {
+			return nil, fmt.Errorf("region policy config contains empty provider")
+		}
+		for region, policy := range regions {
+			if region == "" {
+				return nil, fmt.Errorf("region policy config of provider %s contains empty region", provider)
+			}
+			for _, toleration := range policy.Tolerations {
+				if toleration == "" {
+					return nil, fmt.Errorf("region policy of %s region %s contains empty toleration", provider, region)
+				}
+			}
+			for _, machineType := range policy.AllowedMachineTypes {
+				if machineType == "" {
+					return nil, fmt.Errorf("region policy of %s region %s contains empty machine type", provider, region)
+				}
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+// ValidateMachineType verifies that the machine type is allowed in the region
+func (p Policy) ValidateMachineType(machineType string) apperrors.AppError {
+	if len(p.AllowedMachineTypes) == 0 {
+		return nil
+	}
+	for _, allowed := range p.AllowedMachineTypes {
+		if allowed == machineType {
+			return nil
+		}
+	}
+	return apperrors.BadRequest("machine type %s is not allowed in the region, allowed machine types: %v", machineType, p.AllowedMachineTypes)
+}
+
+// ApplyToConfig sets the values of the Gardener config required by the policy
+func (p Policy) ApplyToConfig(config *model.GardenerConfig) {
+	if p.ForceEuAccess {
+		config.EuAccess = true
+	}
+}
+
+// ApplyToShoot adds the tolerations, seed selector, annotations and labels required by the policy to the shoot
+func (p Policy) ApplyToShoot(shoot *v1beta1.Shoot) {
+	p.ApplyToUpgradedShoot(shoot)
+
+	if len(p.SeedSelector) > 0 {
+		if shoot.Spec.SeedSelector == nil {
+			shoot.Spec.SeedSelector = &v1beta1.SeedSelector{}
+		}
+		if shoot.Spec.SeedSelector.MatchLabels == nil {
+			shoot.Spec.SeedSelector.MatchLabels = map[string]string{}
+		}
+		for key, value := range p.SeedSelector {
+			shoot.Spec.SeedSelector.MatchLabels[key] = value
+		}
+	}
+}
+
+// ApplyToUpgradedShoot adds the tolerations, annotations and labels required by the policy to the existing shoot.
+// The seed selector is not applied, as the shoot is not moved to another seed when the policy changes.
+func (p Policy) ApplyToUpgradedShoot(shoot *v1beta1.Shoot) {
+	for _, key := range p.Tolerations {
+		if !hasToleration(shoot, key) {
+			shoot.Spec.Tolerations = append(shoot.Spec.Tolerations, v1beta1.Toleration{Key: key})
+		}
+	}
+
+	if p.ForceEuAccess {
+		shoot.Annotations = setEntry(shoot.Annotations, model.EuAccessAnnotation, "true")
+	}
+	for key, value := range p.Annotations {
+		shoot.Annotations = setEntry(shoot.Annotations, key, value)
+	}
+	for key, value := range p.Labels {
+		shoot.Labels = setEntry(shoot.Labels, key, value)
+	}
+}
+
+func hasToleration(shoot *v1beta1.Shoot, key string) bool {
+	for _, toleration := range shoot.Spec.Tolerations {
+		if toleration.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+func setEntry(entries map[string]string, key, value string) map[string]string {
+	if entries == nil {
+		entries = map[string]string{}
+	}
+	entries[key] = value
+	return entries
+}
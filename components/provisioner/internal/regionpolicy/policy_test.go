@@ -0,0 +1,117 @@
+package regionpolicy
+
+import (
+	"testing"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+func TestParsePolicies(t *testing.T) {
+	t.Run("should parse policies", func(t *testing.T) {
+		// when
+		policies, err := ParsePolicies([]byte(`{"gcp": {"me-central2": {"tolerations": ["ksa-assured-workload"], "allowedMachineTypes": ["n2-standard-4"]}}}`))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, Policy{
+			Tolerations:         []string{"ksa-assured-workload"},
+			AllowedMachineTypes: []string{"n2-standard-4"},
+		}, policies.Policy("gcp", "me-central2"))
+		assert.Equal(t, Policy{}, policies.Policy("gcp", "europe-west1"))
+	})
+
+	for _, testCase := range []struct {
+		description string
+		content     string
+	}{
+		{description: "should return error for invalid JSON", content: `{"gcp": []}`},
+		{description: "should return error for empty region", content: `{"gcp": {"": {}}}`},
+		{description: "should return error for empty toleration", content: `{"gcp": {"me-central2": {"tolerations": [""]}}}`},
+		{description: "should return error for empty machine type", content: `{"gcp": {"me-central2": {"allowedMachineTypes": [""]}}}`},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			_, err := ParsePolicies([]byte(testCase.content))
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestPolicy_ValidateMachineType(t *testing.T) {
+	policy := Policy{AllowedMachineTypes: []string{"n2-standard-4", "n2-standard-8"}}
+
+	assert.NoError(t, policy.ValidateMachineType("n2-standard-8"))
+	assert.NoError(t, Policy{}.ValidateMachineType("n2-standard-16"))
+
+	err := policy.ValidateMachineType("n2-standard-16")
+	require.Error(t, err)
+	util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+}
+
+func TestPolicy_Apply(t *testing.T) {
+	policy := Policy{
+		Tolerations:   []string{"ksa-assured-workload", "existing"},
+		SeedSelector:  map[string]string{"region": "me-central2"},
+		ForceEuAccess: true,
+		Annotations:   map[string]string{"annotation": "value"},
+		Labels:        map[string]string{"label": "value"},
+	}
+
+	t.Run("should force EU access in Gardener config", func(t *testing.T) {
+		// given
+		config := model.GardenerConfig{}
+
+		// when
+		policy.ApplyToConfig(&config)
+
+		// then
+		assert.True(t, config.EuAccess)
+	})
+
+	t.Run("should add policy requirements to shoot", func(t *testing.T) {
+		// given
+		shoot := &v1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"account": "tenant"}},
+			Spec: v1beta1.ShootSpec{
+				Tolerations: []v1beta1.Toleration{{Key: "existing"}},
+			},
+		}
+
+		// when
+		policy.ApplyToShoot(shoot)
+
+		// then
+		assert.Equal(t, []v1beta1.Toleration{{Key: "existing"}, {Key: "ksa-assured-workload"}}, shoot.Spec.Tolerations)
+		require.NotNil(t, shoot.Spec.SeedSelector)
+		assert.Equal(t, map[string]string{"region": "me-central2"}, shoot.Spec.SeedSelector.MatchLabels)
+		assert.Equal(t, map[string]string{model.EuAccessAnnotation: "true", "annotation": "value"}, shoot.Annotations)
+		assert.Equal(t, map[string]string{"account": "tenant", "label": "value"}, shoot.Labels)
+	})
+
+	t.Run("should add policy requirements except seed selector to upgraded shoot", func(t *testing.T) {
+		// given
+		shoot := &v1beta1.Shoot{
+			Spec: v1beta1.ShootSpec{
+				Tolerations: []v1beta1.Toleration{{Key: "existing"}},
+			},
+		}
+
+		// when
+		policy.ApplyToUpgradedShoot(shoot)
+
+		// then
+		assert.Equal(t, []v1beta1.Toleration{{Key: "existing"}, {Key: "ksa-assured-workload"}}, shoot.Spec.Tolerations)
+		assert.Nil(t, shoot.Spec.SeedSelector)
+		assert.Equal(t, map[string]string{model.EuAccessAnnotation: "true", "annotation": "value"}, shoot.Annotations)
+		assert.Equal(t, map[string]string{"label": "value"}, shoot.Labels)
+	})
+}
@@ -0,0 +1,105 @@
+package regionpolicy
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+type Provider interface {
+	// Policy returns the policy of the provider region. Policies can change between the calls.
+	Policy(provider, region string) Policy
+}
+
+// NewProvider provides policies read from the file, or the default policies if the path is empty
+func NewProvider(path string) (Provider, error) {
+	if path == "" {
+		return NewStaticProvider(DefaultPolicies()), nil
+	}
+	return NewFileProvider(path)
+}
+
+type staticProvider struct {
+	policies Policies
+}
+
+// NewStaticProvider provides policies which do not change
+func NewStaticProvider(policies Policies) Provider {
+	return &staticProvider{policies: policies}
+}
+
+func (p *staticProvider) Policy(provider, region string) Policy {
+	return p.policies.Policy(provider, region)
+}
+
+const fileCheckInterval = 10 * time.Second
+
+type fileProvider struct {
+	path string
+	now  func() time.Time
+
+	lock        sync.Mutex
+	policies    Policies
+	modTime     time.Time
+	lastChecked time.Time
+}
+
+// NewFileProvider provides policies read from the file. The file is read again when it is modified, so that policies mounted from a ConfigMap can be changed without restart.
+func NewFileProvider(path string) (Provider, error) {
+	provider := &fileProvider{
+		path: path,
+		now:  time.Now,
+	}
+
+	if err := provider.reload(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+func (p *fileProvider) Policy(provider, region string) Policy {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.now().Sub(p.lastChecked) >= fileCheckInterval {
+		// Policies which failed to load are not used, the last valid policies are provided instead
+		if err := p.reload(); err != nil {
+			log.Errorf("Failed to reload region policies from %s: %s", p.path, err.Error())
+		}
+	}
+
+	return p.policies.Policy(provider, region)
+}
+
+func (p *fileProvider) reload() error {
+	p.lastChecked = p.now()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return errors.Wrap(err, "while checking region policy config file")
+	}
+	if p.policies != nil && info.ModTime().Equal(p.modTime) {
+		return nil
+	}
+
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return errors.Wrap(err, "while reading region policy config file")
+	}
+	policies, err := ParsePolicies(content)
+	if err != nil {
+		return err
+	}
+	if policies == nil {
+		policies = Policies{}
+	}
+
+	p.policies = policies
+	p.modTime = info.ModTime()
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package regionpolicy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Run("should provide default policies if path is empty", func(t *testing.T) {
+		// when
+		provider, err := NewProvider("")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ksa-assured-workload"}, provider.Policy("gcp", "me-central2").Tolerations)
+	})
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Run("should reload policies when file is modified", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "policies.json")
+		writePoliciesFile(t, path, `{"gcp": {"me-central2": {"tolerations": ["ksa-assured-workload"]}}}`, time.Now().Add(-time.Hour))
+
+		provider, err := NewFileProvider(path)
+		require.NoError(t, err)
+
+		now := time.Now()
+		provider.(*fileProvider).now = func() time.Time { return now }
+
+		assert.Empty(t, provider.Policy("gcp", "europe-west1").Tolerations)
+
+		// when
+		writePoliciesFile(t, path, `{"gcp": {"europe-west1": {"tolerations": ["sovereign"]}}}`, time.Now())
+		now = now.Add(fileCheckInterval)
+
+		// then
+		assert.Equal(t, []string{"sovereign"}, provider.Policy("gcp", "europe-west1").Tolerations)
+		assert.Empty(t, provider.Policy("gcp", "me-central2").Tolerations)
+	})
+
+	t.Run("should keep last valid policies when file is invalid", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "policies.json")
+		writePoliciesFile(t, path, `{"gcp": {"me-central2": {"tolerations": ["ksa-assured-workload"]}}}`, time.Now().Add(-time.Hour))
+
+		provider, err := NewFileProvider(path)
+		require.NoError(t, err)
+
+		now := time.Now()
+		provider.(*fileProvider).now = func() time.Time { return now }
+
+		// when
+		writePoliciesFile(t, path, `{"gcp": {"me-central2": {"tolerations": [""]}}}`, time.Now())
+		now = now.Add(fileCheckInterval)
+
+		// then
+		assert.Equal(t, []string{"ksa-assured-workload"}, provider.Policy("gcp", "me-central2").Tolerations)
+	})
+
+	t.Run("should return error when file does not exist", func(t *testing.T) {
+		// when
+		_, err := NewFileProvider(filepath.Join(t.TempDir(), "missing"))
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func writePoliciesFile(t *testing.T, path, content string, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
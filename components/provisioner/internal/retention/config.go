@@ -0,0 +1,35 @@
+package retention
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ArchivePolicy moves operations of deleted clusters to the operation_archive table
+	ArchivePolicy = "archive"
+	// DeletePolicy deletes deleted clusters together with their configs and operations
+	DeletePolicy = "delete"
+)
+
+type Config struct {
+	Enabled                 bool          `envconfig:"default=false"`
+	Interval                time.Duration `envconfig:"default=24h"`
+	SecretsRetentionDays    int           `envconfig:"default=30"`
+	OperationsRetentionDays int           `envconfig:"default=90"`
+	OperationsPolicy        string        `envconfig:"default=archive"`
+}
+
+func (c Config) Validate() error {
+	if c.SecretsRetentionDays <= 0 {
+		return fmt.Errorf("secrets retention must be at least 1 day, got %d", c.SecretsRetentionDays)
+	}
+	if c.OperationsRetentionDays <= 0 {
+		return fmt.Errorf("operations retention must be at least 1 day, got %d", c.OperationsRetentionDays)
+	}
+	if c.OperationsPolicy != ArchivePolicy && c.OperationsPolicy != DeletePolicy {
+		return fmt.Errorf("unknown operations retention policy %s, expected %s or %s", c.OperationsPolicy, ArchivePolicy, DeletePolicy)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Job purges the data periodically. The job runs on every replica, as there is no leader election, and only the one which acquires the lock purges the data.
+type Job struct {
+	purger   Purger
+	interval time.Duration
+	log      log.FieldLogger
+}
+
+func NewJob(purger Purger, interval time.Duration) *Job {
+	return &Job{
+		purger:   purger,
+		interval: interval,
+		log:      log.WithField("job", "retention"),
+	}
+}
+
+// Run purges the data immediately and then with the interval, until the context is done
+func (j *Job) Run(ctx context.Context) {
+	wait.Until(j.purge, j.interval, ctx.Done())
+}
+
+func (j *Job) purge() {
+	result, err := j.purger.Purge()
+	if errors.Is(err, ErrPurgeInProgress) {
+		j.log.Info("Skipping purge, as it is in progress on another replica")
+		return
+	}
+	if err != nil {
+		j.log.Errorf("Failed to purge deleted clusters: %s", err.Error())
+		return
+	}
+
+	j.log.Infof("Purged %d kubeconfigs, %d administrators, archived %d operations, deleted %d operations and %d clusters",
+		result.Kubeconfigs, result.Administrators, result.ArchivedOperations, result.DeletedOperations, result.DeletedClusters)
+}
@@ -0,0 +1,56 @@
+package retention_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/retention"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/retention/mocks"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestJob_Run(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		err         error
+	}{
+		{description: "should purge periodically"},
+		{description: "should continue when purge is in progress on another replica", err: retention.ErrPurgeInProgress},
+		{description: "should continue when purge fails", err: errors.New("some error")},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			calls := make(chan struct{}, 3)
+
+			purger := &mocks.Purger{}
+			purger.On("Purge").Return(retention.Result{Kubeconfigs: 1}, testCase.err).Run(func(_ mock.Arguments) {
+				select {
+				case calls <- struct{}{}:
+				default:
+				}
+			})
+
+			job := retention.NewJob(purger, 10*time.Millisecond)
+
+			// when
+			go job.Run(ctx)
+
+			// then
+			for i := 0; i < 3; i++ {
+				select {
+				case <-calls:
+				case <-time.After(time.Second):
+					t.Fatalf("purge was called %d times, expected at least 3", i)
+				}
+			}
+			cancel()
+			assert.GreaterOrEqual(t, len(purger.Calls), 3)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	retention "github.com/kyma-project/control-plane/components/provisioner/internal/retention"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Purger is an autogenerated mock type for the Purger type
+type Purger struct {
+	mock.Mock
+}
+
+// Purge provides a mock function with given fields:
+func (_m *Purger) Purge() (retention.Result, error) {
+	ret := _m.Called()
+
+	var r0 retention.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (retention.Result, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() retention.Result); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(retention.Result)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPurger creates a new instance of Purger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Purger {
+	mock := &Purger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,245 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// advisoryLockID identifies the Postgres advisory lock held by the replica which purges the data
+const advisoryLockID = 4_817_263_001
+
+const (
+	KindKubeconfig        = "kubeconfig"
+	KindAdministrator     = "administrator"
+	KindArchivedOperation = "archived_operation"
+	KindDeletedOperation  = "deleted_operation"
+	KindDeletedCluster    = "deleted_cluster"
+)
+
+// ErrPurgeInProgress is returned when other replica or CLI is purging the data
+var ErrPurgeInProgress = errors.New("purge is already in progress")
+
+type Result struct {
+	Kubeconfigs        int
+	Administrators     int
+	ArchivedOperations int
+	DeletedOperations  int
+	DeletedClusters    int
+}
+
+//go:generate mockery --name=Purger
+type Purger interface {
+	Purge() (Result, error)
+}
+
+type Recorder interface {
+	Add(kind string, rows int)
+}
+
+type purger struct {
+	connection *dbr.Connection
+	config     Config
+	recorder   Recorder
+	now        func() time.Time
+}
+
+// NewPurger creates the purger, which removes secrets of deleted clusters and their operations after the retention period
+func NewPurger(connection *dbr.Connection, config Config, recorder Recorder) Purger {
+	return &purger{
+		connection: connection,
+		config:     config,
+		recorder:   recorder,
+		now:        time.Now,
+	}
+}
+
+func (p *purger) Purge() (Result, error) {
+	unlock, err := p.lock()
+	if err != nil {
+		return Result{}, err
+	}
+	defer unlock()
+
+	now := p.now()
+	session := p.connection.NewSession(nil)
+
+	var result Result
+
+	result.Kubeconfigs, result.Administrators, err = p.purgeSecrets(session, now.AddDate(0, 0, -p.config.SecretsRetentionDays))
+	p.record(result)
+	if err != nil {
+		return result, errors.Wrap(err, "while purging secrets of deleted clusters")
+	}
+
+	operationsCutoff := now.AddDate(0, 0, -p.config.OperationsRetentionDays)
+	if p.config.OperationsPolicy == DeletePolicy {
+		result.DeletedOperations, result.DeletedClusters, err = p.deleteClusters(session, operationsCutoff)
+	} else {
+		result.ArchivedOperations, err = p.archiveOperations(session, operationsCutoff, now)
+	}
+	p.record(Result{
+		ArchivedOperations: result.ArchivedOperations,
+		DeletedOperations:  result.DeletedOperations,
+		DeletedClusters:    result.DeletedClusters,
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "while purging operations of deleted clusters")
+	}
+
+	return result, nil
+}
+
+// lock acquires the advisory lock on the dedicated connection, as the lock is released when the connection is closed
+func (p *purger) lock() (func(), error) {
+	ctx := context.Background()
+
+	conn, err := p.connection.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting database connection")
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", advisoryLockID).Scan(&acquired)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrap(err, "while acquiring purge lock")
+	}
+	if !acquired {
+		conn.Close()
+		return nil, ErrPurgeInProgress
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", advisoryLockID); err != nil {
+			log.Warnf("Failed to release purge lock: %s", err.Error())
+		}
+		conn.Close()
+	}, nil
+}
+
+func (p *purger) purgeSecrets(session *dbr.Session, cutoff time.Time) (int, int, error) {
+	res, err := session.
+		Update("cluster").
+		Set("kubeconfig", nil).
+		Set("is_kubeconfig_encrypted", false).
+		Where(dbr.And(deletedBefore(cutoff), dbr.Neq("kubeconfig", nil))).
+		Exec()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while removing kubeconfigs")
+	}
+	kubeconfigs, err := res.RowsAffected()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while counting removed kubeconfigs")
+	}
+
+	res, err = session.
+		DeleteFrom("cluster_administrator").
+		Where(clusterDeletedBefore(cutoff)).
+		Exec()
+	if err != nil {
+		return int(kubeconfigs), 0, errors.Wrap(err, "while removing administrators")
+	}
+	administrators, err := res.RowsAffected()
+	if err != nil {
+		return int(kubeconfigs), 0, errors.Wrap(err, "while counting removed administrators")
+	}
+
+	return int(kubeconfigs), int(administrators), nil
+}
+
+func (p *purger) archiveOperations(session *dbr.Session, cutoff, now time.Time) (int, error) {
+	tx, err := session.Begin()
+	if err != nil {
+		return 0, errors.Wrap(err, "while starting transaction")
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	_, err = tx.InsertBySql(fmt.Sprintf(
+		"INSERT INTO operation_archive (%[1]s, archived_at) SELECT %[1]s, ? FROM operation WHERE cluster_id IN (%[2]s)",
+		operationColumns, deletedClusterIDs), now, cutoff).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "while archiving operations")
+	}
+
+	res, err := tx.DeleteFrom("operation").Where(clusterDeletedBefore(cutoff)).Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "while deleting archived operations")
+	}
+	archived, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "while counting archived operations")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "while committing transaction")
+	}
+
+	return int(archived), nil
+}
+
+func (p *purger) deleteClusters(session *dbr.Session, cutoff time.Time) (int, int, error) {
+	tx, err := session.Begin()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while starting transaction")
+	}
+	defer tx.RollbackUnlessCommitted()
+
+	// Operations are deleted by cascade, so they are counted before
+	var operations int
+	err = tx.Select("count(*)").From("operation").Where(clusterDeletedBefore(cutoff)).LoadOne(&operations)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while counting operations")
+	}
+
+	// Administrators are not deleted by cascade
+	_, err = tx.DeleteFrom("cluster_administrator").Where(clusterDeletedBefore(cutoff)).Exec()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while deleting administrators")
+	}
+
+	res, err := tx.DeleteFrom("cluster").Where(deletedBefore(cutoff)).Exec()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while deleting clusters")
+	}
+	clusters, err := res.RowsAffected()
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "while counting deleted clusters")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, errors.Wrap(err, "while committing transaction")
+	}
+
+	return operations, int(clusters), nil
+}
+
+func (p *purger) record(result Result) {
+	if p.recorder == nil {
+		return
+	}
+
+	p.recorder.Add(KindKubeconfig, result.Kubeconfigs)
+	p.recorder.Add(KindAdministrator, result.Administrators)
+	p.recorder.Add(KindArchivedOperation, result.ArchivedOperations)
+	p.recorder.Add(KindDeletedOperation, result.DeletedOperations)
+	p.recorder.Add(KindDeletedCluster, result.DeletedClusters)
+}
+
+const (
+	operationColumns  = "id, type, state, message, start_timestamp, end_timestamp, cluster_id, stage, last_transition, err_message, reason, component, seed_placement"
+	deletedClusterIDs = "SELECT id FROM cluster WHERE deleted = true AND deleted_at < ?"
+)
+
+func deletedBefore(cutoff time.Time) dbr.Builder {
+	return dbr.And(dbr.Eq("deleted", true), dbr.Lt("deleted_at", cutoff))
+}
+
+func clusterDeletedBefore(cutoff time.Time) dbr.Builder {
+	return dbr.Expr("cluster_id IN ("+deletedClusterIDs+")", cutoff)
+}
@@ -0,0 +1,231 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbr "github.com/gocraft/dbr/v2"
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	schemaFilePath = "../../assets/database/provisioner.sql"
+	secretKey      = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+)
+
+func TestPurger_Purge(t *testing.T) {
+	ctx := context.Background()
+
+	containerCleanupFunc, connString, err := testutils.InitTestDBContainer(t, ctx)
+	require.NoError(t, err)
+	defer containerCleanupFunc()
+
+	connection, err := database.InitializeDatabaseConnection(connString, 5)
+	require.NoError(t, err)
+	require.NotNil(t, connection)
+	defer testutils.CloseDatabase(t, connection)
+
+	err = database.SetupSchema(connection, schemaFilePath)
+	require.NoError(t, err)
+
+	keyProvider, err := dbsession.NewStaticKeyProvider(secretKey, nil)
+	require.NoError(t, err)
+
+	factory, err := dbsession.NewFactory(connection, keyProvider)
+	require.NoError(t, err)
+
+	config := Config{
+		SecretsRetentionDays:    30,
+		OperationsRetentionDays: 90,
+	}
+
+	t.Run("should remove secrets and archive operations of clusters deleted before the retention period", func(t *testing.T) {
+		// given
+		config.OperationsPolicy = ArchivePolicy
+
+		expired := insertDeletedCluster(t, factory, connection, 100)
+		secretsExpired := insertDeletedCluster(t, factory, connection, 40)
+		recent := insertDeletedCluster(t, factory, connection, 1)
+		running := insertCluster(t, factory)
+
+		recorder := recorderStub{}
+
+		// when
+		result, err := NewPurger(connection, config, recorder).Purge()
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.Kubeconfigs, 2)
+		assert.GreaterOrEqual(t, result.Administrators, 2)
+		assert.GreaterOrEqual(t, result.ArchivedOperations, 1)
+		assert.Zero(t, result.DeletedClusters)
+		assert.Equal(t, result.Kubeconfigs, recorder[KindKubeconfig])
+		assert.Equal(t, result.ArchivedOperations, recorder[KindArchivedOperation])
+
+		assertSecrets(t, factory, connection, expired, false)
+		assertSecrets(t, factory, connection, secretsExpired, false)
+		assertSecrets(t, factory, connection, recent, true)
+		assertSecrets(t, factory, connection, running, true)
+
+		assert.Zero(t, countRows(t, connection, "operation", expired))
+		assert.Equal(t, 1, countRows(t, connection, "operation_archive", expired))
+		assert.Equal(t, 1, countRows(t, connection, "operation", secretsExpired))
+		assert.Equal(t, 1, countRows(t, connection, "operation", running))
+		assert.Equal(t, 1, countRows(t, connection, "cluster", expired))
+	})
+
+	t.Run("should delete clusters deleted before the retention period", func(t *testing.T) {
+		// given
+		config.OperationsPolicy = DeletePolicy
+
+		expired := insertDeletedCluster(t, factory, connection, 100)
+		recent := insertDeletedCluster(t, factory, connection, 40)
+
+		// when
+		result, err := NewPurger(connection, config, nil).Purge()
+
+		// then
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, result.DeletedClusters, 1)
+		assert.GreaterOrEqual(t, result.DeletedOperations, 1)
+
+		assert.Zero(t, countRows(t, connection, "cluster", expired))
+		assert.Zero(t, countRows(t, connection, "gardener_config", expired))
+		assert.Zero(t, countRows(t, connection, "operation", expired))
+		assert.Equal(t, 1, countRows(t, connection, "cluster", recent))
+		assert.Equal(t, 1, countRows(t, connection, "operation", recent))
+	})
+
+	t.Run("should not purge when purge is in progress", func(t *testing.T) {
+		// given
+		config.OperationsPolicy = ArchivePolicy
+
+		conn, err := connection.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockID)
+		require.NoError(t, err)
+		defer conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", advisoryLockID)
+
+		expired := insertDeletedCluster(t, factory, connection, 100)
+
+		// when
+		_, err = NewPurger(connection, config, nil).Purge()
+
+		// then
+		require.ErrorIs(t, err, ErrPurgeInProgress)
+		assert.Equal(t, 1, countRows(t, connection, "operation", expired))
+	})
+}
+
+type recorderStub map[string]int
+
+func (r recorderStub) Add(kind string, rows int) {
+	r[kind] += rows
+}
+
+func insertCluster(t *testing.T, factory dbsession.Factory) string {
+	id := uuid.New().String()
+
+	providerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-b"}})
+	require.NoError(t, err)
+
+	session := factory.NewReadWriteSession()
+
+	dberr := session.InsertCluster(model.Cluster{
+		ID:                id,
+		Tenant:            "tenant",
+		SubAccountId:      util.PtrTo("sub-account"),
+		CreationTimestamp: time.Now(),
+		Kubeconfig:        util.PtrTo("kubeconfig"),
+	})
+	require.NoError(t, dberr)
+
+	dberr = session.InsertGardenerConfig(model.GardenerConfig{
+		ID:                     uuid.New().String(),
+		ClusterID:              id,
+		Name:                   "c-" + id[:7],
+		ProjectName:            "project",
+		KubernetesVersion:      "1.30",
+		MachineType:            "n2-standard-4",
+		Region:                 "europe-west1",
+		Provider:               "gcp",
+		TargetSecret:           "secret",
+		WorkerCidr:             "10.250.0.0/16",
+		AutoScalerMin:          3,
+		AutoScalerMax:          10,
+		MaxSurge:               1,
+		GardenerProviderConfig: providerConfig,
+	})
+	require.NoError(t, dberr)
+
+	dberr = session.InsertAdministrators(id, []string{"admin@example.com"})
+	require.NoError(t, dberr)
+
+	dberr = session.InsertOperation(model.Operation{
+		ID:             uuid.New().String(),
+		Type:           model.Provision,
+		StartTimestamp: time.Now(),
+		State:          model.Succeeded,
+		Message:        "succeeded",
+		ClusterID:      id,
+		Stage:          model.FinishedStage,
+	})
+	require.NoError(t, dberr)
+
+	return id
+}
+
+func insertDeletedCluster(t *testing.T, factory dbsession.Factory, connection *dbr.Connection, deletedDaysAgo int) string {
+	id := insertCluster(t, factory)
+
+	dberr := factory.NewReadWriteSession().MarkClusterAsDeleted(id)
+	require.NoError(t, dberr)
+
+	_, err := connection.NewSession(nil).
+		Update("cluster").
+		Set("deleted_at", time.Now().AddDate(0, 0, -deletedDaysAgo)).
+		Where(dbr.Eq("id", id)).
+		Exec()
+	require.NoError(t, err)
+
+	return id
+}
+
+func assertSecrets(t *testing.T, factory dbsession.Factory, connection *dbr.Connection, runtimeID string, present bool) {
+	cluster, dberr := factory.NewReadSession().GetCluster(runtimeID)
+	require.NoError(t, dberr)
+
+	administrators := countRows(t, connection, "cluster_administrator", runtimeID)
+
+	if present {
+		assert.NotNil(t, cluster.Kubeconfig)
+		assert.Equal(t, 1, administrators)
+	} else {
+		assert.Nil(t, cluster.Kubeconfig)
+		assert.Zero(t, administrators)
+	}
+}
+
+func countRows(t *testing.T, connection *dbr.Connection, table, runtimeID string) int {
+	column := "cluster_id"
+	if table == "cluster" {
+		column = "id"
+	}
+
+	var count int
+	err := connection.NewSession(nil).Select("count(*)").From(table).Where(dbr.Eq(column, runtimeID)).LoadOne(&count)
+	require.NoError(t, err)
+
+	return count
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.36.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	mock "github.com/stretchr/testify/mock"
+
+	seedplacement "github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
+)
+
+// Placer is an autogenerated mock type for the Placer type
+type Placer struct {
+	mock.Mock
+}
+
+// Place provides a mock function with given fields: requirements
+func (_m *Placer) Place(requirements seedplacement.Requirements) (seedplacement.Decision, apperrors.AppError) {
+	ret := _m.Called(requirements)
+
+	var r0 seedplacement.Decision
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(seedplacement.Requirements) (seedplacement.Decision, apperrors.AppError)); ok {
+		return rf(requirements)
+	}
+	if rf, ok := ret.Get(0).(func(seedplacement.Requirements) seedplacement.Decision); ok {
+		r0 = rf(requirements)
+	} else {
+		r0 = ret.Get(0).(seedplacement.Decision)
+	}
+
+	if rf, ok := ret.Get(1).(func(seedplacement.Requirements) apperrors.AppError); ok {
+		r1 = rf(requirements)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewPlacer creates a new instance of Placer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlacer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Placer {
+	mock := &Placer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,184 @@
+package seedplacement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned"
+	"github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardener_listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// EuAccessLabel marks the seeds which can host the shoots with EU access
+const EuAccessLabel = "seed.gardener.cloud/eu-access"
+
+// requiredConditions must be true for the seed to accept new shoots
+var requiredConditions = []gardener_types.ConditionType{
+	gardener_types.SeedGardenletReady,
+	gardener_types.SeedSystemComponentsHealthy,
+}
+
+//go:generate mockery --name=Placer
+type Placer interface {
+	// Place chooses the seed which meets the requirements. The empty seed name in the decision leaves the choice to Gardener,
+	// which happens only if no seed is available and the shoot requires neither seed labels nor EU access.
+	Place(requirements Requirements) (Decision, apperrors.AppError)
+}
+
+// Requirements describe the seeds on which the shoot can be placed
+type Requirements struct {
+	Provider string
+	Region   string
+	// Labels contains labels which the seed must have
+	Labels map[string]string
+	// EuAccess requires the seed to have the EuAccessLabel set to true
+	EuAccess bool
+}
+
+// Decision contains the chosen seed and the reasons why it was chosen and other seeds were not
+type Decision struct {
+	SeedName string
+	Reasons  []string
+}
+
+func (d Decision) String() string {
+	return strings.Join(d.Reasons, "; ")
+}
+
+type placer struct {
+	seeds  gardener_listers.SeedLister
+	shoots gardener_listers.ShootLister
+}
+
+// NewInformerPlacer chooses the least loaded seed, the load is the number of the shoots of all Gardener projects placed on the seed.
+// Seeds and shoots are read from the cache of the informers, which are started and synchronized before the placer is returned, and stopped when the context is done.
+func NewInformerPlacer(ctx context.Context, client versioned.Interface, resync time.Duration) (Placer, error) {
+	factory := externalversions.NewSharedInformerFactory(client, resync)
+	seeds := factory.Core().V1beta1().Seeds().Lister()
+	shoots := factory.Core().V1beta1().Shoots().Lister()
+
+	factory.Start(ctx.Done())
+	for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return nil, errors.Errorf("failed to synchronize cache of %s informer", informerType)
+		}
+	}
+
+	return &placer{
+		seeds:  seeds,
+		shoots: shoots,
+	}, nil
+}
+
+func (p *placer) Place(requirements Requirements) (Decision, apperrors.AppError) {
+	seeds, err := p.seeds.List(labels.Everything())
+	if err != nil {
+		return Decision{}, apperrors.Internal("failed to list seeds: %s", err.Error())
+	}
+
+	load, appErr := p.load()
+	if appErr != nil {
+		return Decision{}, appErr
+	}
+
+	var decision Decision
+	var candidates []string
+	for _, seed := range seeds {
+		if !strings.EqualFold(seed.Spec.Provider.Type, requirements.Provider) {
+			continue
+		}
+		if reason := exclusionReason(seed, requirements, load[seed.Name]); reason != "" {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("seed %s excluded: %s", seed.Name, reason))
+			continue
+		}
+		candidates = append(candidates, seed.Name)
+	}
+
+	if len(candidates) == 0 {
+		// Gardener does not check EU access of the seed, so the shoot is not left to it when the requirements cannot be met
+		if requirements.EuAccess || len(requirements.Labels) > 0 {
+			decision.Reasons = append(decision.Reasons, fmt.Sprintf("no seed of %s provider in %s region meets the required labels and EU access", requirements.Provider, requirements.Region))
+			return Decision{}, apperrors.BadRequest("failed to place shoot: %s", decision)
+		}
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("no seed of %s provider in %s region available, seed chosen by Gardener", requirements.Provider, requirements.Region))
+		return decision, nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if load[candidates[i]] != load[candidates[j]] {
+			return load[candidates[i]] < load[candidates[j]]
+		}
+		return candidates[i] < candidates[j]
+	})
+
+	decision.SeedName = candidates[0]
+	decision.Reasons = append(decision.Reasons, fmt.Sprintf("seed %s chosen with %d shoots among %d candidates", decision.SeedName, load[decision.SeedName], len(candidates)))
+
+	return decision, nil
+}
+
+func exclusionReason(seed *gardener_types.Seed, requirements Requirements, shoots int) string {
+	if seed.Spec.Provider.Region != requirements.Region {
+		return fmt.Sprintf("region %s", seed.Spec.Provider.Region)
+	}
+	if seed.DeletionTimestamp != nil {
+		return "being deleted"
+	}
+	if seed.Spec.Settings != nil && seed.Spec.Settings.Scheduling != nil && !seed.Spec.Settings.Scheduling.Visible {
+		return "not visible for scheduling"
+	}
+	for key, value := range requirements.Labels {
+		if actual, found := seed.Labels[key]; !found || actual != value {
+			return fmt.Sprintf("label %s=%s missing", key, value)
+		}
+	}
+	if requirements.EuAccess && seed.Labels[EuAccessLabel] != "true" {
+		return fmt.Sprintf("label %s=true missing", EuAccessLabel)
+	}
+	for _, conditionType := range requiredConditions {
+		if !conditionTrue(seed.Status.Conditions, conditionType) {
+			return fmt.Sprintf("condition %s not true", conditionType)
+		}
+	}
+	if allocatable, found := seed.Status.Allocatable[gardener_types.ResourceShoots]; found && int64(shoots) >= allocatable.Value() {
+		return fmt.Sprintf("no capacity left, %d of %d shoots placed", shoots, allocatable.Value())
+	}
+	return ""
+}
+
+func conditionTrue(conditions []gardener_types.Condition, conditionType gardener_types.ConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == gardener_types.ConditionTrue
+		}
+	}
+	return false
+}
+
+// load returns the number of shoots placed on every seed. The shoots not yet scheduled by Gardener are counted for the seed from their spec.
+func (p *placer) load() (map[string]int, apperrors.AppError) {
+	shoots, err := p.shoots.List(labels.Everything())
+	if err != nil {
+		return nil, apperrors.Internal("failed to list shoots: %s", err.Error())
+	}
+
+	load := map[string]int{}
+	for _, shoot := range shoots {
+		seedName := shoot.Spec.SeedName
+		if seedName == nil {
+			seedName = shoot.Status.SeedName
+		}
+		if seedName != nil {
+			load[*seedName]++
+		}
+	}
+
+	return load, nil
+}
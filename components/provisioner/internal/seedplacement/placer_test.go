@@ -0,0 +1,252 @@
+package seedplacement_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/seedplacement"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const namespace = "garden-project"
+
+func TestPlacer_Place(t *testing.T) {
+	requirements := seedplacement.Requirements{
+		Provider: "aws",
+		Region:   "eu-central-1",
+		Labels:   map[string]string{"environment": "production"},
+	}
+
+	t.Run("should choose the least loaded seed which meets the requirements", func(t *testing.T) {
+		// given
+		placer := newPlacer(t,
+			newSeed("busy", "aws", "eu-central-1", true),
+			newSeed("idle", "aws", "eu-central-1", true),
+			newSeed("other-region", "aws", "us-east-1", true),
+			newSeed("other-provider", "gcp", "eu-central-1", true),
+			newShoot("shoot-1", util.PtrTo("busy"), nil),
+			newShoot("shoot-2", nil, util.PtrTo("busy")),
+			newShoot("shoot-3", util.PtrTo("idle"), nil),
+			newShoot("shoot-4", nil, nil),
+		)
+
+		// when
+		decision, err := placer.Place(requirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "idle", decision.SeedName)
+		assert.Equal(t, []string{
+			"seed other-region excluded: region us-east-1",
+			"seed idle chosen with 1 shoots among 2 candidates",
+		}, decision.Reasons)
+		assert.Equal(t, "seed other-region excluded: region us-east-1; seed idle chosen with 1 shoots among 2 candidates", decision.String())
+	})
+
+	t.Run("should choose the seed by name when the load is equal", func(t *testing.T) {
+		// given
+		placer := newPlacer(t,
+			newSeed("seed-b", "aws", "eu-central-1", true),
+			newSeed("seed-a", "aws", "eu-central-1", true),
+		)
+
+		// when
+		decision, err := placer.Place(requirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "seed-a", decision.SeedName)
+	})
+
+	t.Run("should exclude seeds which cannot accept shoots", func(t *testing.T) {
+		// given
+		notReady := newSeed("not-ready", "aws", "eu-central-1", false)
+
+		unlabeled := newSeed("unlabeled", "aws", "eu-central-1", true)
+		unlabeled.Labels = nil
+
+		invisible := newSeed("invisible", "aws", "eu-central-1", true)
+		invisible.Spec.Settings = &gardener_types.SeedSettings{Scheduling: &gardener_types.SeedSettingScheduling{Visible: false}}
+
+		placer := newPlacer(t, notReady, unlabeled, invisible, newSeed("ready", "AWS", "eu-central-1", true))
+
+		// when
+		decision, err := placer.Place(requirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "ready", decision.SeedName)
+		assert.ElementsMatch(t, []string{
+			"seed not-ready excluded: condition GardenletReady not true",
+			"seed unlabeled excluded: label environment=production missing",
+			"seed invisible excluded: not visible for scheduling",
+			"seed ready chosen with 0 shoots among 1 candidates",
+		}, decision.Reasons)
+	})
+
+	t.Run("should count shoots of all Gardener projects", func(t *testing.T) {
+		// given
+		placer := newPlacer(t,
+			newSeed("seed-a", "aws", "eu-central-1", true),
+			newSeed("seed-b", "aws", "eu-central-1", true),
+			newShoot("shoot-1", util.PtrTo("seed-b"), nil),
+			newShootInNamespace("shoot-2", "garden-other", util.PtrTo("seed-a"), nil),
+			newShootInNamespace("shoot-3", "garden-other", util.PtrTo("seed-a"), nil),
+		)
+
+		// when
+		decision, err := placer.Place(requirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "seed-b", decision.SeedName)
+	})
+
+	t.Run("should exclude seeds without capacity left", func(t *testing.T) {
+		// given
+		full := newSeed("full", "aws", "eu-central-1", true)
+		full.Status.Allocatable = corev1.ResourceList{gardener_types.ResourceShoots: resource.MustParse("1")}
+
+		placer := newPlacer(t,
+			full,
+			newSeed("busy", "aws", "eu-central-1", true),
+			newShoot("shoot-1", util.PtrTo("full"), nil),
+			newShoot("shoot-2", util.PtrTo("busy"), nil),
+			newShoot("shoot-3", util.PtrTo("busy"), nil),
+		)
+
+		// when
+		decision, err := placer.Place(requirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "busy", decision.SeedName)
+		assert.Equal(t, []string{
+			"seed full excluded: no capacity left, 1 of 1 shoots placed",
+			"seed busy chosen with 2 shoots among 1 candidates",
+		}, decision.Reasons)
+	})
+
+	t.Run("should require seed with EU access", func(t *testing.T) {
+		// given
+		euAccess := newSeed("eu-access", "aws", "eu-central-1", true)
+		euAccess.Labels[seedplacement.EuAccessLabel] = "true"
+
+		placer := newPlacer(t,
+			newSeed("regular", "aws", "eu-central-1", true),
+			euAccess,
+			newShoot("shoot-1", util.PtrTo("eu-access"), nil),
+		)
+
+		euAccessRequirements := requirements
+		euAccessRequirements.EuAccess = true
+
+		// when
+		decision, err := placer.Place(euAccessRequirements)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "eu-access", decision.SeedName)
+		assert.Equal(t, []string{
+			"seed regular excluded: label seed.gardener.cloud/eu-access=true missing",
+			"seed eu-access chosen with 1 shoots among 1 candidates",
+		}, decision.Reasons)
+	})
+
+	t.Run("should leave the choice to Gardener when no seed is available and shoot has no seed requirements", func(t *testing.T) {
+		// given
+		placer := newPlacer(t, newSeed("not-ready", "aws", "eu-central-1", false))
+
+		// when
+		decision, err := placer.Place(seedplacement.Requirements{Provider: "aws", Region: "eu-central-1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, decision.SeedName)
+		assert.Equal(t, []string{
+			"seed not-ready excluded: condition GardenletReady not true",
+			"no seed of aws provider in eu-central-1 region available, seed chosen by Gardener",
+		}, decision.Reasons)
+	})
+
+	t.Run("should return error when no seed has required labels", func(t *testing.T) {
+		// given
+		placer := newPlacer(t, newSeed("not-ready", "aws", "eu-central-1", false))
+
+		// when
+		_, err := placer.Place(requirements)
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Contains(t, err.Error(), "seed not-ready excluded: condition GardenletReady not true")
+	})
+
+	t.Run("should return error when no seed allows EU access", func(t *testing.T) {
+		// given
+		placer := newPlacer(t, newSeed("regular", "aws", "eu-central-1", true))
+
+		// when
+		_, err := placer.Place(seedplacement.Requirements{Provider: "aws", Region: "eu-central-1", EuAccess: true})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		assert.Contains(t, err.Error(), "seed regular excluded: label seed.gardener.cloud/eu-access=true missing")
+	})
+}
+
+func newPlacer(t *testing.T, objects ...runtime.Object) seedplacement.Placer {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	placer, err := seedplacement.NewInformerPlacer(ctx, fake.NewSimpleClientset(objects...), time.Minute)
+	require.NoError(t, err)
+
+	return placer
+}
+
+func newSeed(name, provider, region string, ready bool) *gardener_types.Seed {
+	status := gardener_types.ConditionFalse
+	if ready {
+		status = gardener_types.ConditionTrue
+	}
+
+	return &gardener_types.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"environment": "production"},
+		},
+		Spec: gardener_types.SeedSpec{
+			Provider: gardener_types.SeedProvider{Type: provider, Region: region},
+		},
+		Status: gardener_types.SeedStatus{
+			Conditions: []gardener_types.Condition{
+				{Type: gardener_types.SeedGardenletReady, Status: status},
+				{Type: gardener_types.SeedSystemComponentsHealthy, Status: gardener_types.ConditionTrue},
+			},
+		},
+	}
+}
+
+func newShoot(name string, specSeed, statusSeed *string) *gardener_types.Shoot {
+	return newShootInNamespace(name, namespace, specSeed, statusSeed)
+}
+
+func newShootInNamespace(name, shootNamespace string, specSeed, statusSeed *string) *gardener_types.Shoot {
+	return &gardener_types.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: shootNamespace},
+		Spec:       gardener_types.ShootSpec{SeedName: specSeed},
+		Status:     gardener_types.ShootStatus{SeedName: statusSeed},
+	}
+}
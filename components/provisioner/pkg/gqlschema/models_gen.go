@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type ProviderSpecificConfig interface {
@@ -21,9 +22,10 @@ type AWSProviderConfig struct {
 func (AWSProviderConfig) IsProviderSpecificConfig() {}
 
 type AWSProviderConfigInput struct {
-	VpcCidr      string          `json:"vpcCidr"`
-	AwsZones     []*AWSZoneInput `json:"awsZones"`
-	EnableIMDSv2 *bool           `json:"enableIMDSv2,omitempty"`
+	VpcCidr           string                  `json:"vpcCidr"`
+	AwsZones          []*AWSZoneInput         `json:"awsZones,omitempty"`
+	AwsZoneAllocation *AWSZoneAllocationInput `json:"awsZoneAllocation,omitempty"`
+	EnableIMDSv2      *bool                   `json:"enableIMDSv2,omitempty"`
 }
 
 type AWSZone struct {
@@ -33,6 +35,13 @@ type AWSZone struct {
 	WorkerCidr   *string `json:"workerCidr,omitempty"`
 }
 
+type AWSZoneAllocationInput struct {
+	Names                []string `json:"names"`
+	WorkerPrefixLength   *int     `json:"workerPrefixLength,omitempty"`
+	PublicPrefixLength   *int     `json:"publicPrefixLength,omitempty"`
+	InternalPrefixLength *int     `json:"internalPrefixLength,omitempty"`
+}
+
 type AWSZoneInput struct {
 	Name         string `json:"name"`
 	PublicCidr   string `json:"publicCidr"`
@@ -40,6 +49,21 @@ type AWSZoneInput struct {
 	WorkerCidr   string `json:"workerCidr"`
 }
 
+type AuditEvent struct {
+	ID           string            `json:"id"`
+	Timestamp    time.Time         `json:"timestamp"`
+	Caller       string            `json:"caller"`
+	UserAgent    *string           `json:"userAgent,omitempty"`
+	Tenant       string            `json:"tenant"`
+	SubAccountID *string           `json:"subAccountID,omitempty"`
+	Mutation     string            `json:"mutation"`
+	RuntimeID    *string           `json:"runtimeID,omitempty"`
+	Input        *string           `json:"input,omitempty"`
+	OperationID  *string           `json:"operationID,omitempty"`
+	Outcome      AuditEventOutcome `json:"outcome"`
+	ErrMessage   *string           `json:"errMessage,omitempty"`
+}
+
 type AzureProviderConfig struct {
 	VnetCidr                     *string      `json:"vnetCidr,omitempty"`
 	Zones                        []string     `json:"zones,omitempty"`
@@ -51,11 +75,12 @@ type AzureProviderConfig struct {
 func (AzureProviderConfig) IsProviderSpecificConfig() {}
 
 type AzureProviderConfigInput struct {
-	VnetCidr                     string            `json:"vnetCidr"`
-	Zones                        []string          `json:"zones,omitempty"`
-	AzureZones                   []*AzureZoneInput `json:"azureZones,omitempty"`
-	EnableNatGateway             *bool             `json:"enableNatGateway,omitempty"`
-	IdleConnectionTimeoutMinutes *int              `json:"idleConnectionTimeoutMinutes,omitempty"`
+	VnetCidr                     string                    `json:"vnetCidr"`
+	Zones                        []string                  `json:"zones,omitempty"`
+	AzureZones                   []*AzureZoneInput         `json:"azureZones,omitempty"`
+	AzureZoneAllocation          *AzureZoneAllocationInput `json:"azureZoneAllocation,omitempty"`
+	EnableNatGateway             *bool                     `json:"enableNatGateway,omitempty"`
+	IdleConnectionTimeoutMinutes *int                      `json:"idleConnectionTimeoutMinutes,omitempty"`
 }
 
 type AzureZone struct {
@@ -63,6 +88,11 @@ type AzureZone struct {
 	Cidr string `json:"cidr"`
 }
 
+type AzureZoneAllocationInput struct {
+	Names        []int `json:"names"`
+	PrefixLength *int  `json:"prefixLength,omitempty"`
+}
+
 type AzureZoneInput struct {
 	Name int    `json:"name"`
 	Cidr string `json:"cidr"`
@@ -88,6 +118,12 @@ type ComponentConfigurationInput struct {
 	ConflictStrategy *ConflictStrategy   `json:"conflictStrategy,omitempty"`
 }
 
+type ConfigChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
 type ConfigEntry struct {
 	Key    string `json:"key"`
 	Value  string `json:"value"`
@@ -128,6 +164,28 @@ type Error struct {
 	Message *string `json:"message,omitempty"`
 }
 
+type Extension struct {
+	Type           string  `json:"type"`
+	Disabled       *bool   `json:"disabled,omitempty"`
+	ProviderConfig *string `json:"providerConfig,omitempty"`
+}
+
+type ExtensionInput struct {
+	Type           string  `json:"type"`
+	Disabled       *bool   `json:"disabled,omitempty"`
+	ProviderConfig *string `json:"providerConfig,omitempty"`
+}
+
+type FeatureGate struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+type FeatureGateInput struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
 type GCPProviderConfig struct {
 	Zones []string `json:"zones"`
 }
@@ -153,6 +211,7 @@ type GardenerConfig struct {
 	WorkerCidr                          *string                `json:"workerCidr,omitempty"`
 	PodsCidr                            *string                `json:"podsCidr,omitempty"`
 	ServicesCidr                        *string                `json:"servicesCidr,omitempty"`
+	Networking                          *Networking            `json:"networking,omitempty"`
 	AutoScalerMin                       *int                   `json:"autoScalerMin,omitempty"`
 	AutoScalerMax                       *int                   `json:"autoScalerMax,omitempty"`
 	MaxSurge                            *int                   `json:"maxSurge,omitempty"`
@@ -164,10 +223,14 @@ type GardenerConfig struct {
 	ProviderSpecificConfig              ProviderSpecificConfig `json:"providerSpecificConfig,omitempty"`
 	DNSConfig                           *DNSConfig             `json:"dnsConfig,omitempty"`
 	OidcConfig                          *OIDCConfig            `json:"oidcConfig,omitempty"`
+	KubeAPIServer                       *KubeAPIServer         `json:"kubeAPIServer,omitempty"`
 	ExposureClassName                   *string                `json:"exposureClassName,omitempty"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled,omitempty"`
+	Extensions                          []*Extension           `json:"extensions,omitempty"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance,omitempty"`
 	EuAccess                            *bool                  `json:"euAccess,omitempty"`
+	ShootAndSeedSameRegion              *bool                  `json:"shootAndSeedSameRegion,omitempty"`
+	ResourceVersion                     *int                   `json:"resourceVersion,omitempty"`
 }
 
 type GardenerConfigInput struct {
@@ -183,6 +246,7 @@ type GardenerConfigInput struct {
 	VolumeSizeGb                        *int                   `json:"volumeSizeGB,omitempty"`
 	WorkerCidr                          string                 `json:"workerCidr"`
 	PodsCidr                            *string                `json:"podsCidr,omitempty"`
+	Networking                          *NetworkingInput       `json:"networking,omitempty"`
 	ServicesCidr                        *string                `json:"servicesCidr,omitempty"`
 	AutoScalerMin                       int                    `json:"autoScalerMin"`
 	AutoScalerMax                       int                    `json:"autoScalerMax"`
@@ -196,8 +260,10 @@ type GardenerConfigInput struct {
 	DNSConfig                           *DNSConfigInput        `json:"dnsConfig,omitempty"`
 	Seed                                *string                `json:"seed,omitempty"`
 	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig,omitempty"`
+	KubeAPIServer                       *KubeAPIServerInput    `json:"kubeAPIServer,omitempty"`
 	ExposureClassName                   *string                `json:"exposureClassName,omitempty"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled,omitempty"`
+	Extensions                          []*ExtensionInput      `json:"extensions,omitempty"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance,omitempty"`
 	EuAccess                            *bool                  `json:"euAccess,omitempty"`
 	ShootAndSeedSameRegion              *bool                  `json:"shootAndSeedSameRegion,omitempty"`
@@ -221,6 +287,10 @@ type GardenerUpgradeInput struct {
 	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig,omitempty"`
 	ExposureClassName                   *string                `json:"exposureClassName,omitempty"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled,omitempty"`
+	Extensions                          []*ExtensionInput      `json:"extensions,omitempty"`
+	Networking                          *NetworkingInput       `json:"networking,omitempty"`
+	KubeAPIServer                       *KubeAPIServerInput    `json:"kubeAPIServer,omitempty"`
+	ExpectedResourceVersion             *int                   `json:"expectedResourceVersion,omitempty"`
 }
 
 type HibernationStatus struct {
@@ -228,6 +298,34 @@ type HibernationStatus struct {
 	HibernationPossible *bool `json:"hibernationPossible,omitempty"`
 }
 
+type KubeAPIServer struct {
+	FeatureGates         []*FeatureGate         `json:"featureGates,omitempty"`
+	RuntimeConfig        []*RuntimeConfigEntry  `json:"runtimeConfig,omitempty"`
+	Requests             *KubeAPIServerRequests `json:"requests,omitempty"`
+	ServiceAccount       *ServiceAccount        `json:"serviceAccount,omitempty"`
+	WatchCacheSizes      *WatchCacheSizes       `json:"watchCacheSizes,omitempty"`
+	AuditPolicyConfigMap *string                `json:"auditPolicyConfigMap,omitempty"`
+}
+
+type KubeAPIServerInput struct {
+	FeatureGates         []*FeatureGateInput         `json:"featureGates,omitempty"`
+	RuntimeConfig        []*RuntimeConfigEntryInput  `json:"runtimeConfig,omitempty"`
+	Requests             *KubeAPIServerRequestsInput `json:"requests,omitempty"`
+	ServiceAccount       *ServiceAccountInput        `json:"serviceAccount,omitempty"`
+	WatchCacheSizes      *WatchCacheSizesInput       `json:"watchCacheSizes,omitempty"`
+	AuditPolicyConfigMap *string                     `json:"auditPolicyConfigMap,omitempty"`
+}
+
+type KubeAPIServerRequests struct {
+	MaxNonMutatingInflight *int `json:"maxNonMutatingInflight,omitempty"`
+	MaxMutatingInflight    *int `json:"maxMutatingInflight,omitempty"`
+}
+
+type KubeAPIServerRequestsInput struct {
+	MaxNonMutatingInflight *int `json:"maxNonMutatingInflight,omitempty"`
+	MaxMutatingInflight    *int `json:"maxMutatingInflight,omitempty"`
+}
+
 type KymaConfig struct {
 	Version       *string                   `json:"version,omitempty"`
 	Profile       *KymaProfile              `json:"profile,omitempty"`
@@ -252,6 +350,18 @@ type LastError struct {
 type Mutation struct {
 }
 
+type Networking struct {
+	Type           string   `json:"type"`
+	IPFamilies     []string `json:"ipFamilies"`
+	ProviderConfig *string  `json:"providerConfig,omitempty"`
+}
+
+type NetworkingInput struct {
+	Type           *string  `json:"type,omitempty"`
+	IPFamilies     []string `json:"ipFamilies,omitempty"`
+	ProviderConfig *string  `json:"providerConfig,omitempty"`
+}
+
 type OIDCConfig struct {
 	ClientID       string   `json:"clientID"`
 	GroupsClaim    string   `json:"groupsClaim"`
@@ -294,6 +404,7 @@ type OperationStatus struct {
 	RuntimeID        *string        `json:"runtimeID,omitempty"`
 	CompassRuntimeID *string        `json:"compassRuntimeID,omitempty"`
 	LastError        *LastError     `json:"lastError,omitempty"`
+	SeedPlacement    *string        `json:"seedPlacement,omitempty"`
 }
 
 type ProviderSpecificInput struct {
@@ -312,17 +423,65 @@ type ProvisionRuntimeInput struct {
 type Query struct {
 }
 
+type QuotaLimits struct {
+	Runtimes             *int `json:"runtimes,omitempty"`
+	TotalMaxNodes        *int `json:"totalMaxNodes,omitempty"`
+	ConcurrentOperations *int `json:"concurrentOperations,omitempty"`
+}
+
+type QuotaUsage struct {
+	Runtimes             int `json:"runtimes"`
+	TotalMaxNodes        int `json:"totalMaxNodes"`
+	ConcurrentOperations int `json:"concurrentOperations"`
+}
+
+type ResourceWatchCacheSize struct {
+	APIGroup *string `json:"apiGroup,omitempty"`
+	Resource string  `json:"resource"`
+	Size     int     `json:"size"`
+}
+
+type ResourceWatchCacheSizeInput struct {
+	APIGroup *string `json:"apiGroup,omitempty"`
+	Resource string  `json:"resource"`
+	Size     int     `json:"size"`
+}
+
 type RuntimeConfig struct {
 	ClusterConfig *GardenerConfig `json:"clusterConfig,omitempty"`
 	KymaConfig    *KymaConfig     `json:"kymaConfig,omitempty"`
 	Kubeconfig    *string         `json:"kubeconfig,omitempty"`
 }
 
+type RuntimeConfigEntry struct {
+	APIGroupVersion string `json:"apiGroupVersion"`
+	Enabled         bool   `json:"enabled"`
+}
+
+type RuntimeConfigEntryInput struct {
+	APIGroupVersion string `json:"apiGroupVersion"`
+	Enabled         bool   `json:"enabled"`
+}
+
+type RuntimeConfigRevision struct {
+	Revision          int             `json:"revision"`
+	OperationID       *string         `json:"operationID,omitempty"`
+	CreationTimestamp time.Time       `json:"creationTimestamp"`
+	ClusterConfig     *GardenerConfig `json:"clusterConfig"`
+}
+
 type RuntimeConnectionStatus struct {
 	Status RuntimeAgentConnectionStatus `json:"status"`
 	Errors []*Error                     `json:"errors,omitempty"`
 }
 
+type RuntimeDriftItem struct {
+	Field       string    `json:"field"`
+	StoredValue *string   `json:"storedValue,omitempty"`
+	ActualValue *string   `json:"actualValue,omitempty"`
+	DetectedAt  time.Time `json:"detectedAt"`
+}
+
 type RuntimeInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description,omitempty"`
@@ -334,6 +493,25 @@ type RuntimeStatus struct {
 	RuntimeConnectionStatus *RuntimeConnectionStatus `json:"runtimeConnectionStatus,omitempty"`
 	RuntimeConfiguration    *RuntimeConfig           `json:"runtimeConfiguration,omitempty"`
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus,omitempty"`
+	Drift                   []*RuntimeDriftItem      `json:"drift,omitempty"`
+	Description             *string                  `json:"description,omitempty"`
+	Labels                  Labels                   `json:"labels,omitempty"`
+}
+
+type ServiceAccount struct {
+	Issuer                *string `json:"issuer,omitempty"`
+	ExtendTokenExpiration *bool   `json:"extendTokenExpiration,omitempty"`
+}
+
+type ServiceAccountInput struct {
+	Issuer                *string `json:"issuer,omitempty"`
+	ExtendTokenExpiration *bool   `json:"extendTokenExpiration,omitempty"`
+}
+
+type TenantQuota struct {
+	Tenant string       `json:"tenant"`
+	Limits *QuotaLimits `json:"limits"`
+	Usage  *QuotaUsage  `json:"usage"`
 }
 
 type UpgradeRuntimeInput struct {
@@ -341,10 +519,62 @@ type UpgradeRuntimeInput struct {
 }
 
 type UpgradeShootInput struct {
-	GardenerConfig *GardenerUpgradeInput `json:"gardenerConfig"`
+	GardenerConfig *GardenerUpgradeInput `json:"gardenerConfig,omitempty"`
+	Revision       *int                  `json:"revision,omitempty"`
 	Administrators []string              `json:"administrators,omitempty"`
 }
 
+type WatchCacheSizes struct {
+	DefaultSize *int                      `json:"defaultSize,omitempty"`
+	Resources   []*ResourceWatchCacheSize `json:"resources,omitempty"`
+}
+
+type WatchCacheSizesInput struct {
+	DefaultSize *int                           `json:"defaultSize,omitempty"`
+	Resources   []*ResourceWatchCacheSizeInput `json:"resources,omitempty"`
+}
+
+type AuditEventOutcome string
+
+const (
+	AuditEventOutcomeSucceeded AuditEventOutcome = "Succeeded"
+	AuditEventOutcomeFailed    AuditEventOutcome = "Failed"
+)
+
+var AllAuditEventOutcome = []AuditEventOutcome{
+	AuditEventOutcomeSucceeded,
+	AuditEventOutcomeFailed,
+}
+
+func (e AuditEventOutcome) IsValid() bool {
+	switch e {
+	case AuditEventOutcomeSucceeded, AuditEventOutcomeFailed:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventOutcome) String() string {
+	return string(e)
+}
+
+func (e *AuditEventOutcome) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventOutcome", str)
+	}
+	return nil
+}
+
+func (e AuditEventOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ConflictStrategy string
 
 const (
@@ -0,0 +1,19 @@
+BEGIN;
+CREATE TABLE api_audit_event
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    timestamp timestamp without time zone NOT NULL,
+    caller varchar(256) NOT NULL,
+    tenant varchar(256) NOT NULL,
+    sub_account_id varchar(256),
+    mutation varchar(256) NOT NULL,
+    runtime_id varchar(256),
+    input jsonb,
+    operation_id varchar(256),
+    outcome varchar(256) NOT NULL,
+    err_message text
+);
+CREATE INDEX api_audit_event_runtime_id_timestamp_idx ON api_audit_event (runtime_id, timestamp);
+CREATE RULE api_audit_event_no_update AS ON UPDATE TO api_audit_event DO INSTEAD NOTHING;
+CREATE RULE api_audit_event_no_delete AS ON DELETE TO api_audit_event DO INSTEAD NOTHING;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+CREATE TABLE idempotency_key
+(
+    tenant varchar(256) NOT NULL,
+    key varchar(256) NOT NULL,
+    mutation varchar(256) NOT NULL,
+    request_hash varchar(64) NOT NULL,
+    operation_id uuid NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (tenant, key),
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE
+);
+COMMIT;
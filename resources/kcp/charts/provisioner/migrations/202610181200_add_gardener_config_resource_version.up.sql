@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN resource_version integer NOT NULL DEFAULT 1;
+COMMIT;
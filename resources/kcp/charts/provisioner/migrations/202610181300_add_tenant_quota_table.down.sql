@@ -0,0 +1,4 @@
+BEGIN;
+DROP INDEX cluster_tenant_idx;
+DROP TABLE tenant_quota;
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+CREATE TABLE tenant_quota
+(
+    tenant varchar(256) PRIMARY KEY,
+    runtimes integer,
+    total_max_nodes integer,
+    concurrent_operations integer
+);
+CREATE INDEX cluster_tenant_idx ON cluster (tenant);
+COMMIT;
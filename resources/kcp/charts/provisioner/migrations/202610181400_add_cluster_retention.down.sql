@@ -0,0 +1,5 @@
+BEGIN;
+DROP INDEX operation_archive_cluster_id_idx;
+DROP TABLE operation_archive;
+ALTER TABLE cluster DROP COLUMN deleted_at;
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+ALTER TABLE cluster ADD COLUMN deleted_at timestamp without time zone;
+UPDATE cluster SET deleted_at = COALESCE(
+    (SELECT MAX(operation.end_timestamp) FROM operation WHERE operation.cluster_id = cluster.id),
+    cluster.creation_timestamp)
+WHERE deleted = true;
+CREATE TABLE operation_archive
+(
+    id uuid PRIMARY KEY,
+    type operation_type NOT NULL,
+    state operation_state NOT NULL,
+    message text,
+    start_timestamp timestamp without time zone NOT NULL,
+    end_timestamp timestamp without time zone,
+    cluster_id uuid NOT NULL,
+    stage varchar(256) NOT NULL,
+    last_transition timestamp without time zone,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL,
+    archived_at timestamp without time zone NOT NULL
+);
+CREATE INDEX operation_archive_cluster_id_idx ON operation_archive (cluster_id);
+COMMIT;
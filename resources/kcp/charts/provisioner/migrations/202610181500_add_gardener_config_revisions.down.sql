@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE gardener_config_revision;
+COMMIT;
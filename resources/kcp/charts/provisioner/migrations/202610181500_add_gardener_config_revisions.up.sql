@@ -0,0 +1,12 @@
+BEGIN;
+CREATE TABLE gardener_config_revision
+(
+    cluster_id uuid NOT NULL,
+    revision integer NOT NULL,
+    operation_id uuid,
+    creation_timestamp timestamp without time zone NOT NULL,
+    config jsonb NOT NULL,
+    PRIMARY KEY (cluster_id, revision),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE runtime_drift;
+COMMIT;
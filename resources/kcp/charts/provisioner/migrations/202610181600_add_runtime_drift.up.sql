@@ -0,0 +1,12 @@
+BEGIN;
+CREATE TABLE runtime_drift
+(
+    cluster_id uuid NOT NULL,
+    field varchar(256) NOT NULL,
+    stored_value text,
+    actual_value text,
+    detected_at timestamp without time zone NOT NULL,
+    PRIMARY KEY (cluster_id, field),
+    foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
+);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config DROP COLUMN shoot_and_seed_same_region;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN shoot_and_seed_same_region boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+DROP INDEX cluster_labels_idx;
+ALTER TABLE cluster DROP COLUMN description;
+ALTER TABLE cluster DROP COLUMN labels;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+ALTER TABLE cluster ADD COLUMN labels jsonb;
+ALTER TABLE cluster ADD COLUMN description text;
+CREATE INDEX cluster_labels_idx ON cluster USING GIN (labels);
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE operation_archive DROP COLUMN seed_placement;
+ALTER TABLE operation DROP COLUMN seed_placement;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+ALTER TABLE operation ADD COLUMN seed_placement text;
+ALTER TABLE operation_archive ADD COLUMN seed_placement text;
+COMMIT;
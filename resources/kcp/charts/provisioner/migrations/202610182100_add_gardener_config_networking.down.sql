@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config DROP COLUMN networking;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config DROP COLUMN kube_api_server;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN kube_api_server jsonb;
+COMMIT;
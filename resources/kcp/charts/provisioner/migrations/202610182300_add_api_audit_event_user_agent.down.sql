@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE api_audit_event DROP COLUMN user_agent;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE api_audit_event ADD COLUMN user_agent varchar(256);
+COMMIT;
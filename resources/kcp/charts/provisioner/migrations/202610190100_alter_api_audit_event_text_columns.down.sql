@@ -0,0 +1,11 @@
+BEGIN;
+ALTER TABLE api_audit_event
+    ALTER COLUMN caller TYPE varchar(256) USING left(caller, 256),
+    ALTER COLUMN tenant TYPE varchar(256) USING left(tenant, 256),
+    ALTER COLUMN sub_account_id TYPE varchar(256) USING left(sub_account_id, 256),
+    ALTER COLUMN mutation TYPE varchar(256) USING left(mutation, 256),
+    ALTER COLUMN runtime_id TYPE varchar(256) USING left(runtime_id, 256),
+    ALTER COLUMN operation_id TYPE varchar(256) USING left(operation_id, 256),
+    ALTER COLUMN outcome TYPE varchar(256) USING left(outcome, 256),
+    ALTER COLUMN user_agent TYPE varchar(256) USING left(user_agent, 256);
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+ALTER TABLE api_audit_event
+    ALTER COLUMN caller TYPE text,
+    ALTER COLUMN tenant TYPE text,
+    ALTER COLUMN sub_account_id TYPE text,
+    ALTER COLUMN mutation TYPE text,
+    ALTER COLUMN runtime_id TYPE text,
+    ALTER COLUMN operation_id TYPE text,
+    ALTER COLUMN outcome TYPE text,
+    ALTER COLUMN user_agent TYPE text;
+COMMIT;